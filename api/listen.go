@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/knx-go/knx-go/knx"
+	"github.com/knx-go/knx-go/knx/dpt"
 	"github.com/knx-go/knx-go/knx/gac"
 	"github.com/knx-go/knx-go/pgknx"
 )
@@ -52,13 +53,16 @@ func eventFromDetails(event knx.GroupEvent, catalog *gac.Catalog) pgknx.Event {
 	if len(group.DPTs) > 0 {
 		recorded.DPT = string(group.DPTs[0])
 	}
-	if description, ok := describeDatapoints(group, event.Data); ok {
-		recorded.Decoded = description
+	if value, ok := decodeDatapoints(group, event.Data); ok {
+		description := dpt.Describe(value)
+		recorded.Decoded = value.String()
+		recorded.Value = &description
 	}
 	return recorded
 }
 
-func describeDatapoints(group *gac.Group, data []byte) (string, bool) {
+// decodeDatapoints unpacks the data with the first datapoint type of the group which accepts it.
+func decodeDatapoints(group *gac.Group, data []byte) (dpt.DatapointValue, bool) {
 	for _, dp := range group.DPTs {
 		value, ok := dp.Produce()
 		if !ok {
@@ -69,7 +73,7 @@ func describeDatapoints(group *gac.Group, data []byte) (string, bool) {
 			continue
 		}
 
-		return fmt.Sprint(value), true
+		return value, true
 	}
-	return "", false
+	return nil, false
 }
@@ -250,17 +250,26 @@ func (a api) handleGroupWrite(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Send event
+	// Parse the value in the text form of the datapoint type, e.g. "on" or "21.5 °C"
+	if len(group.DPTs) == 0 {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		if e := json.NewEncoder(w).Encode(map[string]string{"error": "group has no datapoint type"}); e != nil {
+			log.Printf("failed to encode group error: %v", e)
+		}
+		return
+	}
 	payload, err := dpt.EncodeDPTFromStringN(string(group.DPTs[0]), p.Value)
 	if err != nil {
 		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusInternalServerError)
+		w.WriteHeader(http.StatusBadRequest)
 		if e := json.NewEncoder(w).Encode(map[string]string{"error": fmt.Sprintf("%v", err)}); e != nil {
 			log.Printf("the input string is not valid: %v", e)
 		}
 		return
 	}
 
+	// Send event
 	event := knx.GroupEvent{
 		Command:     knx.GroupWrite,
 		Destination: group.Address,
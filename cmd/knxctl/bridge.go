@@ -99,7 +99,7 @@ func runBridgeLoop(otherAddr string) error {
 }
 
 func newBridge(gatewayAddr, otherAddr string, logger *log.Logger) (*bridge, error) {
-	tunnel, err := knx.NewTunnel(gatewayAddr, knxnet.TunnelLayerData, knx.DefaultTunnelConfig)
+	tunnel, err := knx.NewTunnel(gatewayAddr, knxnet.TunnelLayerData, tunnelConfig())
 	if err != nil {
 		return nil, err
 	}
@@ -113,7 +113,7 @@ func newBridge(gatewayAddr, otherAddr string, logger *log.Logger) (*bridge, erro
 	}
 
 	if addr.IP.IsMulticast() {
-		router, err := knx.NewRouter(otherAddr, knx.DefaultRouterConfig)
+		router, err := knx.NewRouter(otherAddr, routerConfig())
 		if err != nil {
 			tunnel.Close()
 			return nil, err
@@ -121,7 +121,7 @@ func newBridge(gatewayAddr, otherAddr string, logger *log.Logger) (*bridge, erro
 
 		other = routerRelay{router}
 	} else {
-		otherTunnel, err := knx.NewTunnel(otherAddr, knxnet.TunnelLayerData, knx.DefaultTunnelConfig)
+		otherTunnel, err := knx.NewTunnel(otherAddr, knxnet.TunnelLayerData, tunnelConfig())
 		if err != nil {
 			tunnel.Close()
 			return nil, err
@@ -3,10 +3,12 @@ package main
 import (
 	"bufio"
 	"fmt"
+	"log/slog"
 	"os"
 	"strings"
 	"time"
 
+	"github.com/knx-go/knx-go/knx"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -72,6 +74,7 @@ func loadConfigFile(path string) error {
 	if err := bindEnvKeys(
 		"server",
 		"port",
+		"log_level",
 		"listen.group_file",
 		"listen.database_url",
 		"serve.listen",
@@ -87,6 +90,10 @@ func loadConfigFile(path string) error {
 		"send.group_file",
 		"send.wait_response",
 		"send.timeout",
+		"timesync.time_group",
+		"timesync.date_group",
+		"timesync.datetime_group",
+		"timesync.interval",
 		"bridge.other",
 	); err != nil {
 		return err
@@ -121,6 +128,44 @@ func applyGlobalConfig(cmd *cobra.Command) {
 	if value := strings.TrimSpace(viper.GetString("port")); value != "" && !flagChanged(cmd, "port") {
 		port = value
 	}
+	if value := strings.TrimSpace(viper.GetString("log_level")); value != "" && !flagChanged(cmd, "log-level") {
+		logLevel = value
+	}
+}
+
+func validateLogLevel(value string) error {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(strings.TrimSpace(value))); err != nil {
+		return fmt.Errorf("invalid log level %q", value)
+	}
+	return nil
+}
+
+// clientLogger creates the logger for the KNX clients. Logging is disabled unless a level has been
+// configured.
+func clientLogger() *slog.Logger {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(strings.TrimSpace(logLevel))); err != nil {
+		return slog.New(slog.DiscardHandler)
+	}
+
+	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level}))
+}
+
+func tunnelConfig() knx.TunnelConfig {
+	config := knx.DefaultTunnelConfig
+	config.Logger = clientLogger()
+	return config
+}
+
+func routerConfig() knx.RouterConfig {
+	config := knx.DefaultRouterConfig
+	config.Logger = clientLogger()
+	return config
 }
 
 func flagChanged(cmd *cobra.Command, name string) bool {
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/knx-go/knx-go/knx"
+	"github.com/knx-go/knx-go/knx/knxnet"
+	"github.com/spf13/cobra"
+)
+
+var (
+	diagnoseMulticast string
+	diagnoseMAC       string
+	diagnoseProgMode  bool
+	diagnoseTimeout   time.Duration = 2 * time.Second
+	diagnoseIP        string
+	diagnoseNetmask   string
+	diagnoseGateway   string
+	diagnoseDHCP      bool
+)
+
+func init() {
+	cmd := &cobra.Command{
+		Use:   "diagnose",
+		Short: "Diagnose and configure KNXnet/IP servers via multicast, even on the wrong subnet",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return diagnose()
+		},
+	}
+
+	cmd.Flags().StringVar(&diagnoseMulticast, "multicast", "224.0.23.12:3671", "KNXnet/IP system setup multicast address")
+	cmd.Flags().StringVar(&diagnoseMAC, "mac", "", "select the server with this MAC address")
+	cmd.Flags().BoolVar(&diagnoseProgMode, "prog-mode", false, "select all servers in programming mode")
+	cmd.Flags().DurationVar(&diagnoseTimeout, "timeout", 2*time.Second, "time to wait for diagnostic responses")
+	cmd.Flags().StringVar(&diagnoseIP, "set-ip", "", "push this IP address to the selected server")
+	cmd.Flags().StringVar(&diagnoseNetmask, "set-netmask", "255.255.255.0", "subnet mask to push along with --set-ip")
+	cmd.Flags().StringVar(&diagnoseGateway, "set-gateway", "0.0.0.0", "default gateway to push along with --set-ip")
+	cmd.Flags().BoolVar(&diagnoseDHCP, "dhcp", false, "push a configuration that makes the selected server use DHCP")
+
+	root.AddCommand(cmd)
+}
+
+// parseSelector determines which servers the command addresses.
+func parseSelector(mac string, progMode bool) (knxnet.Selector, error) {
+	mac = strings.TrimSpace(mac)
+
+	switch {
+	case mac != "" && progMode:
+		return knxnet.Selector{}, errors.New("--mac and --prog-mode are mutually exclusive")
+
+	case mac != "":
+		addr, err := net.ParseMAC(mac)
+		if err != nil {
+			return knxnet.Selector{}, err
+		}
+
+		return knxnet.NewMACSelector(addr)
+
+	case progMode:
+		return knxnet.NewProgModeSelector(), nil
+
+	default:
+		return knxnet.Selector{}, errors.New("either --mac or --prog-mode is required")
+	}
+}
+
+// parseIPv4 converts a dotted IPv4 address.
+func parseIPv4(value string) (knxnet.Address, error) {
+	ip := net.ParseIP(strings.TrimSpace(value)).To4()
+	if ip == nil {
+		return knxnet.Address{}, fmt.Errorf("invalid IPv4 address %q", value)
+	}
+
+	var addr knxnet.Address
+	copy(addr[:], ip)
+
+	return addr, nil
+}
+
+// buildIPConfig assembles the configuration that shall be pushed.
+func buildIPConfig(ip, netmask, gateway string, dhcp bool) (knxnet.IPConfigDIB, error) {
+	if dhcp {
+		return knxnet.IPConfigDIB{AssignmentMethod: knxnet.IPAssignmentDHCP}, nil
+	}
+
+	config := knxnet.IPConfigDIB{AssignmentMethod: knxnet.IPAssignmentManual}
+
+	var err error
+	if config.Address, err = parseIPv4(ip); err != nil {
+		return config, err
+	}
+	if config.SubnetMask, err = parseIPv4(netmask); err != nil {
+		return config, err
+	}
+	if config.DefaultGateway, err = parseIPv4(gateway); err != nil {
+		return config, err
+	}
+
+	return config, nil
+}
+
+func printDiagnosis(res *knxnet.RemoteDiagRes) {
+	info := res.DescriptionB.DeviceHardware
+	fmt.Printf("%s (%s) %s\n", info.FriendlyName, info.HardwareAddr, info.Source)
+
+	if cfg := res.DescriptionB.IPConfig; cfg != nil {
+		fmt.Printf("  configured: %s/%s gateway %s (%s)\n",
+			cfg.Address, cfg.SubnetMask, cfg.DefaultGateway, cfg.AssignmentMethod)
+	}
+
+	if cfg := res.DescriptionB.IPCurrentConfig; cfg != nil {
+		fmt.Printf("  current:    %s/%s gateway %s (%s)\n",
+			cfg.Address, cfg.SubnetMask, cfg.DefaultGateway, cfg.AssignmentMethod)
+	}
+}
+
+func diagnose() error {
+	selector, err := parseSelector(diagnoseMAC, diagnoseProgMode)
+	if err != nil {
+		return err
+	}
+
+	if diagnoseIP != "" || diagnoseDHCP {
+		config, err := buildIPConfig(diagnoseIP, diagnoseNetmask, diagnoseGateway, diagnoseDHCP)
+		if err != nil {
+			return err
+		}
+
+		if err := knx.RemoteConfigure(diagnoseMulticast, selector, config); err != nil {
+			return err
+		}
+
+		fmt.Printf("Pushed IP configuration to %s\n", selector)
+	}
+
+	results, err := knx.RemoteDiagnose(diagnoseMulticast, selector, diagnoseTimeout)
+	if err != nil {
+		return err
+	}
+
+	if len(results) == 0 {
+		fmt.Printf("No response from %s\n", selector)
+		return nil
+	}
+
+	for _, res := range results {
+		printDiagnosis(res)
+	}
+
+	return nil
+}
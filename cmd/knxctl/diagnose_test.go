@@ -0,0 +1,55 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/knx-go/knx-go/knx/knxnet"
+)
+
+func TestParseSelector(t *testing.T) {
+	sel, err := parseSelector("00:24:6d:01:02:03", false)
+	if err != nil {
+		t.Fatalf("parseSelector returned error: %v", err)
+	}
+	if sel.Type != knxnet.SelectorTypeMAC || sel.HardwareAddr.String() != "00:24:6d:01:02:03" {
+		t.Fatalf("unexpected selector %v", sel)
+	}
+
+	sel, err = parseSelector("", true)
+	if err != nil || sel.Type != knxnet.SelectorTypeProgMode {
+		t.Fatalf("unexpected selector %v (error %v)", sel, err)
+	}
+
+	if _, err := parseSelector("", false); err == nil {
+		t.Fatal("expected an error without selector")
+	}
+	if _, err := parseSelector("00:24:6d:01:02:03", true); err == nil {
+		t.Fatal("expected an error for conflicting selectors")
+	}
+}
+
+func TestBuildIPConfig(t *testing.T) {
+	config, err := buildIPConfig("192.168.1.10", "255.255.255.0", "192.168.1.1", false)
+	if err != nil {
+		t.Fatalf("buildIPConfig returned error: %v", err)
+	}
+
+	want := knxnet.IPConfigDIB{
+		Address:          knxnet.Address{192, 168, 1, 10},
+		SubnetMask:       knxnet.Address{255, 255, 255, 0},
+		DefaultGateway:   knxnet.Address{192, 168, 1, 1},
+		AssignmentMethod: knxnet.IPAssignmentManual,
+	}
+	if config != want {
+		t.Fatalf("expected %+v, got %+v", want, config)
+	}
+
+	if _, err := buildIPConfig("192.168.1.300", "255.255.255.0", "0.0.0.0", false); err == nil {
+		t.Fatal("expected an error for an invalid address")
+	}
+
+	config, err = buildIPConfig("", "", "", true)
+	if err != nil || config.AssignmentMethod != knxnet.IPAssignmentDHCP {
+		t.Fatalf("unexpected DHCP configuration %+v (error %v)", config, err)
+	}
+}
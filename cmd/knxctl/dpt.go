@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/knx-go/knx-go/knx/dpt"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	cmd := &cobra.Command{
+		Use:   "dpt",
+		Short: "Show the supported datapoint types",
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "list",
+		Short: "List the supported datapoint types",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return listDatapointTypes(os.Stdout)
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "info <dpt>",
+		Short: "Show the name, unit, range and values of a datapoint type, e.g. 9.001 or DPST-9-1",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return showDatapointType(os.Stdout, args[0])
+		},
+	})
+
+	root.AddCommand(cmd)
+}
+
+// listDatapointTypes prints one line per supported datapoint type.
+func listDatapointTypes(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tNAME\tUNIT\tDESCRIPTION")
+	for _, meta := range dpt.ListMetadata() {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", meta.ID, meta.Name, meta.Unit, meta.Description)
+	}
+	return tw.Flush()
+}
+
+// showDatapointType prints the metadata of a single datapoint type.
+func showDatapointType(w io.Writer, id string) error {
+	normalized, err := dpt.NormaliseDPTID(id)
+	if err != nil {
+		return err
+	}
+
+	meta, ok := dpt.Lookup(normalized)
+	if !ok {
+		return fmt.Errorf("DPT not supported: %s", normalized)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "ID:\t%s\n", meta.ID)
+	fmt.Fprintf(tw, "Name:\t%s\n", meta.Name)
+	fmt.Fprintf(tw, "Description:\t%s\n", meta.Description)
+	if meta.Unit != "" {
+		fmt.Fprintf(tw, "Unit:\t%s\n", meta.Unit)
+	}
+	if meta.Range != nil {
+		fmt.Fprintf(tw, "Range:\t%s\n", meta.Range)
+	}
+	if meta.Resolution != 0 {
+		fmt.Fprintf(tw, "Resolution:\t%s\n", strconv.FormatFloat(meta.Resolution, 'g', 6, 64))
+	}
+	if len(meta.Enum) > 0 {
+		fmt.Fprintln(tw, "Values:")
+		for _, value := range slices.Sorted(maps.Keys(meta.Enum)) {
+			fmt.Fprintf(tw, "  %d\t%s\n", value, meta.Enum[value])
+		}
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestListDatapointTypes(t *testing.T) {
+	var out bytes.Buffer
+	if err := listDatapointTypes(&out); err != nil {
+		t.Fatalf("listDatapointTypes returned error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if !strings.HasPrefix(lines[0], "ID") || !strings.HasPrefix(lines[1], "1.001 ") {
+		t.Fatalf("unexpected listing start:\n%s\n%s", lines[0], lines[1])
+	}
+	if !strings.Contains(out.String(), "DPT_Value_Temp") {
+		t.Fatal("expected DPT_Value_Temp in the listing")
+	}
+}
+
+func TestShowDatapointType(t *testing.T) {
+	var out bytes.Buffer
+	if err := showDatapointType(&out, "DPST-9-1"); err != nil {
+		t.Fatalf("showDatapointType returned error: %v", err)
+	}
+
+	for _, want := range []string{"9.001", "DPT_Value_Temp", "°C", "[-273, 670760]", "0.01"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected %q in\n%s", want, out.String())
+		}
+	}
+
+	out.Reset()
+	if err := showDatapointType(&out, "20.102"); err != nil {
+		t.Fatalf("showDatapointType returned error: %v", err)
+	}
+	if !strings.Contains(out.String(), "Comfort") {
+		t.Errorf("expected the enum values in\n%s", out.String())
+	}
+
+	if err := showDatapointType(&out, "99.001"); err == nil {
+		t.Fatal("expected an error for an unsupported DPT")
+	}
+}
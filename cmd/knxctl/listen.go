@@ -10,12 +10,15 @@ import (
 
 	"github.com/knx-go/knx-go/knx"
 	"github.com/knx-go/knx-go/knx/cemi"
+	"github.com/knx-go/knx-go/knx/dpt"
 	"github.com/knx-go/knx-go/knx/gac"
 	"github.com/knx-go/knx-go/pgknx"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
+var listenGuessDPT bool
+
 func init() {
 	cmd := &cobra.Command{
 		Use:   "listen",
@@ -31,6 +34,7 @@ func init() {
 
 	cmd.Flags().StringVarP(&groupFile, "group-file", "f", "", "path to a KNX group address export (XML)")
 	cmd.Flags().StringVarP(&postgresqlDSN, "postgresql-dsn", "d", "", "PostgreSQL Data Source Name used to persist events")
+	cmd.Flags().BoolVar(&listenGuessDPT, "guess-dpt", false, "propose datapoint types for groups which are not in the group file")
 
 	root.AddCommand(cmd)
 }
@@ -42,6 +46,9 @@ func applyListenConfig(cmd *cobra.Command) {
 	if value := strings.TrimSpace(viper.GetString("listen.postrgesql-dsn")); value != "" && !flagChanged(cmd, "postgresql-dsn") {
 		postgresqlDSN = value
 	}
+	if viper.IsSet("listen.guess_dpt") && !flagChanged(cmd, "guess-dpt") {
+		listenGuessDPT = viper.GetBool("listen.guess_dpt")
+	}
 }
 
 func listen() error {
@@ -67,8 +74,10 @@ func listen() error {
 		fmt.Println("Database recording enabled")
 	}
 
+	guesses := datapointGuesses{}
+
 	for {
-		client, err := knx.NewGroupTunnel(fmt.Sprintf("%s:%s", server, port), knx.DefaultTunnelConfig)
+		client, err := knx.NewGroupTunnel(fmt.Sprintf("%s:%s", server, port), tunnelConfig())
 		if err != nil {
 			fmt.Printf("Error while creating: %v\n", err)
 			time.Sleep(time.Second)
@@ -83,9 +92,17 @@ func listen() error {
 				group, ok := catalog.LookupByAddress(event.Destination)
 				if ok || group != nil {
 					destination = fmt.Sprintf("%s (%s)", catalog.FormatAddress(event.Destination), group.Name)
-					description, _ := describeDatapoints(group, event.Data)
+					description := ""
+					if value, ok := decodeDatapoints(group, event.Data); ok {
+						description = value.String()
+					}
 					fmt.Printf("[%s] %s %s -> %s %s\n", timestamp, event.Command, event.Source, destination, description)
+				} else if listenGuessDPT {
+					destination = catalog.FormatAddress(event.Destination)
+					fmt.Printf("[%s] %s %s -> %s %v %s\n", timestamp, event.Command, event.Source, destination, event.Data, guesses.add(event))
 				}
+			} else if listenGuessDPT {
+				fmt.Printf("[%s] %s %s -> %s %v %s\n", timestamp, event.Command, event.Source, destination, event.Data, guesses.add(event))
 			} else {
 				fmt.Printf("[%s] %s %s -> %s %v\n", timestamp, event.Command, event.Source, destination, event.Data)
 			}
@@ -123,13 +140,16 @@ func eventFromDetails(event knx.GroupEvent, catalog *gac.Catalog) pgknx.Event {
 	if len(group.DPTs) > 0 {
 		recorded.DPT = string(group.DPTs[0])
 	}
-	if description, ok := describeDatapoints(group, event.Data); ok {
-		recorded.Decoded = description
+	if value, ok := decodeDatapoints(group, event.Data); ok {
+		description := dpt.Describe(value)
+		recorded.Decoded = value.String()
+		recorded.Value = &description
 	}
 	return recorded
 }
 
-func describeDatapoints(group *gac.Group, data []byte) (string, bool) {
+// decodeDatapoints unpacks the data with the first datapoint type of the group which accepts it.
+func decodeDatapoints(group *gac.Group, data []byte) (dpt.DatapointValue, bool) {
 	for _, dp := range group.DPTs {
 		value, ok := dp.Produce()
 		if !ok {
@@ -140,7 +160,40 @@ func describeDatapoints(group *gac.Group, data []byte) (string, bool) {
 			continue
 		}
 
-		return fmt.Sprint(value), true
+		return value, true
+	}
+	return nil, false
+}
+
+// datapointGuesses keeps a dpt.Guesser per group address, so the guesses improve with every event.
+type datapointGuesses map[cemi.GroupAddr]*dpt.Guesser
+
+// add rates the data of the event and returns the most plausible datapoint types of its group.
+func (g datapointGuesses) add(event knx.GroupEvent) string {
+	// Only writes and responses carry a value.
+	if event.Command == knx.GroupRead {
+		return ""
+	}
+
+	guesser, ok := g[event.Destination]
+	if !ok {
+		guesser = &dpt.Guesser{}
+		g[event.Destination] = guesser
+	}
+	guesser.Add(event.Data)
+
+	return formatGuesses(guesser.Candidates(), 3)
+}
+
+// formatGuesses describes at most n candidates, e.g. "guess: 9.001 21.50 °C (80%), 7.001 3123 pulses (30%)".
+func formatGuesses(candidates []dpt.Candidate, n int) string {
+	if len(candidates) == 0 {
+		return "guess: unknown"
+	}
+
+	parts := make([]string, 0, n)
+	for _, candidate := range candidates[:min(n, len(candidates))] {
+		parts = append(parts, fmt.Sprintf("%s %s (%.0f%%)", candidate.DPT, candidate.Value, candidate.Score*100))
 	}
-	return "", false
+	return "guess: " + strings.Join(parts, ", ")
 }
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/knx-go/knx-go/knx"
+	"github.com/knx-go/knx-go/knx/cemi"
+	"github.com/knx-go/knx-go/knx/dpt"
+)
+
+func TestFormatGuesses(t *testing.T) {
+	temperature := dpt.DPT_9001(21.5)
+	counter := dpt.DPT_7001(3123)
+	candidates := []dpt.Candidate{
+		{DPT: "9.001", Score: 0.8, Value: &temperature},
+		{DPT: "7.001", Score: 0.3, Value: &counter},
+	}
+
+	if got, want := formatGuesses(candidates, 3), "guess: 9.001 21.50 °C (80%), 7.001 3123 pulses (30%)"; got != want {
+		t.Errorf("formatGuesses() = %q, want %q", got, want)
+	}
+	if got, want := formatGuesses(candidates, 1), "guess: 9.001 21.50 °C (80%)"; got != want {
+		t.Errorf("formatGuesses() = %q, want %q", got, want)
+	}
+	if got, want := formatGuesses(nil, 3), "guess: unknown"; got != want {
+		t.Errorf("formatGuesses() = %q, want %q", got, want)
+	}
+}
+
+func TestDatapointGuesses(t *testing.T) {
+	guesses := datapointGuesses{}
+	destination := cemi.NewGroupAddr3(1, 2, 3)
+
+	if got := guesses.add(knx.GroupEvent{Command: knx.GroupRead, Destination: destination}); got != "" {
+		t.Errorf("expected no guess for a read, got %q", got)
+	}
+
+	guesses.add(knx.GroupEvent{Command: knx.GroupWrite, Destination: destination, Data: []byte{0x01}})
+	got := guesses.add(knx.GroupEvent{Command: knx.GroupWrite, Destination: destination, Data: []byte{0x02}})
+	if !strings.HasPrefix(got, "guess: 2.001 ") {
+		t.Errorf("expected 2.001 after the payloads 01 and 02, got %q", got)
+	}
+	if len(guesses) != 1 {
+		t.Errorf("expected a single guesser, got %d", len(guesses))
+	}
+}
@@ -24,6 +24,7 @@ var (
 	configPath      string
 	envFile         string
 	serveListenAddr string
+	logLevel        string
 )
 
 var root = &cobra.Command{
@@ -48,7 +49,7 @@ var root = &cobra.Command{
 		}
 
 		applyGlobalConfig(cmd)
-		return nil
+		return validateLogLevel(logLevel)
 	},
 }
 
@@ -57,6 +58,7 @@ func init() {
 	root.PersistentFlags().StringVarP(&configPath, "config", "c", "", "path to an INI/TOML configuration file")
 	root.PersistentFlags().StringVarP(&server, "server", "s", "127.0.0.1", "KNXnet/IP server address")
 	root.PersistentFlags().StringVarP(&port, "port", "p", "3671", "KNXnet/IP server port")
+	root.PersistentFlags().StringVar(&logLevel, "log-level", "", "log level of the KNX clients (debug, info, warn, error); disabled if empty")
 }
 
 func main() {
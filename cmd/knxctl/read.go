@@ -64,7 +64,7 @@ func readValue() error {
 		return err
 	}
 
-	client, err := knx.NewGroupTunnel(fmt.Sprintf("%s:%s", server, port), knx.DefaultTunnelConfig)
+	client, err := knx.NewGroupTunnel(fmt.Sprintf("%s:%s", server, port), tunnelConfig())
 	if err != nil {
 		fmt.Printf("Error while creating: %v\n", err)
 		return err
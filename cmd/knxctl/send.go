@@ -32,7 +32,7 @@ func init() {
 	cmd.Flags().StringVarP(&group, "group", "g", "", "KNX group address to target")
 	cmd.Flags().StringVar(&groupName, "group-name", "", "KNX group name to resolve via the catalog")
 	cmd.Flags().StringVar(&writeDPT, "dpt", "", "Datapoint type to use when encoding the value")
-	cmd.Flags().StringVar(&valueRaw, "value", "", "Value to encode and send to the destination group")
+	cmd.Flags().StringVar(&valueRaw, "value", "", "Value to encode and send to the destination group, in the text form of the datapoint type (e.g. on, 21.5 °C)")
 	cmd.Flags().StringVarP(&groupFile, "group-file", "f", "", "path to a KNX group address export (XML)")
 	cmd.Flags().BoolVar(&waitForResponse, "wait-response", false, "wait for a response to the sent command")
 	cmd.Flags().DurationVar(&waitTimeout, "timeout", 5*time.Second, "maximum time to wait for a response when wait-response is enabled")
@@ -72,7 +72,7 @@ func sendValue() error {
 		return err
 	}
 
-	client, err := knx.NewGroupTunnel(fmt.Sprintf("%s:%s", server, port), knx.DefaultTunnelConfig)
+	client, err := knx.NewGroupTunnel(fmt.Sprintf("%s:%s", server, port), tunnelConfig())
 	if err != nil {
 		fmt.Printf("Error while creating: %v\n", err)
 		return err
@@ -198,6 +198,9 @@ func resolveDatapointType(group *gac.Group) (string, error) {
 	return "", errors.New("no datapoint type provided and none available from catalog")
 }
 
+// encodeDatapointValue parses the value in the text form of the datapoint type, e.g. "on" or
+// "21.5 °C". Structured values may also be given as a JSON object with the named fields. Values
+// outside of the range of the datapoint type are rejected rather than clamped.
 func encodeDatapointValue(datapointType, literal string) ([]byte, error) {
 	value, ok := dpt.Produce(datapointType)
 	if !ok {
@@ -209,26 +212,33 @@ func encodeDatapointValue(datapointType, literal string) ([]byte, error) {
 		return nil, errors.New("value must be provided")
 	}
 
-	candidate := []byte(trimmed)
-	if !json.Valid(candidate) {
-		marshaled, err := json.Marshal(trimmed)
+	if strings.HasPrefix(trimmed, "{") {
+		if err := json.Unmarshal([]byte(trimmed), value); err != nil {
+			return nil, fmt.Errorf("failed to decode value for datapoint %s: %w", datapointType, err)
+		}
+
+		payload, err := dpt.PackChecked(value)
 		if err != nil {
-			return nil, fmt.Errorf("failed to serialize value: %w", err)
+			return nil, fmt.Errorf("invalid value for datapoint %s: %w", datapointType, err)
 		}
-		candidate = marshaled
+
+		return payload, nil
 	}
 
-	if err := json.Unmarshal(candidate, value); err != nil {
-		if parsed, perr := strconv.ParseBool(trimmed); perr == nil {
-			fallback := []byte(strconv.FormatBool(parsed))
-			if err := json.Unmarshal(fallback, value); err == nil {
-				return value.Pack(), nil
-			}
+	// A JSON string allows to send text with leading or trailing white space.
+	if strings.HasPrefix(trimmed, `"`) {
+		var text string
+		if err := json.Unmarshal([]byte(trimmed), &text); err == nil {
+			trimmed = text
 		}
+	}
+
+	payload, err := dpt.EncodeDPTFromString(value, trimmed)
+	if err != nil {
 		return nil, fmt.Errorf("failed to decode value for datapoint %s: %w", datapointType, err)
 	}
 
-	return value.Pack(), nil
+	return payload, nil
 }
 
 func canonicalizeDatapointType(raw string) (string, error) {
@@ -21,3 +21,73 @@ func TestEncodeDatapointValueBooleanNumeric(t *testing.T) {
 		t.Fatalf("expected payload to contain a single 0x01 byte, got %v", payload)
 	}
 }
+
+func TestEncodeDatapointValueFlags(t *testing.T) {
+	payload, err := encodeDatapointValue("21.001", "Fault, InAlarm")
+	if err != nil {
+		t.Fatalf("encodeDatapointValue returned error: %v", err)
+	}
+
+	if len(payload) != 2 || payload[1] != 0x0a {
+		t.Fatalf("expected payload 00 0a, got % x", payload)
+	}
+
+	payload, err = encodeDatapointValue("21.001", `{"OutOfService": true}`)
+	if err != nil {
+		t.Fatalf("encodeDatapointValue returned error: %v", err)
+	}
+
+	if len(payload) != 2 || payload[1] != 0x01 {
+		t.Fatalf("expected payload 00 01, got % x", payload)
+	}
+}
+
+func TestEncodeDatapointValueText(t *testing.T) {
+	cases := []struct {
+		dpt     string
+		literal string
+		want    []byte
+	}{
+		{"1.001", "on", []byte{0x01}},
+		{"1.009", "Open", []byte{0x00}},
+		{"9.001", "21.5 °C", []byte{0x00, 0x0c, 0x33}},
+		{"10.001", "Monday 07:30", []byte{0x00, 0x27, 0x1e, 0x00}},
+		{"16.000", `" padded "`, []byte{0, ' ', 'p', 'a', 'd', 'd', 'e', 'd', ' ', 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range cases {
+		payload, err := encodeDatapointValue(tc.dpt, tc.literal)
+		if err != nil {
+			t.Errorf("encodeDatapointValue(%s, %q) returned error: %v", tc.dpt, tc.literal, err)
+			continue
+		}
+
+		if string(payload) != string(tc.want) {
+			t.Errorf("encodeDatapointValue(%s, %q) = % x, want % x", tc.dpt, tc.literal, payload, tc.want)
+		}
+	}
+
+	if _, err := encodeDatapointValue("9.001", "warm"); err == nil {
+		t.Error("encodeDatapointValue(9.001, warm) expected error")
+	}
+}
+
+func TestEncodeDatapointValueOutOfRange(t *testing.T) {
+	cases := []struct {
+		dpt     string
+		literal string
+	}{
+		{"5.001", "150 %"},
+		{"9.001", "-300 °C"},
+		{"9.001", "NaN"},
+		{"17.001", "64"},
+		{"3.007", `{"Increase":true,"StepCode":9}`},
+		{"5.001", `{"value":101}`},
+	}
+
+	for _, tc := range cases {
+		if payload, err := encodeDatapointValue(tc.dpt, tc.literal); err == nil {
+			t.Errorf("encodeDatapointValue(%s, %q) = % x, want an error", tc.dpt, tc.literal, payload)
+		}
+	}
+}
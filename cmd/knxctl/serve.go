@@ -57,7 +57,7 @@ func runServe(ctx context.Context) error {
 		fmt.Printf("Loaded %d group addresses from %s\n", len(catalog.Groups()), strings.TrimSpace(groupFile))
 	}
 
-	knxgt, err := knx.NewGroupTunnel(fmt.Sprintf("%s:%s", server, port), knx.DefaultTunnelConfig)
+	knxgt, err := knx.NewGroupTunnel(fmt.Sprintf("%s:%s", server, port), tunnelConfig())
 	if err != nil {
 		fmt.Printf("Error while creating: %v\n", err)
 		return err
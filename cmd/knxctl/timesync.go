@@ -0,0 +1,184 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/knx-go/knx-go/knx"
+	"github.com/knx-go/knx-go/knx/cemi"
+	"github.com/knx-go/knx-go/knx/dpt"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	timesyncTimeGroup     string
+	timesyncDateGroup     string
+	timesyncDateTimeGroup string
+	timesyncInterval      time.Duration = time.Minute
+)
+
+func init() {
+	cmd := &cobra.Command{
+		Use:   "timesync",
+		Short: "Periodically send the local date and time to KNX groups",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			applyTimesyncConfig(cmd)
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return timesync()
+		},
+	}
+
+	cmd.Flags().StringVar(&timesyncTimeGroup, "time-group", "", "KNX group address which receives the time of day (DPT 10.001)")
+	cmd.Flags().StringVar(&timesyncDateGroup, "date-group", "", "KNX group address which receives the date (DPT 11.001)")
+	cmd.Flags().StringVar(&timesyncDateTimeGroup, "datetime-group", "", "KNX group address which receives date and time (DPT 19.001)")
+	cmd.Flags().DurationVar(&timesyncInterval, "interval", time.Minute, "time between two updates, 0 sends the values only once")
+
+	root.AddCommand(cmd)
+}
+
+func applyTimesyncConfig(cmd *cobra.Command) {
+	if value := strings.TrimSpace(viper.GetString("timesync.time_group")); value != "" && !flagChanged(cmd, "time-group") {
+		timesyncTimeGroup = value
+	}
+	if value := strings.TrimSpace(viper.GetString("timesync.date_group")); value != "" && !flagChanged(cmd, "date-group") {
+		timesyncDateGroup = value
+	}
+	if value := strings.TrimSpace(viper.GetString("timesync.datetime_group")); value != "" && !flagChanged(cmd, "datetime-group") {
+		timesyncDateTimeGroup = value
+	}
+	if viper.IsSet("timesync.interval") && !flagChanged(cmd, "interval") {
+		if duration, ok := normalizeDuration(viper.Get("timesync.interval")); ok {
+			timesyncInterval = duration
+		}
+	}
+}
+
+// timesyncTarget is a group which receives the time in the given format.
+type timesyncTarget struct {
+	destination cemi.GroupAddr
+	encode      func(time.Time) dpt.DatapointValue
+}
+
+// parseTimesyncTargets resolves the configured group addresses.
+func parseTimesyncTargets(timeGroup, dateGroup, dateTimeGroup string) ([]timesyncTarget, error) {
+	encoders := []struct {
+		group  string
+		encode func(time.Time) dpt.DatapointValue
+	}{
+		{timeGroup, func(t time.Time) dpt.DatapointValue {
+			value := new(dpt.DPT_10001)
+			value.SetTime(t)
+			return value
+		}},
+		{dateGroup, func(t time.Time) dpt.DatapointValue {
+			value := new(dpt.DPT_11001)
+			value.SetTime(t)
+			return value
+		}},
+		{dateTimeGroup, func(t time.Time) dpt.DatapointValue {
+			value := new(dpt.DPT_19001)
+			value.SetTime(t)
+			return value
+		}},
+	}
+
+	var targets []timesyncTarget
+	for _, encoder := range encoders {
+		trimmed := strings.TrimSpace(encoder.group)
+		if trimmed == "" {
+			continue
+		}
+
+		destination, err := cemi.NewGroupAddrString(trimmed)
+		if err != nil {
+			return nil, err
+		}
+
+		targets = append(targets, timesyncTarget{destination: destination, encode: encoder.encode})
+	}
+
+	if len(targets) == 0 {
+		return nil, errors.New("at least one of --time-group, --date-group or --datetime-group must be specified")
+	}
+
+	return targets, nil
+}
+
+// timesyncEvents creates the group writes which publish the given time.
+func timesyncEvents(targets []timesyncTarget, now time.Time) []knx.GroupEvent {
+	events := make([]knx.GroupEvent, 0, len(targets))
+	for _, target := range targets {
+		events = append(events, knx.GroupEvent{
+			Command:     knx.GroupWrite,
+			Destination: target.destination,
+			Data:        target.encode(now).Pack(),
+		})
+	}
+	return events
+}
+
+func timesync() error {
+	targets, err := parseTimesyncTargets(timesyncTimeGroup, timesyncDateGroup, timesyncDateTimeGroup)
+	if err != nil {
+		return err
+	}
+
+	if timesyncInterval < 0 {
+		return fmt.Errorf("invalid interval %v", timesyncInterval)
+	}
+
+	client, err := knx.NewGroupTunnel(fmt.Sprintf("%s:%s", server, port), tunnelConfig())
+	if err != nil {
+		fmt.Printf("Error while creating: %v\n", err)
+		return err
+	}
+	defer client.Close()
+
+	send := func() error {
+		now := time.Now()
+		for _, event := range timesyncEvents(targets, now) {
+			if err := client.Send(event); err != nil {
+				fmt.Printf("Error while sending: %v\n", err)
+				return err
+			}
+		}
+		fmt.Printf("Sent %s\n", now.Format(time.DateTime))
+		return nil
+	}
+
+	if err := send(); err != nil || timesyncInterval == 0 {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	ticker := time.NewTicker(timesyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := send(); err != nil {
+				return err
+			}
+		case _, open := <-client.Inbound():
+			// Incoming group events are of no interest, but the channel tells when the
+			// connection has been closed.
+			if !open {
+				return errors.New("connection closed")
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
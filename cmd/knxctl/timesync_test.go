@@ -0,0 +1,45 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/knx-go/knx-go/knx"
+	"github.com/knx-go/knx-go/knx/cemi"
+)
+
+func TestTimesyncEvents(t *testing.T) {
+	targets, err := parseTimesyncTargets("1/0/1", "", "1/0/3")
+	if err != nil {
+		t.Fatalf("parseTimesyncTargets returned error: %v", err)
+	}
+
+	now := time.Date(2024, time.March, 5, 14, 30, 15, 0, time.UTC)
+	events := timesyncEvents(targets, now)
+
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events))
+	}
+
+	if events[0].Command != knx.GroupWrite || events[0].Destination != cemi.NewGroupAddr3(1, 0, 1) {
+		t.Fatalf("unexpected time event %+v", events[0])
+	}
+
+	if got, want := events[0].Data, []byte{0, 2<<5 | 14, 30, 15}; string(got) != string(want) {
+		t.Fatalf("unexpected time payload % x, want % x", got, want)
+	}
+
+	if events[1].Destination != cemi.NewGroupAddr3(1, 0, 3) || len(events[1].Data) != 9 {
+		t.Fatalf("unexpected date time event %+v", events[1])
+	}
+}
+
+func TestParseTimesyncTargetsRequiresGroup(t *testing.T) {
+	if _, err := parseTimesyncTargets("", " ", ""); err == nil {
+		t.Fatal("expected an error without any group")
+	}
+
+	if _, err := parseTimesyncTargets("not a group", "", ""); err == nil {
+		t.Fatal("expected an error for an invalid group address")
+	}
+}
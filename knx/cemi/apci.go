@@ -0,0 +1,214 @@
+// Copyright 2017 Ole Krüger.
+// Licensed under the MIT license which can be found in the LICENSE file.
+
+package cemi
+
+import "fmt"
+
+// APCI is the Application-layer Protocol Control Information. It holds the full 10-bit service
+// code as it appears on the wire. Services which only use the upper 4 bits (e.g. group value
+// services) carry 6 bits of application data in the lower bits; these bits are always zero in an
+// APCI value and belong to the application data instead.
+type APCI uint16
+
+// These are usable APCI values.
+const (
+	GroupValueRead       APCI = 0x000
+	GroupValueResponse   APCI = 0x040
+	GroupValueWrite      APCI = 0x080
+	PhysicalAddrWrite    APCI = 0x0C0
+	PhysicalAddrRequest  APCI = 0x100
+	PhysicalAddrResponse APCI = 0x140
+	AdcRead              APCI = 0x180
+	AdcResponse          APCI = 0x1C0
+
+	SystemNetworkParameterRead     APCI = 0x1C8
+	SystemNetworkParameterResponse APCI = 0x1C9
+	SystemNetworkParameterWrite    APCI = 0x1CA
+
+	MemoryRead     APCI = 0x200
+	MemoryResponse APCI = 0x240
+	MemoryWrite    APCI = 0x280
+
+	UserMemoryRead                APCI = 0x2C0
+	UserMemoryResponse            APCI = 0x2C1
+	UserMemoryWrite               APCI = 0x2C2
+	UserMemoryBitWrite            APCI = 0x2C4
+	UserManufacturerInfoRead      APCI = 0x2C5
+	UserManufacturerInfoResponse  APCI = 0x2C6
+	FunctionPropertyCommand       APCI = 0x2C7
+	FunctionPropertyStateRead     APCI = 0x2C8
+	FunctionPropertyStateResponse APCI = 0x2C9
+
+	DeviceDescriptorRead     APCI = 0x300
+	DeviceDescriptorResponse APCI = 0x340
+	Restart                  APCI = 0x380
+
+	OpenRoutingTableRequest           APCI = 0x3C0
+	ReadRoutingTableRequest           APCI = 0x3C1
+	ReadRoutingTableResponse          APCI = 0x3C2
+	WriteRoutingTableRequest          APCI = 0x3C3
+	ReadRouterMemoryRequest           APCI = 0x3C8
+	ReadRouterMemoryResponse          APCI = 0x3C9
+	WriteRouterMemoryRequest          APCI = 0x3CA
+	ReadRouterStatusRequest           APCI = 0x3CD
+	ReadRouterStatusResponse          APCI = 0x3CE
+	WriteRouterStatusRequest          APCI = 0x3CF
+	MemoryBitWrite                    APCI = 0x3D0
+	AuthorizeRequest                  APCI = 0x3D1
+	AuthorizeResponse                 APCI = 0x3D2
+	KeyWrite                          APCI = 0x3D3
+	KeyResponse                       APCI = 0x3D4
+	PropertyValueRead                 APCI = 0x3D5
+	PropertyValueResponse             APCI = 0x3D6
+	PropertyValueWrite                APCI = 0x3D7
+	PropertyDescriptionRead           APCI = 0x3D8
+	PropertyDescriptionResponse       APCI = 0x3D9
+	NetworkParameterRead              APCI = 0x3DA
+	NetworkParameterResponse          APCI = 0x3DB
+	PhysicalAddrSerialNumberRead      APCI = 0x3DC
+	PhysicalAddrSerialNumberResponse  APCI = 0x3DD
+	PhysicalAddrSerialNumberWrite     APCI = 0x3DE
+	DomainAddressWrite                APCI = 0x3E0
+	DomainAddressRead                 APCI = 0x3E1
+	DomainAddressResponse             APCI = 0x3E2
+	DomainAddressSelectiveRead        APCI = 0x3E3
+	NetworkParameterWrite             APCI = 0x3E4
+	LinkRead                          APCI = 0x3E5
+	LinkResponse                      APCI = 0x3E6
+	LinkWrite                         APCI = 0x3E7
+	GroupPropValueRead                APCI = 0x3E8
+	GroupPropValueResponse            APCI = 0x3E9
+	GroupPropValueWrite               APCI = 0x3EA
+	GroupPropValueInfoReport          APCI = 0x3EB
+	DomainAddressSerialNumberRead     APCI = 0x3EC
+	DomainAddressSerialNumberResponse APCI = 0x3ED
+	DomainAddressSerialNumberWrite    APCI = 0x3EE
+	FileStreamInfoReport              APCI = 0x3F0
+)
+
+// These are the names of APCI values prior to the support of extended services. They are kept
+// for compatibility.
+const (
+	// UserMessage is the first of the user-defined services.
+	UserMessage = UserMemoryRead
+
+	// MaskVersionRead is a device descriptor read for descriptor type 0.
+	MaskVersionRead = DeviceDescriptorRead
+
+	// MaskVersionResponse is a device descriptor response for descriptor type 0.
+	MaskVersionResponse = DeviceDescriptorResponse
+
+	// Escape is the prefix of all services in the escape range.
+	Escape = OpenRoutingTableRequest
+)
+
+var apciNames = map[APCI]string{
+	GroupValueRead:                    "GroupValueRead",
+	GroupValueResponse:                "GroupValueResponse",
+	GroupValueWrite:                   "GroupValueWrite",
+	PhysicalAddrWrite:                 "PhysicalAddrWrite",
+	PhysicalAddrRequest:               "PhysicalAddrRequest",
+	PhysicalAddrResponse:              "PhysicalAddrResponse",
+	AdcRead:                           "AdcRead",
+	AdcResponse:                       "AdcResponse",
+	SystemNetworkParameterRead:        "SystemNetworkParameterRead",
+	SystemNetworkParameterResponse:    "SystemNetworkParameterResponse",
+	SystemNetworkParameterWrite:       "SystemNetworkParameterWrite",
+	MemoryRead:                        "MemoryRead",
+	MemoryResponse:                    "MemoryResponse",
+	MemoryWrite:                       "MemoryWrite",
+	UserMemoryRead:                    "UserMemoryRead",
+	UserMemoryResponse:                "UserMemoryResponse",
+	UserMemoryWrite:                   "UserMemoryWrite",
+	UserMemoryBitWrite:                "UserMemoryBitWrite",
+	UserManufacturerInfoRead:          "UserManufacturerInfoRead",
+	UserManufacturerInfoResponse:      "UserManufacturerInfoResponse",
+	FunctionPropertyCommand:           "FunctionPropertyCommand",
+	FunctionPropertyStateRead:         "FunctionPropertyStateRead",
+	FunctionPropertyStateResponse:     "FunctionPropertyStateResponse",
+	DeviceDescriptorRead:              "DeviceDescriptorRead",
+	DeviceDescriptorResponse:          "DeviceDescriptorResponse",
+	Restart:                           "Restart",
+	OpenRoutingTableRequest:           "OpenRoutingTableRequest",
+	ReadRoutingTableRequest:           "ReadRoutingTableRequest",
+	ReadRoutingTableResponse:          "ReadRoutingTableResponse",
+	WriteRoutingTableRequest:          "WriteRoutingTableRequest",
+	ReadRouterMemoryRequest:           "ReadRouterMemoryRequest",
+	ReadRouterMemoryResponse:          "ReadRouterMemoryResponse",
+	WriteRouterMemoryRequest:          "WriteRouterMemoryRequest",
+	ReadRouterStatusRequest:           "ReadRouterStatusRequest",
+	ReadRouterStatusResponse:          "ReadRouterStatusResponse",
+	WriteRouterStatusRequest:          "WriteRouterStatusRequest",
+	MemoryBitWrite:                    "MemoryBitWrite",
+	AuthorizeRequest:                  "AuthorizeRequest",
+	AuthorizeResponse:                 "AuthorizeResponse",
+	KeyWrite:                          "KeyWrite",
+	KeyResponse:                       "KeyResponse",
+	PropertyValueRead:                 "PropertyValueRead",
+	PropertyValueResponse:             "PropertyValueResponse",
+	PropertyValueWrite:                "PropertyValueWrite",
+	PropertyDescriptionRead:           "PropertyDescriptionRead",
+	PropertyDescriptionResponse:       "PropertyDescriptionResponse",
+	NetworkParameterRead:              "NetworkParameterRead",
+	NetworkParameterResponse:          "NetworkParameterResponse",
+	PhysicalAddrSerialNumberRead:      "PhysicalAddrSerialNumberRead",
+	PhysicalAddrSerialNumberResponse:  "PhysicalAddrSerialNumberResponse",
+	PhysicalAddrSerialNumberWrite:     "PhysicalAddrSerialNumberWrite",
+	DomainAddressWrite:                "DomainAddressWrite",
+	DomainAddressRead:                 "DomainAddressRead",
+	DomainAddressResponse:             "DomainAddressResponse",
+	DomainAddressSelectiveRead:        "DomainAddressSelectiveRead",
+	NetworkParameterWrite:             "NetworkParameterWrite",
+	LinkRead:                          "LinkRead",
+	LinkResponse:                      "LinkResponse",
+	LinkWrite:                         "LinkWrite",
+	GroupPropValueRead:                "GroupPropValueRead",
+	GroupPropValueResponse:            "GroupPropValueResponse",
+	GroupPropValueWrite:               "GroupPropValueWrite",
+	GroupPropValueInfoReport:          "GroupPropValueInfoReport",
+	DomainAddressSerialNumberRead:     "DomainAddressSerialNumberRead",
+	DomainAddressSerialNumberResponse: "DomainAddressSerialNumberResponse",
+	DomainAddressSerialNumberWrite:    "DomainAddressSerialNumberWrite",
+	FileStreamInfoReport:              "FileStreamInfoReport",
+}
+
+// String converts the APCI to a string.
+func (apci APCI) String() string {
+	if name, ok := apciNames[apci]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("%#x", uint16(apci))
+}
+
+// IsGroupCommand determines if the APCI indicates a group command.
+func (apci APCI) IsGroupCommand() bool {
+	return apci == GroupValueRead || apci == GroupValueResponse || apci == GroupValueWrite
+}
+
+// IsExtended determines if the APCI occupies all 10 bits. Services that are not extended only use
+// the upper 4 bits and leave the lower 6 bits to the application data.
+func (apci APCI) IsExtended() bool {
+	switch apci >> 6 {
+	case UserMessage >> 6, Escape >> 6:
+		return true
+
+	case AdcResponse >> 6:
+		return apci >= SystemNetworkParameterRead && apci <= SystemNetworkParameterWrite
+	}
+
+	return false
+}
+
+// unpackAPCI extracts the APCI from the two octets that contain it. For services which are not
+// extended, the lower 6 bits are masked out.
+func unpackAPCI(hi, lo byte) APCI {
+	apci := APCI(hi&3)<<8 | APCI(lo)
+
+	if !apci.IsExtended() {
+		apci &^= 63
+	}
+
+	return apci
+}
@@ -6,6 +6,7 @@ package cemi
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/knx-go/knx-go/knx/util"
 )
@@ -93,17 +94,35 @@ func (info Info) Pack(buffer []byte) {
 
 // Unpack initializes the structure by parsing the given data.
 func (info *Info) Unpack(data []byte) (n uint, err error) {
-	var length uint8
+	return info.unpack(data, false)
+}
 
-	n, err = util.Unpack(data, &length)
-	if err != nil {
-		return
+// unpack parses the given data. If reuse is set, the current buffer is reused if it is large
+// enough.
+func (info *Info) unpack(data []byte, reuse bool) (n uint, err error) {
+	if len(data) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	length := data[0]
+	n = 1
+
+	if len(data) < 1+int(length) {
+		return n, io.ErrUnexpectedEOF
 	}
 
 	if length > 0 {
-		buf := make([]byte, length)
+		var buf []byte
+		if reuse && cap(*info) >= int(length) {
+			buf = (*info)[:length]
+		} else {
+			buf = make([]byte, length)
+		}
+
 		n += uint(copy(buf, data[n:n+uint(length)]))
 		*info = Info(buf)
+	} else if reuse {
+		*info = (*info)[:0]
 	} else {
 		*info = nil
 	}
@@ -140,8 +159,10 @@ func (body *UnsupportedMessage) Pack(buffer []byte) {
 
 // Unpack initializes the structure by parsing the given data.
 func (body *UnsupportedMessage) Unpack(data []byte) (uint, error) {
-	if len(body.Data) < len(data) {
+	if cap(body.Data) < len(data) {
 		body.Data = make([]byte, len(data))
+	} else {
+		body.Data = body.Data[:len(data)]
 	}
 
 	return uint(copy(body.Data, data)), nil
@@ -152,6 +173,11 @@ type messageUnpackable interface {
 	Message
 }
 
+// messageReusable is implemented by messages which can reuse their buffers during unpacking.
+type messageReusable interface {
+	unpackInto(data []byte) (uint, error)
+}
+
 // Unpack a message from a CEMI-encoded frame.
 func Unpack(data []byte, message *Message) (n uint, err error) {
 	var code MessageCode
@@ -201,6 +227,35 @@ func Unpack(data []byte, message *Message) (n uint, err error) {
 	return n + m, err
 }
 
+// UnpackInto works like Unpack, but reuses the message which message points to if it has the same
+// message code as the frame. Its buffers, e.g. the application data, are reused as well. This
+// avoids allocations when many frames are parsed in a row. The previous contents of the message
+// must not be referenced anymore. Unlike Unpack, the message may be modified even if an error is
+// returned.
+func UnpackInto(data []byte, message *Message) (n uint, err error) {
+	// Read header.
+	if len(data) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	code := MessageCode(data[0])
+	n = 1
+
+	body, ok := (*message).(messageUnpackable)
+	if !ok || body.MessageCode() != code {
+		return Unpack(data, message)
+	}
+
+	var m uint
+	if reusable, ok := body.(messageReusable); ok {
+		m, err = reusable.unpackInto(data[n:])
+	} else {
+		m, err = body.Unpack(data[n:])
+	}
+
+	return n + m, err
+}
+
 // Size returns the size for a CEMI-encoded frame with the given message.
 func Size(message Message) uint {
 	return 1 + message.Size()
@@ -74,3 +74,88 @@ func TestUnpack(t *testing.T) {
 		}
 	}
 }
+
+func TestUnpackInto(t *testing.T) {
+	frames := [][]byte{
+		{0x29, 0x00, 0xbc, 0xe0, 0x11, 0x01, 0x0a, 0x03, 0x03, 0x00, 0x80, 0x0c, 0x1a},
+		{0x29, 0x00, 0xbc, 0xe0, 0x11, 0x02, 0x0a, 0x04, 0x01, 0x00, 0x81},
+		{0x29, 0x02, 0x03, 0x04, 0xbc, 0xe0, 0x11, 0x01, 0x11, 0x02, 0x02, 0x03, 0xd5, 0x01},
+		{0x2e, 0x00, 0xbc, 0xe0, 0x11, 0x01, 0x0a, 0x03, 0x01, 0x00, 0x80},
+		{0x2b, 0x00, 0x01, 0x02, 0x03},
+		{0x2b, 0x00, 0x01},
+	}
+
+	var msg Message
+
+	for _, frame := range frames {
+		var expected Message
+		if _, err := Unpack(frame, &expected); err != nil {
+			t.Fatal("Unexpected error:", err, frame)
+		}
+
+		previous := msg
+
+		num, err := UnpackInto(frame, &msg)
+		if err != nil {
+			t.Fatal("Unexpected error:", err, frame)
+		}
+
+		if num != uint(len(frame)) {
+			t.Error("Unexpected length:", num, len(frame), frame)
+		}
+
+		if previous != nil && previous.MessageCode() == expected.MessageCode() && previous != msg {
+			t.Error("Message has not been reused:", frame)
+		}
+
+		if !bytes.Equal(allocAndPack(msg), frame) || !bytes.Equal(allocAndPack(msg), allocAndPack(expected)) {
+			t.Error("Unexpected result:", msg, frame)
+		}
+	}
+}
+
+func allocAndPack(msg Message) []byte {
+	buffer := make([]byte, Size(msg))
+	Pack(buffer, msg)
+	return buffer
+}
+
+var benchmarkFrame = []byte{0x29, 0x00, 0xbc, 0xe0, 0x11, 0x01, 0x0a, 0x03, 0x03, 0x00, 0x80, 0x0c, 0x1a}
+
+func BenchmarkUnpack(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var msg Message
+		if _, err := Unpack(benchmarkFrame, &msg); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnpackInto(b *testing.B) {
+	b.ReportAllocs()
+
+	var msg Message
+
+	for i := 0; i < b.N; i++ {
+		if _, err := UnpackInto(benchmarkFrame, &msg); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPack(b *testing.B) {
+	b.ReportAllocs()
+
+	var msg Message
+	if _, err := Unpack(benchmarkFrame, &msg); err != nil {
+		b.Fatal(err)
+	}
+
+	buffer := make([]byte, Size(msg))
+
+	for i := 0; i < b.N; i++ {
+		Pack(buffer, msg)
+	}
+}
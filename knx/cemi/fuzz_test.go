@@ -0,0 +1,90 @@
+package cemi
+
+import (
+	"bytes"
+	"testing"
+)
+
+// fuzzFrames are CEMI-encoded frames captured from real installations.
+var fuzzFrames = [][]byte{
+	// L_Data.ind GroupValueWrite 1.1.1 -> 1/2/3 with 2 bytes of data
+	{0x29, 0x00, 0xbc, 0xe0, 0x11, 0x01, 0x0a, 0x03, 0x03, 0x00, 0x80, 0x0c, 0x1a},
+	// L_Data.ind GroupValueWrite with a small value
+	{0x29, 0x00, 0xbc, 0xd0, 0x11, 0x02, 0x0a, 0x04, 0x01, 0x00, 0x81},
+	// L_Data.req GroupValueRead
+	{0x11, 0x00, 0xbc, 0xe0, 0x00, 0x00, 0x0a, 0x03, 0x01, 0x00, 0x00},
+	// L_Data.con with additional info
+	{0x2e, 0x02, 0x03, 0x04, 0xbc, 0xe0, 0x11, 0x01, 0x0a, 0x03, 0x01, 0x00, 0x80},
+	// L_Data.ind PropertyValueRead to an individual address
+	{0x29, 0x00, 0xb0, 0x60, 0x11, 0x01, 0x11, 0x02, 0x05, 0x03, 0xd5, 0x00, 0x0b, 0x10, 0x01},
+	// L_Data.ind T_Connect
+	{0x29, 0x00, 0xb0, 0x60, 0x11, 0x01, 0x11, 0x02, 0x00, 0x80},
+	// L_Busmon.ind
+	{0x2b, 0x07, 0x03, 0x01, 0x04, 0x02, 0x00, 0x00, 0xbc, 0x11, 0x01, 0x0a, 0x03, 0xe1, 0x00, 0x81, 0x3a},
+	// L_Raw.ind
+	{0x2d, 0xbc, 0x11, 0x01, 0x0a, 0x03, 0xe1, 0x00, 0x81},
+}
+
+func FuzzUnpack(f *testing.F) {
+	for _, frame := range fuzzFrames {
+		f.Add(frame)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var msg Message
+
+		n, err := Unpack(data, &msg)
+		if err != nil {
+			return
+		}
+
+		if n > uint(len(data)) {
+			t.Fatalf("Unpack consumed %d bytes of %d", n, len(data))
+		}
+
+		// Packing the message must not fail either.
+		Pack(make([]byte, Size(msg)), msg)
+	})
+}
+
+func FuzzUnpackInto(f *testing.F) {
+	for _, frame := range fuzzFrames {
+		f.Add(frame, fuzzFrames[0])
+	}
+
+	f.Fuzz(func(t *testing.T, data, previous []byte) {
+		var msg Message
+		Unpack(previous, &msg)
+
+		n, err := UnpackInto(data, &msg)
+		if err != nil {
+			return
+		}
+
+		var expected Message
+		m, err := Unpack(data, &expected)
+		if err != nil || n != m {
+			t.Fatalf("UnpackInto and Unpack disagree: %d %d %v", n, m, err)
+		}
+
+		if !bytes.Equal(allocAndPack(msg), allocAndPack(expected)) {
+			t.Fatalf("UnpackInto and Unpack disagree: %v %v", msg, expected)
+		}
+	})
+}
+
+func FuzzUnpackTP(f *testing.F) {
+	f.Add([]byte{0xbc, 0x11, 0x01, 0x0a, 0x03, 0xe1, 0x00, 0x81})
+	f.Add([]byte{0xb0, 0x11, 0x02, 0x11, 0x01, 0x60, 0x80})
+	f.Add([]byte{0x3c, 0xe0, 0x11, 0x01, 0x0a, 0x03, 0x01, 0x00, 0x80})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var ldata LData
+
+		if _, err := ldata.UnpackTP(data); err != nil {
+			return
+		}
+
+		ldata.PackTP(make([]byte, ldata.TPSize()))
+	})
+}
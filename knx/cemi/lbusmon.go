@@ -25,8 +25,10 @@ func (lbm LBusmonInd) Pack(buffer []byte) {
 func (lbm *LBusmonInd) Unpack(data []byte) (n uint, err error) {
 	target := []byte(*lbm)
 
-	if len(target) < len(data) {
+	if cap(target) < len(data) {
 		target = make([]byte, len(data))
+	} else {
+		target = target[:len(data)]
 	}
 
 	n = uint(copy(target, data))
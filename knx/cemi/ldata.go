@@ -3,7 +3,7 @@
 
 package cemi
 
-import "github.com/knx-go/knx-go/knx/util"
+import "io"
 
 // A LData is a link-layer data frame. L_Data.req, L_Data.con and L_Data.ind share this structure.
 type LData struct {
@@ -17,18 +17,33 @@ type LData struct {
 
 // Unpack initializes the structure by parsing the given data.
 func (ldata *LData) Unpack(data []byte) (n uint, err error) {
-	if n, err = util.UnpackSome(
-		data,
-		&ldata.Info,
-		(*uint8)(&ldata.Control1),
-		(*uint8)(&ldata.Control2),
-		(*uint16)(&ldata.Source),
-		&ldata.Destination,
-	); err != nil {
+	return ldata.unpack(data, false)
+}
+
+// unpackInto works like Unpack, but reuses the additional info and the transport unit.
+func (ldata *LData) unpackInto(data []byte) (n uint, err error) {
+	return ldata.unpack(data, true)
+}
+
+// unpack parses the given data. If reuse is set, the current buffers are reused where possible.
+func (ldata *LData) unpack(data []byte, reuse bool) (n uint, err error) {
+	if n, err = ldata.Info.unpack(data, reuse); err != nil {
 		return
 	}
 
-	m, err := unpackTransportUnit(data[n:], &ldata.Data)
+	// The fields are decoded by hand, because passing them to util.UnpackSome causes allocations.
+	header := data[n:]
+	if len(header) < 6 {
+		return n, io.ErrUnexpectedEOF
+	}
+
+	ldata.Control1 = ControlField1(header[0])
+	ldata.Control2 = ControlField2(header[1])
+	ldata.Source = PhysicalAddr(uint16(header[2])<<8 | uint16(header[3]))
+	ldata.Destination = uint16(header[4])<<8 | uint16(header[5])
+	n += 6
+
+	m, err := unpackTransportUnit(data[n:], &ldata.Data, reuse)
 	n += m
 
 	return
@@ -41,15 +56,17 @@ func (ldata *LData) Size() uint {
 
 // Pack the message body into the buffer.
 func (ldata *LData) Pack(buffer []byte) {
-	util.PackSome(
-		buffer,
-		ldata.Info,
-		uint8(ldata.Control1),
-		uint8(ldata.Control2),
-		uint16(ldata.Source),
-		ldata.Destination,
-		ldata.Data,
-	)
+	ldata.Info.Pack(buffer)
+
+	header := buffer[ldata.Info.Size():]
+	header[0] = byte(ldata.Control1)
+	header[1] = byte(ldata.Control2)
+	header[2] = byte(ldata.Source >> 8)
+	header[3] = byte(ldata.Source)
+	header[4] = byte(ldata.Destination >> 8)
+	header[5] = byte(ldata.Destination)
+
+	ldata.Data.Pack(header[6:])
 }
 
 // A LDataReq represents a L_Data.req message body.
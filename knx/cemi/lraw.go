@@ -20,8 +20,10 @@ func (lraw LRaw) Pack(buffer []byte) {
 func (lraw *LRaw) Unpack(data []byte) (n uint, err error) {
 	target := []byte(*lraw)
 
-	if len(target) < len(data) {
+	if cap(target) < len(data) {
 		target = make([]byte, len(data))
+	} else {
+		target = target[:len(data)]
 	}
 
 	n = uint(copy(target, data))
@@ -0,0 +1,93 @@
+package cemi
+
+import (
+	"io"
+
+	"github.com/knx-go/knx-go/knx/util"
+)
+
+// IsStdFrame determines if the frame is a standard frame.
+func (ldata *LData) IsStdFrame() bool {
+	return ldata.Control1&Control1StdFrame == Control1StdFrame
+}
+
+// tpStdFrame determines if the frame can be packed as a standard frame on TP1 media. Its length
+// field has only 4 bits, hence longer transport units require an extended frame.
+func (ldata *LData) tpStdFrame() bool {
+	return ldata.IsStdFrame() && ldata.Data.Size()-2 <= 15
+}
+
+// TPSize returns the size of the frame in the format that is used on TP1 media, excluding the
+// trailing checksum.
+func (ldata *LData) TPSize() uint {
+	if ldata.tpStdFrame() {
+		return 5 + ldata.Data.Size()
+	}
+
+	return 6 + ldata.Data.Size()
+}
+
+// PackTP packs the frame in the format that is used on TP1 media, excluding the trailing checksum.
+// EMI1 and EMI2 use the same format following the message code. The additional info segment is
+// not part of this format and will be omitted. Standard frames whose transport unit carries more
+// than 15 octets after the TPCI are packed as extended frames.
+func (ldata *LData) PackTP(buffer []byte) {
+	if ldata.tpStdFrame() {
+		buffer[0] = 0x90 | byte(ldata.Control1)&0x2C
+		util.PackSome(buffer[1:], uint16(ldata.Source), ldata.Destination)
+
+		// The length byte of the transport unit shares its octet with the address type and
+		// hop count.
+		ldata.Data.Pack(buffer[5:])
+		buffer[5] = byte(ldata.Control2)&0xF0 | buffer[5]&0x0F
+	} else {
+		buffer[0] = 0x10 | byte(ldata.Control1)&0x2C
+		util.PackSome(buffer[1:], uint8(ldata.Control2), uint16(ldata.Source), ldata.Destination)
+		ldata.Data.Pack(buffer[6:])
+	}
+}
+
+// UnpackTP initializes the structure by parsing the given data in the format that is used on TP1
+// media, excluding the trailing checksum.
+func (ldata *LData) UnpackTP(data []byte) (n uint, err error) {
+	if len(data) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	ldata.Info = nil
+	ldata.Control1 = ControlField1(data[0] & 0xBC)
+
+	if ldata.IsStdFrame() {
+		if len(data) < 6 {
+			return 0, io.ErrUnexpectedEOF
+		}
+
+		n, err = util.UnpackSome(data[1:], (*uint16)(&ldata.Source), &ldata.Destination)
+		if err != nil {
+			return
+		}
+
+		ldata.Control2 = ControlField2(data[5] & 0xF0)
+
+		// The transport unit expects a dedicated length byte.
+		tpdu := make([]byte, len(data)-5)
+		tpdu[0] = data[5] & 0x0F
+		copy(tpdu[1:], data[6:])
+
+		m, err := unpackTransportUnit(tpdu, &ldata.Data, false)
+		return 5 + m, err
+	}
+
+	n, err = util.UnpackSome(
+		data[1:],
+		(*uint8)(&ldata.Control2),
+		(*uint16)(&ldata.Source),
+		&ldata.Destination,
+	)
+	if err != nil {
+		return
+	}
+
+	m, err := unpackTransportUnit(data[6:], &ldata.Data, false)
+	return 6 + m, err
+}
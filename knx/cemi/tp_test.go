@@ -0,0 +1,139 @@
+package cemi
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestLData_PackTP(t *testing.T) {
+	ldata := LData{
+		Control1:    Control1StdFrame | Control1NoRepeat | Control1NoSysBroadcast | Control1Prio(PrioLow),
+		Control2:    Control2GroupAddr | Control2Hops(6),
+		Source:      PhysicalAddr(0x1101),
+		Destination: uint16(NewGroupAddr3(1, 2, 3)),
+		Data: &AppData{
+			Command: GroupValueWrite,
+			Data:    []byte{1},
+		},
+	}
+
+	expected := []byte{0xBC, 0x11, 0x01, 0x0A, 0x03, 0xE1, 0x00, 0x81}
+
+	buffer := make([]byte, ldata.TPSize())
+	ldata.PackTP(buffer)
+
+	if !bytes.Equal(buffer, expected) {
+		t.Fatalf("Unexpected frame: % X", buffer)
+	}
+
+	var unpacked LData
+	n, err := unpacked.UnpackTP(buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n != uint(len(buffer)) {
+		t.Errorf("Unexpected length: %d", n)
+	}
+
+	if unpacked.Control1 != ldata.Control1 || unpacked.Control2 != ldata.Control2 {
+		t.Errorf("Unexpected control fields: %#x %#x", unpacked.Control1, unpacked.Control2)
+	}
+
+	if unpacked.Source != ldata.Source || unpacked.Destination != ldata.Destination {
+		t.Errorf("Unexpected addresses: %v %v", unpacked.Source, unpacked.Destination)
+	}
+
+	app, ok := unpacked.Data.(*AppData)
+	if !ok || app.Command != GroupValueWrite || !bytes.Equal(app.Data, []byte{1}) {
+		t.Errorf("Unexpected transport unit: %+v", unpacked.Data)
+	}
+}
+
+func TestLData_PackTPExtended(t *testing.T) {
+	ldata := LData{
+		Control1:    Control1NoRepeat | Control1NoSysBroadcast | Control1Prio(PrioLow),
+		Control2:    Control2GroupAddr | Control2Hops(6),
+		Source:      PhysicalAddr(0x1101),
+		Destination: uint16(NewGroupAddr3(1, 2, 3)),
+		Data: &AppData{
+			Command: GroupValueWrite,
+			Data:    makeRandBuffer(20),
+		},
+	}
+
+	ldata.Data.(*AppData).Data[0] = 0
+
+	buffer := make([]byte, ldata.TPSize())
+	ldata.PackTP(buffer)
+
+	if buffer[0] != 0x3C || buffer[1] != 0xE0 || buffer[6] != 20 {
+		t.Fatalf("Unexpected frame: % X", buffer)
+	}
+
+	var unpacked LData
+	if _, err := unpacked.UnpackTP(buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	app, ok := unpacked.Data.(*AppData)
+	if !ok || !bytes.Equal(app.Data, ldata.Data.(*AppData).Data) {
+		t.Errorf("Unexpected transport unit: %+v", unpacked.Data)
+	}
+}
+
+func TestLData_PackTPLongStdFrame(t *testing.T) {
+	ldata := LData{
+		Control1:    Control1StdFrame | Control1NoRepeat | Control1NoSysBroadcast | Control1Prio(PrioLow),
+		Control2:    Control2GroupAddr | Control2Hops(6),
+		Source:      PhysicalAddr(0x1101),
+		Destination: uint16(NewGroupAddr3(1, 2, 3)),
+		Data: &AppData{
+			Command: GroupValueWrite,
+			Data:    append([]byte{0}, "KNX 28.001 text"...),
+		},
+	}
+
+	// The length field of a standard frame cannot hold 16.
+	if size := ldata.TPSize(); size != 6+18 {
+		t.Fatalf("Unexpected size: %d", size)
+	}
+
+	buffer := make([]byte, ldata.TPSize())
+	ldata.PackTP(buffer)
+
+	if buffer[0] != 0x3C || buffer[1] != 0xE0 || buffer[6] != 16 {
+		t.Fatalf("Unexpected frame: % X", buffer)
+	}
+
+	var unpacked LData
+	n, err := unpacked.UnpackTP(buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n != uint(len(buffer)) {
+		t.Errorf("Unexpected length: %d", n)
+	}
+
+	app, ok := unpacked.Data.(*AppData)
+	if !ok || !bytes.Equal(app.Data, ldata.Data.(*AppData).Data) {
+		t.Errorf("Unexpected transport unit: %+v", unpacked.Data)
+	}
+}
+
+func TestLData_UnpackTPTruncated(t *testing.T) {
+	frames := [][]byte{
+		{},
+		{0xBC, 0x11, 0x01},
+		{0xBC, 0x11, 0x01, 0x0A, 0x03, 0xE1},
+		{0x3C, 0xE0, 0x11, 0x01, 0x0A},
+	}
+
+	for _, frame := range frames {
+		var ldata LData
+		if _, err := ldata.UnpackTP(frame); err == nil {
+			t.Errorf("Should not succeed for % X", frame)
+		}
+	}
+}
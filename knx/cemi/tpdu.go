@@ -9,35 +9,9 @@ import (
 	"github.com/knx-go/knx-go/knx/util"
 )
 
-// APCI is the Application-layer Protocol Control Information.
-type APCI uint8
-
-// IsGroupCommand determines if the APCI indicates a group command.
-func (apci APCI) IsGroupCommand() bool {
-	return apci < 3
-}
-
-// These are usable APCI values.
-const (
-	GroupValueRead       APCI = 0
-	GroupValueResponse   APCI = 1
-	GroupValueWrite      APCI = 2
-	PhysicalAddrWrite    APCI = 3
-	PhysicalAddrRequest  APCI = 4
-	PhysicalAddrResponse APCI = 5
-	AdcRead              APCI = 6
-	AdcResponse          APCI = 7
-	MemoryRead           APCI = 8
-	MemoryResponse       APCI = 9
-	MemoryWrite          APCI = 10
-	UserMessage          APCI = 11
-	MaskVersionRead      APCI = 12
-	MaskVersionResponse  APCI = 13
-	Restart              APCI = 14
-	Escape               APCI = 15
-)
-
-// An AppData contains application data in a transport unit.
+// An AppData contains application data in a transport unit. If the command is not extended, the
+// lower 6 bits of the first data byte are packed alongside the command. Otherwise the data follows
+// the command.
 type AppData struct {
 	Numbered  bool
 	SeqNumber uint8
@@ -45,9 +19,13 @@ type AppData struct {
 	Data      []byte
 }
 
-// Size retrieves the packed size.
-func (app *AppData) Size() uint {
-	dataLength := uint(len(app.Data))
+// dataLength determines the value of the length byte.
+func (app *AppData) dataLength() int {
+	dataLength := len(app.Data)
+
+	if app.Command.IsExtended() {
+		dataLength++
+	}
 
 	if dataLength > 255 {
 		dataLength = 255
@@ -55,18 +33,17 @@ func (app *AppData) Size() uint {
 		dataLength = 1
 	}
 
-	return 2 + dataLength
+	return dataLength
+}
+
+// Size retrieves the packed size.
+func (app *AppData) Size() uint {
+	return 2 + uint(app.dataLength())
 }
 
 // Pack into a transport data unit including its leading length byte.
 func (app *AppData) Pack(buffer []byte) {
-	dataLength := len(app.Data)
-
-	if dataLength > 255 {
-		dataLength = 255
-	} else if dataLength < 1 {
-		dataLength = 1
-	}
+	dataLength := app.dataLength()
 
 	buffer[0] = byte(dataLength)
 
@@ -74,12 +51,17 @@ func (app *AppData) Pack(buffer []byte) {
 		buffer[1] |= 1<<6 | (app.SeqNumber&15)<<2
 	}
 
-	buffer[1] |= byte(app.Command>>2) & 3
+	buffer[1] |= byte(app.Command>>8) & 3
 
-	copy(buffer[2:], app.Data)
+	if app.Command.IsExtended() {
+		buffer[2] = byte(app.Command)
+		copy(buffer[3:2+dataLength], app.Data)
+	} else {
+		copy(buffer[2:2+dataLength], app.Data)
 
-	buffer[2] &= 63
-	buffer[2] |= byte(app.Command&3) << 6
+		buffer[2] &= 63
+		buffer[2] |= byte(app.Command)
+	}
 }
 
 // A ControlData encodes control information in a transport unit.
@@ -110,19 +92,22 @@ type TransportUnit interface {
 }
 
 // unpackTransportUnit parses the given data in order to extract the transport unit that it encodes.
-func unpackTransportUnit(data []byte, unit *TransportUnit) (uint, error) {
+func unpackTransportUnit(data []byte, unit *TransportUnit, reuse bool) (uint, error) {
 	if len(data) < 2 {
 		return 0, io.ErrUnexpectedEOF
 	}
 
 	// Does unit contain control information?
 	if (data[1] & (1 << 7)) == 1<<7 {
-		control := &ControlData{
-			Numbered:  (data[1] & (1 << 6)) == 1<<6,
-			SeqNumber: (data[1] >> 2) & 15,
-			Command:   data[1] & 3,
+		control, ok := (*unit).(*ControlData)
+		if !reuse || !ok {
+			control = &ControlData{}
 		}
 
+		control.Numbered = (data[1] & (1 << 6)) == 1<<6
+		control.SeqNumber = (data[1] >> 2) & 15
+		control.Command = data[1] & 3
+
 		*unit = control
 
 		return 2, nil
@@ -130,19 +115,36 @@ func unpackTransportUnit(data []byte, unit *TransportUnit) (uint, error) {
 
 	dataLength := int(data[0])
 
-	if len(data) < 3 || dataLength+2 < len(data) {
+	if dataLength < 1 || len(data) < dataLength+2 {
 		return 0, io.ErrUnexpectedEOF
 	}
 
-	app := &AppData{
-		Numbered:  (data[1] & (1 << 6)) == 1<<6,
-		SeqNumber: (data[1] >> 2) & 15,
-		Command:   APCI((data[1]&3)<<2 | data[2]>>6),
-		Data:      make([]byte, dataLength),
+	app, ok := (*unit).(*AppData)
+	if !reuse || !ok {
+		app = &AppData{}
 	}
 
-	copy(app.Data, data[2:])
-	app.Data[0] &= 63
+	app.Numbered = (data[1] & (1 << 6)) == 1<<6
+	app.SeqNumber = (data[1] >> 2) & 15
+	app.Command = unpackAPCI(data[1], data[2])
+
+	offset := 2
+	if app.Command.IsExtended() {
+		offset = 3
+	}
+
+	length := dataLength + 2 - offset
+	if reuse && cap(app.Data) >= length {
+		app.Data = app.Data[:length]
+	} else {
+		app.Data = make([]byte, length)
+	}
+
+	copy(app.Data, data[offset:])
+
+	if !app.Command.IsExtended() {
+		app.Data[0] &= 63
+	}
 
 	*unit = app
 
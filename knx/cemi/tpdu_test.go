@@ -11,12 +11,24 @@ import (
 	"github.com/knx-go/knx-go/knx/util"
 )
 
+var shortAPCIs = []APCI{
+	GroupValueRead, GroupValueResponse, GroupValueWrite, PhysicalAddrWrite, PhysicalAddrRequest,
+	PhysicalAddrResponse, AdcRead, AdcResponse, MemoryRead, MemoryResponse, MemoryWrite,
+	DeviceDescriptorRead, DeviceDescriptorResponse, Restart,
+}
+
+var extendedAPCIs = []APCI{
+	SystemNetworkParameterRead, UserMemoryRead, FunctionPropertyCommand, AuthorizeRequest, KeyWrite,
+	PropertyValueRead, PropertyValueResponse, NetworkParameterRead, DomainAddressWrite,
+	DomainAddressSelectiveRead, NetworkParameterWrite, GroupPropValueRead, FileStreamInfoReport,
+}
+
 func TestAppData_Pack(t *testing.T) {
 	for i := 0; i < 100; i++ {
 		app := AppData{
 			Numbered:  randInt(2) == 0,
 			SeqNumber: uint8(randInt(15)),
-			Command:   APCI(randInt(15)),
+			Command:   shortAPCIs[randInt(len(shortAPCIs))],
 			Data:      makeRandBuffer(randInt(300)),
 		}
 
@@ -52,7 +64,7 @@ func TestAppData_Pack(t *testing.T) {
 			t.Error("Unexpected sequence number", (data[1]>>2)&15, app.SeqNumber)
 		}
 
-		apci := APCI((data[1]&3)<<2 | data[2]>>6)
+		apci := APCI(data[1]&3)<<8 | APCI(data[2]&^63)
 		if apci != app.Command {
 			t.Error("Unexpected command:", apci, app.Command)
 		}
@@ -67,6 +79,52 @@ func TestAppData_Pack(t *testing.T) {
 	}
 }
 
+func TestAppData_PackExtended(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		app := AppData{
+			Numbered:  randInt(2) == 0,
+			SeqNumber: uint8(randInt(15)),
+			Command:   extendedAPCIs[randInt(len(extendedAPCIs))],
+			Data:      makeRandBuffer(randInt(254)),
+		}
+
+		data := util.AllocAndPack(&app)
+
+		if len(data) != 3+len(app.Data) {
+			t.Error("Unexpected length:", len(data), app)
+			continue
+		}
+
+		if int(data[0]) != len(app.Data)+1 {
+			t.Error("Unexpected unit length:", data[0], app)
+		}
+
+		apci := APCI(data[1]&3)<<8 | APCI(data[2])
+		if apci != app.Command {
+			t.Error("Unexpected command:", apci, app.Command)
+		}
+
+		if !bytes.Equal(data[3:], app.Data) {
+			t.Error("Data mismatch", data[3:], app.Data)
+		}
+	}
+}
+
+func TestAPCI_String(t *testing.T) {
+	cases := map[APCI]string{
+		GroupValueWrite:   "GroupValueWrite",
+		PropertyValueRead: "PropertyValueRead",
+		MaskVersionRead:   "DeviceDescriptorRead",
+		APCI(0x3FF):       "0x3ff",
+	}
+
+	for apci, expected := range cases {
+		if apci.String() != expected {
+			t.Errorf("Unexpected string for %#x: %s", uint16(apci), apci.String())
+		}
+	}
+}
+
 func TestControlData_Pack(t *testing.T) {
 	for i := 0; i < 100; i++ {
 		control := ControlData{
@@ -111,7 +169,7 @@ func TestUnpackTransportUnit(t *testing.T) {
 			data[1] |= 1 << 7
 
 			var unit TransportUnit
-			num, err := unpackTransportUnit(data, &unit)
+			num, err := unpackTransportUnit(data, &unit, false)
 			if err != nil {
 				t.Error("Unexpected error:", err, data)
 				continue
@@ -151,7 +209,7 @@ func TestUnpackTransportUnit(t *testing.T) {
 			data[1] &= ^(byte(1) << 7)
 
 			var unit TransportUnit
-			num, err := unpackTransportUnit(data, &unit)
+			num, err := unpackTransportUnit(data, &unit, false)
 			if err != nil {
 				t.Error("Unexpected error:", err, data)
 				continue
@@ -176,9 +234,21 @@ func TestUnpackTransportUnit(t *testing.T) {
 				t.Error("Unexpected sequence number:", app.SeqNumber, (data[1]>>2)&15)
 			}
 
-			apci := APCI((data[1]&3)<<2 | data[2]>>6)
-			if app.Command != apci {
-				t.Error("Unexpected command:", app.Command, apci)
+			apci := APCI(data[1]&3)<<8 | APCI(data[2])
+			if app.Command.IsExtended() {
+				if app.Command != apci {
+					t.Error("Unexpected command:", app.Command, apci)
+				}
+
+				if !bytes.Equal(data[3:], app.Data) {
+					t.Error("Data mismatch", data[3:], app.Data)
+				}
+
+				continue
+			}
+
+			if app.Command != apci&^63 {
+				t.Error("Unexpected command:", app.Command, apci&^63)
 			}
 
 			if len(app.Data) > 0 && data[2]&63 != app.Data[0]&63 {
@@ -190,4 +260,15 @@ func TestUnpackTransportUnit(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("Truncated", func(t *testing.T) {
+		var unit TransportUnit
+		if _, err := unpackTransportUnit([]byte{4, 0, 0x80}, &unit, false); err == nil {
+			t.Error("Should not succeed")
+		}
+
+		if _, err := unpackTransportUnit([]byte{0, 0, 0x80}, &unit, false); err == nil {
+			t.Error("Should not succeed")
+		}
+	})
 }
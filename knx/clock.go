@@ -0,0 +1,38 @@
+package knx
+
+import "time"
+
+// A clock provides the current time and timers. It allows tests to control the passing of time.
+type clock interface {
+	Now() time.Time
+	NewTimer(d time.Duration) clockTimer
+}
+
+// A clockTimer is a timer created by a clock.
+type clockTimer interface {
+	C() <-chan time.Time
+	Stop() bool
+}
+
+// realClock is the clock backed by the time package.
+type realClock struct{}
+
+// Now returns the current time.
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// NewTimer creates a timer which fires after the given duration.
+func (realClock) NewTimer(d time.Duration) clockTimer {
+	return realTimer{time.NewTimer(d)}
+}
+
+// realTimer wraps a timer of the time package.
+type realTimer struct {
+	*time.Timer
+}
+
+// C returns the channel on which the timer fires.
+func (timer realTimer) C() <-chan time.Time {
+	return timer.Timer.C
+}
@@ -0,0 +1,211 @@
+package dpt
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// The conversions between RGB and xyY assume the sRGB colour space with the D65 white point.
+
+// srgbToLinear removes the gamma correction of a sRGB component in the range [0, 1].
+func srgbToLinear(c float64) float64 {
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
+
+// linearToSRGB applies the gamma correction to a linear component in the range [0, 1].
+func linearToSRGB(c float64) float64 {
+	if c <= 0.0031308 {
+		return 12.92 * c
+	}
+
+	return 1.055*math.Pow(c, 1/2.4) - 0.055
+}
+
+// toComponent converts a component in the range [0, 1] to a byte.
+func toComponent(c float64) uint8 {
+	return uint8(math.Round(math.Max(0, math.Min(1, c)) * 255))
+}
+
+// linearToRGB converts linear RGB components to sRGB bytes. Components which exceed 1 scale all
+// components down, so that the hue is retained.
+func linearToRGB(r, g, b float64) (uint8, uint8, uint8) {
+	r, g, b = math.Max(r, 0), math.Max(g, 0), math.Max(b, 0)
+
+	if peak := math.Max(r, math.Max(g, b)); peak > 1 {
+		r, g, b = r/peak, g/peak, b/peak
+	}
+
+	return toComponent(linearToSRGB(r)), toComponent(linearToSRGB(g)), toComponent(linearToSRGB(b))
+}
+
+// RGBToXYY converts a sRGB colour to its chromaticity x, y and its relative luminance Y. All
+// results are in the range [0, 1]. Black yields the chromaticity of the white point.
+func RGBToXYY(r, g, b uint8) (x, y, Y float64) {
+	lr := srgbToLinear(float64(r) / 255)
+	lg := srgbToLinear(float64(g) / 255)
+	lb := srgbToLinear(float64(b) / 255)
+
+	cx := 0.4124564*lr + 0.3575761*lg + 0.1804375*lb
+	cy := 0.2126729*lr + 0.7151522*lg + 0.0721750*lb
+	cz := 0.0193339*lr + 0.1191920*lg + 0.9503041*lb
+
+	sum := cx + cy + cz
+	if sum == 0 {
+		return 0.3127, 0.3290, 0
+	}
+
+	return cx / sum, cy / sum, cy
+}
+
+// XYYToRGB converts a chromaticity x, y and relative luminance Y to a sRGB colour. Colours outside
+// of the sRGB gamut are clipped.
+func XYYToRGB(x, y, Y float64) (r, g, b uint8) {
+	if y <= 0 || Y <= 0 {
+		return 0, 0, 0
+	}
+
+	cx := x * Y / y
+	cz := (1 - x - y) * Y / y
+
+	return linearToRGB(
+		3.2404542*cx-1.5371385*Y-0.4985314*cz,
+		-0.9692660*cx+1.8760108*Y+0.0415560*cz,
+		0.0556434*cx-0.2040259*Y+1.0572252*cz,
+	)
+}
+
+// RGBToHSV converts a sRGB colour to hue (in degrees [0, 360)), saturation and value (both in the
+// range [0, 1]).
+func RGBToHSV(r, g, b uint8) (h, s, v float64) {
+	fr, fg, fb := float64(r)/255, float64(g)/255, float64(b)/255
+
+	peak := math.Max(fr, math.Max(fg, fb))
+	delta := peak - math.Min(fr, math.Min(fg, fb))
+
+	v = peak
+	if peak > 0 {
+		s = delta / peak
+	}
+
+	switch {
+	case delta == 0:
+		h = 0
+	case peak == fr:
+		h = 60 * math.Mod((fg-fb)/delta, 6)
+	case peak == fg:
+		h = 60 * ((fb-fr)/delta + 2)
+	default:
+		h = 60 * ((fr-fg)/delta + 4)
+	}
+
+	if h < 0 {
+		h += 360
+	}
+
+	return h, s, v
+}
+
+// HSVToRGB converts hue (in degrees), saturation and value (both in the range [0, 1]) to a sRGB
+// colour.
+func HSVToRGB(h, s, v float64) (r, g, b uint8) {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+
+	c := v * s
+	x := c * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := v - c
+
+	var fr, fg, fb float64
+	switch {
+	case h < 60:
+		fr, fg, fb = c, x, 0
+	case h < 120:
+		fr, fg, fb = x, c, 0
+	case h < 180:
+		fr, fg, fb = 0, c, x
+	case h < 240:
+		fr, fg, fb = 0, x, c
+	case h < 300:
+		fr, fg, fb = x, 0, c
+	default:
+		fr, fg, fb = c, 0, x
+	}
+
+	return toComponent(fr + m), toComponent(fg + m), toComponent(fb + m)
+}
+
+// KelvinToXY approximates the chromaticity of a black body with the given colour temperature. The
+// temperature is limited to the range 1667 K to 25000 K.
+func KelvinToXY(kelvin float64) (x, y float64) {
+	t := math.Max(1667, math.Min(25000, kelvin))
+
+	if t <= 4000 {
+		x = -0.2661239e9/(t*t*t) - 0.2343589e6/(t*t) + 0.8776956e3/t + 0.179910
+	} else {
+		x = -3.0258469e9/(t*t*t) + 2.1070379e6/(t*t) + 0.2226347e3/t + 0.240390
+	}
+
+	switch {
+	case t <= 2222:
+		y = -1.1063814*x*x*x - 1.34811020*x*x + 2.18555832*x - 0.20219683
+	case t <= 4000:
+		y = -0.9549476*x*x*x - 1.37418593*x*x + 2.09137015*x - 0.16748867
+	default:
+		y = 3.0817580*x*x*x - 5.87338670*x*x + 3.75112997*x - 0.37001483
+	}
+
+	return x, y
+}
+
+// XYToKelvin approximates the correlated colour temperature of a chromaticity.
+func XYToKelvin(x, y float64) float64 {
+	n := (x - 0.3320) / (0.1858 - y)
+	return 449*n*n*n + 3525*n*n + 6823.3*n + 5520.33
+}
+
+// KelvinToRGB approximates the sRGB colour of a black body with the given colour temperature at
+// full brightness.
+func KelvinToRGB(kelvin float64) (r, g, b uint8) {
+	x, y := KelvinToXY(kelvin)
+
+	cx := x / y
+	cz := (1 - x - y) / y
+
+	lr := 3.2404542*cx - 1.5371385 - 0.4985314*cz
+	lg := -0.9692660*cx + 1.8760108 + 0.0415560*cz
+	lb := 0.0556434*cx - 0.2040259 + 1.0572252*cz
+
+	// Scale to full brightness
+	peak := math.Max(lr, math.Max(lg, lb))
+
+	return linearToRGB(lr/peak, lg/peak, lb/peak)
+}
+
+// parseHexColour parses a colour in the form #RRGGBB, or #RRGGBBWW if white is allowed. The hash is
+// optional.
+func parseHexColour(text string, white bool) ([]uint8, error) {
+	text = strings.TrimPrefix(strings.TrimSpace(text), "#")
+
+	if len(text) != 6 && (!white || len(text) != 8) {
+		if white {
+			return nil, fmt.Errorf("colour %q is neither #RRGGBB nor #RRGGBBWW", text)
+		}
+
+		return nil, fmt.Errorf("colour %q is not #RRGGBB", text)
+	}
+
+	components, err := hex.DecodeString(text)
+	if err != nil {
+		return nil, fmt.Errorf("colour %q is invalid: %w", text, err)
+	}
+
+	return components, nil
+}
@@ -0,0 +1,98 @@
+package dpt
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRGBToHSV(t *testing.T) {
+	tests := []struct {
+		r, g, b uint8
+		h, s, v float64
+	}{
+		{0, 0, 0, 0, 0, 0},
+		{255, 255, 255, 0, 0, 1},
+		{255, 0, 0, 0, 1, 1},
+		{0, 255, 0, 120, 1, 1},
+		{0, 0, 255, 240, 1, 1},
+		{255, 0, 255, 300, 1, 1},
+		{128, 64, 0, 30, 1, 128.0 / 255},
+	}
+
+	for _, test := range tests {
+		h, s, v := RGBToHSV(test.r, test.g, test.b)
+		if math.Abs(h-test.h) > epsilon || math.Abs(s-test.s) > epsilon || math.Abs(v-test.v) > epsilon {
+			t.Errorf("RGBToHSV(%d, %d, %d) = %v, %v, %v, want %v, %v, %v", test.r, test.g, test.b, h, s, v, test.h, test.s, test.v)
+		}
+
+		r, g, b := HSVToRGB(h, s, v)
+		if r != test.r || g != test.g || b != test.b {
+			t.Errorf("HSVToRGB(%v, %v, %v) = %d, %d, %d, want %d, %d, %d", h, s, v, r, g, b, test.r, test.g, test.b)
+		}
+	}
+}
+
+func TestRGBToXYY(t *testing.T) {
+	// The primaries and the white point of sRGB
+	tests := []struct {
+		r, g, b uint8
+		x, y, Y float64
+	}{
+		{255, 0, 0, 0.64, 0.33, 0.2126},
+		{0, 255, 0, 0.30, 0.60, 0.7152},
+		{0, 0, 255, 0.15, 0.06, 0.0722},
+		{255, 255, 255, 0.3127, 0.3290, 1},
+	}
+
+	for _, test := range tests {
+		x, y, Y := RGBToXYY(test.r, test.g, test.b)
+		if math.Abs(x-test.x) > 0.001 || math.Abs(y-test.y) > 0.001 || math.Abs(Y-test.Y) > 0.001 {
+			t.Errorf("RGBToXYY(%d, %d, %d) = %v, %v, %v, want %v, %v, %v", test.r, test.g, test.b, x, y, Y, test.x, test.y, test.Y)
+		}
+	}
+
+	for _, c := range [][3]uint8{{255, 96, 0}, {12, 34, 56}, {200, 200, 10}, {1, 2, 3}} {
+		x, y, Y := RGBToXYY(c[0], c[1], c[2])
+		if r, g, b := XYYToRGB(x, y, Y); r != c[0] || g != c[1] || b != c[2] {
+			t.Errorf("XYYToRGB(RGBToXYY(%v)) = %d, %d, %d", c, r, g, b)
+		}
+	}
+
+	if r, g, b := XYYToRGB(0.3127, 0.3290, 0); r != 0 || g != 0 || b != 0 {
+		t.Errorf("Zero luminance should be black, got %d, %d, %d", r, g, b)
+	}
+}
+
+func TestKelvin(t *testing.T) {
+	for _, kelvin := range []float64{2000, 2700, 4000, 5000, 6500, 9000} {
+		x, y := KelvinToXY(kelvin)
+		if cct := XYToKelvin(x, y); math.Abs(cct-kelvin)/kelvin > 0.02 {
+			t.Errorf("XYToKelvin(KelvinToXY(%v)) = %v", kelvin, cct)
+		}
+	}
+
+	// Warm white is mostly red, cold white is mostly blue.
+	if r, _, b := KelvinToRGB(2700); r != 255 || b > 200 {
+		t.Errorf("KelvinToRGB(2700) = %d, _, %d", r, b)
+	}
+
+	if r, _, b := KelvinToRGB(10000); b != 255 || r > 230 {
+		t.Errorf("KelvinToRGB(10000) = %d, _, %d", r, b)
+	}
+}
+
+func TestParseHexColour(t *testing.T) {
+	if c, err := parseHexColour("#FF6000", false); err != nil || c[0] != 0xff || c[1] != 0x60 || c[2] != 0 {
+		t.Errorf("Unexpected colour %v, %v", c, err)
+	}
+
+	if c, err := parseHexColour("ff600012", true); err != nil || len(c) != 4 || c[3] != 0x12 {
+		t.Errorf("Unexpected colour %v, %v", c, err)
+	}
+
+	for _, text := range []string{"#FF600012", "#FF60", "#GG6000", "red"} {
+		if _, err := parseHexColour(text, false); err == nil {
+			t.Errorf("Expected error for %q", text)
+		}
+	}
+}
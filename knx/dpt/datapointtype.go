@@ -2,7 +2,6 @@ package dpt
 
 import (
 	"fmt"
-	"reflect"
 	"strconv"
 	"strings"
 )
@@ -16,15 +15,14 @@ func (d DataPointType) Produce() (DatapointValue, bool) {
 }
 
 func (d DataPointType) DPST() string {
-	dpt, k := d.Produce()
-	if !k {
+	if _, k := d.Produce(); !k {
 		return ""
 	}
-	t := reflect.TypeOf(dpt).Elem()
-	name := t.Name()
 
-	tn, _ := strconv.Atoi(name[4 : len(name)-3])
-	sn, _ := strconv.Atoi(name[len(name)-3:])
+	// Registered identifiers are always in the form yy.xxx
+	main, sub, _ := strings.Cut(string(d), ".")
+	tn, _ := strconv.Atoi(main)
+	sn, _ := strconv.Atoi(sub)
 
 	return fmt.Sprintf("DPST-%d-%d", tn, sn)
 }
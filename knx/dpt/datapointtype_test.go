@@ -21,3 +21,18 @@ func TestNormalizeDPTFormats(t *testing.T) {
 		t.Fatal("normalizeDPT() expected error for invalid input")
 	}
 }
+
+func TestDataPointTypeDPST(t *testing.T) {
+	cases := map[DataPointType]string{
+		"1.001":   "DPST-1-1",
+		"12.1200": "DPST-12-1200",
+		"20.1000": "DPST-20-1000",
+		"235.001": "DPST-235-1",
+		"99.999":  "",
+	}
+	for input, want := range cases {
+		if got := input.DPST(); got != want {
+			t.Errorf("DataPointType(%q).DPST() = %q, want %q", input, got, want)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package dpt
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// Description is the machine-readable form of a datapoint value. It is also the JSON encoding of
+// all datapoint values. Their UnmarshalJSON methods accept a Description, the plain value alone
+// or a JSON string in the text form, see encoding.TextUnmarshaler.
+type Description struct {
+	// Value is the plain value: a bool, an integer, a float64, a string or, for structured types,
+	// a map of the field names to their plain values. Non-finite floats are nil. Numbers which
+	// have been unmarshalled from JSON are json.Number, so 64-bit integers keep their precision.
+	Value any `json:"value"`
+
+	// Unit of the value, if any.
+	Unit string `json:"unit,omitempty"`
+
+	// Name of the value of enumerations and booleans, e.g. "Comfort" or "On".
+	Name string `json:"name,omitempty"`
+
+	// Flags lists the names of the flags which are set, for bitsets like DPT 21.001.
+	Flags []string `json:"flags,omitempty"`
+
+	// Text is the text form of the value, see encoding.TextMarshaler.
+	Text string `json:"text"`
+}
+
+// UnmarshalJSON decodes the description like json.Unmarshal does, except for the numbers in Value,
+// see there.
+func (d *Description) UnmarshalJSON(data []byte) error {
+	// The local type has no methods, which avoids recursion.
+	type description Description
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var desc description
+	if err := decoder.Decode(&desc); err != nil {
+		return err
+	}
+
+	*d = Description(desc)
+
+	return nil
+}
+
+// enumeration is implemented by the enumerated types, whose values have names.
+type enumeration interface {
+	names() enumNames
+}
+
+// flagSet is implemented by the bitset types, whose value is a set of named flags.
+type flagSet interface {
+	flags() []*bool
+	flagNames() []string
+}
+
+// Describe returns the machine-readable form of the value.
+func Describe(value DatapointValue) Description {
+	var desc Description
+
+	if meta, ok := value.(DatapointMeta); ok {
+		desc.Unit = meta.Unit()
+	}
+
+	if marshaler, ok := value.(encoding.TextMarshaler); ok {
+		if text, err := marshaler.MarshalText(); err == nil {
+			desc.Text = string(text)
+		}
+	} else {
+		desc.Text = value.String()
+	}
+
+	rv := reflect.Indirect(reflect.ValueOf(value))
+	desc.Value = plainValue(rv)
+
+	switch v := value.(type) {
+	case enumeration:
+		if name, ok := v.names()[uint8(rv.Uint())]; ok {
+			desc.Name = name
+		}
+
+	case flagSet:
+		names := v.flagNames()
+		for i, flag := range v.flags() {
+			if *flag {
+				desc.Flags = append(desc.Flags, names[i])
+			}
+		}
+	}
+
+	if rv.Kind() == reflect.Bool {
+		desc.Name = value.String()
+	}
+
+	return desc
+}
+
+// plainValue converts the value into its plain Go representation, see Description.Value.
+func plainValue(rv reflect.Value) any {
+	switch rv.Kind() {
+	case reflect.Bool:
+		return rv.Bool()
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int()
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return rv.Uint()
+
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil
+		}
+
+		// Use the shortest representation of a float32, e.g. 0.1 instead of 0.10000000149011612.
+		if rv.Kind() == reflect.Float32 {
+			f, _ = strconv.ParseFloat(strconv.FormatFloat(f, 'g', -1, 32), 64)
+		}
+
+		return f
+
+	case reflect.String:
+		return rv.String()
+
+	case reflect.Array, reflect.Slice:
+		values := make([]any, rv.Len())
+		for i := range values {
+			values[i] = plainValue(rv.Index(i))
+		}
+
+		return values
+
+	case reflect.Struct:
+		fields := make(map[string]any, rv.NumField())
+		for i := 0; i < rv.NumField(); i++ {
+			if rv.Type().Field(i).IsExported() {
+				fields[rv.Type().Field(i).Name] = plainValue(rv.Field(i))
+			}
+		}
+
+		return fields
+	}
+
+	return fmt.Sprint(rv.Interface())
+}
+
+// marshalJSON encodes the description of the value.
+func marshalJSON(value DatapointValue) ([]byte, error) {
+	return json.Marshal(Describe(value))
+}
+
+// unmarshalJSON implements UnmarshalJSON for all datapoint values. The value may be given as
+//   - a Description, only its value is used then, or its text if the value is null,
+//   - a JSON string, which is parsed as text,
+//   - or as plain value, which is decoded into plain. plain must point to the value with a type
+//     which has no JSON methods, e.g. the underlying type or a local type with the same fields.
+//
+// null leaves the value unchanged, like it does for the built-in types.
+func unmarshalJSON(data []byte, text encoding.TextUnmarshaler, plain any) error {
+	data = bytes.TrimSpace(data)
+
+	switch {
+	case len(data) == 0, bytes.Equal(data, []byte("null")):
+		return nil
+
+	case data[0] == '"':
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+
+		return text.UnmarshalText([]byte(s))
+
+	case data[0] == '{':
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return err
+		}
+
+		// The keys of a Description are lower case, unlike the field names of the structured
+		// types. Non-finite floats have no plain value, only their text form tells them.
+		if value, ok := fields["value"]; ok {
+			if bytes.Equal(bytes.TrimSpace(value), []byte("null")) && fields["text"] != nil {
+				return unmarshalJSON(fields["text"], text, plain)
+			}
+
+			return unmarshalJSON(value, text, plain)
+		}
+	}
+
+	return json.Unmarshal(data, plain)
+}
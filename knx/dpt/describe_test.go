@@ -0,0 +1,179 @@
+package dpt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribe(t *testing.T) {
+	tests := []struct {
+		value DatapointValue
+		want  Description
+	}{
+		{ptr(DPT_1001(true)), Description{Value: true, Name: "On", Text: "On"}},
+		{ptr(DPT_9001(21.5)), Description{Value: 21.5, Unit: "°C", Text: "21.5 °C"}},
+		{ptr(DPT_14000(0.1)), Description{Value: 0.1, Unit: "m/s²", Text: "0.1 m/s²"}},
+		{ptr(DPT_14000(math.NaN())), Description{Value: nil, Unit: "m/s²", Text: "NaN m/s²"}},
+		{ptr(DPT_13001(-5)), Description{Value: int64(-5), Unit: "pulses", Text: "-5 pulses"}},
+		{ptr(DPT_7001(300)), Description{Value: uint64(300), Unit: "pulses", Text: "300 pulses"}},
+		{ptr(DPT_20102(3)), Description{Value: uint64(3), Name: "Economy", Text: "Economy"}},
+		{ptr(DPT_20102(9)), Description{Value: uint64(9), Text: "9"}},
+		{ptr(DPT_16000("KNX")), Description{Value: "KNX", Text: "KNX"}},
+		{
+			ptr(DPT_21001{Fault: true, InAlarm: true}),
+			Description{
+				Value: map[string]any{
+					"OutOfService": false, "Fault": true, "Overridden": false, "InAlarm": true, "AlarmUnAck": false,
+				},
+				Flags: []string{"Fault", "InAlarm"},
+				Text:  "Fault, InAlarm",
+			},
+		},
+		{
+			ptr(DPT_235001{ActiveEnergy: 1234, Tariff: 2}),
+			Description{
+				Value: map[string]any{
+					"ActiveEnergy": int64(1234), "Tariff": uint64(2), "EnergyInvalid": false, "TariffInvalid": false,
+				},
+				Unit: "Wh",
+				Text: "1234 Wh (tariff 2)",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.want, Describe(test.value), "%T", test.value)
+	}
+}
+
+func TestJSONRoundTripAllTypes(t *testing.T) {
+	for _, name := range ListSupportedTypes() {
+		t.Run(name, func(t *testing.T) {
+			src, _ := Produce(name)
+			length := len(src.Pack())
+
+			// A fixed seed keeps failures reproducible.
+			rng := rand.New(rand.NewSource(1))
+
+			for i := 0; i < 20; i++ {
+				data := make([]byte, length)
+				rng.Read(data)
+				if length > 1 {
+					data[0] = 0
+				}
+
+				if src.Unpack(data) != nil {
+					continue
+				}
+
+				// Invalid strings, e.g. malformed UTF-8, cannot be represented in JSON.
+				if validator, ok := src.(interface{ IsValid() bool }); ok && !validator.IsValid() {
+					continue
+				}
+
+				encoded, err := json.Marshal(src)
+				if !assert.NoError(t, err) {
+					return
+				}
+
+				var desc Description
+				if !assert.NoError(t, json.Unmarshal(encoded, &desc)) {
+					return
+				}
+
+				// The plain value alone must suffice, except for non-finite floats.
+				plain, _ := json.Marshal(desc.Value)
+				if desc.Value == nil {
+					plain = encoded
+				}
+
+				dst, _ := Produce(name)
+				if err := json.Unmarshal(plain, dst); err != nil {
+					t.Errorf("Unmarshalling %s failed: %v", plain, err)
+					continue
+				}
+
+				// NaN payloads are not retained.
+				if desc.Value == nil && fmt.Sprint(src) == fmt.Sprint(dst) {
+					continue
+				}
+
+				if !bytes.Equal(src.Pack(), dst.Pack()) {
+					t.Errorf("Value %s changed to %s after unmarshalling %s", src, dst, plain)
+				}
+			}
+		})
+	}
+}
+
+func TestDescriptionJSONPrecision(t *testing.T) {
+	// Values are stored as Description, e.g. in the database, and restored from it.
+	src := DPT_29010(1<<53 + 1)
+
+	encoded, err := json.Marshal(Describe(&src))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var desc Description
+	if !assert.NoError(t, json.Unmarshal(encoded, &desc)) {
+		return
+	}
+	assert.Equal(t, json.Number("9007199254740993"), desc.Value)
+
+	stored, err := json.Marshal(desc)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var dst DPT_29010
+	if assert.NoError(t, json.Unmarshal(stored, &dst)) {
+		assert.Equal(t, src, dst)
+	}
+
+	// Structured values keep their precision as well.
+	energy := DPT_235001{ActiveEnergy: math.MinInt32, Tariff: 1}
+	encoded, _ = json.Marshal(&energy)
+	assert.NoError(t, json.Unmarshal(encoded, &desc))
+	assert.Equal(t, json.Number("-2147483648"), desc.Value.(map[string]any)["ActiveEnergy"])
+}
+
+func TestUnmarshalJSONForms(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want DatapointValue
+	}{
+		{"1.001", `true`, ptr(DPT_1001(true))},
+		{"1.001", `"on"`, ptr(DPT_1001(true))},
+		{"9.001", `21.5`, ptr(DPT_9001(21.5))},
+		{"9.001", `"21.5 °C"`, ptr(DPT_9001(21.5))},
+		{"9.001", `{"value":21.5,"unit":"°C","text":"21.5 °C"}`, ptr(DPT_9001(21.5))},
+		{"20.102", `3`, ptr(DPT_20102(3))},
+		{"20.102", `"Economy"`, ptr(DPT_20102(3))},
+		{"235.001", `{"ActiveEnergy":1234,"Tariff":2}`, ptr(DPT_235001{ActiveEnergy: 1234, Tariff: 2})},
+		{"235.001", `{"value":{"ActiveEnergy":1234,"Tariff":2}}`, ptr(DPT_235001{ActiveEnergy: 1234, Tariff: 2})},
+	}
+
+	for _, test := range tests {
+		dst, _ := Produce(test.name)
+		if assert.NoError(t, json.Unmarshal([]byte(test.json), dst), test.json) {
+			assert.Equal(t, test.want, dst, test.json)
+		}
+	}
+
+	var nan DPT_14000
+	assert.NoError(t, json.Unmarshal([]byte(`{"value":null,"text":"NaN m/s²"}`), &nan))
+	assert.True(t, math.IsNaN(float64(nan)))
+
+	for _, input := range []string{`"warm"`, `{"value":"warm"}`, `[1]`} {
+		var dst DPT_9001
+		assert.Error(t, json.Unmarshal([]byte(input), &dst), input)
+	}
+}
@@ -1,13 +1,14 @@
 package dpt
 
 import (
+	"encoding"
 	"errors"
 	"fmt"
-	"reflect"
-	"strconv"
 	"strings"
 )
 
+// EncodeDPTFromStringN parses the value for the datapoint type with the given name, e.g. "9.001",
+// and returns its packed form.
 func EncodeDPTFromStringN(dptName, value string) ([]byte, error) {
 	dv, ok := Produce(dptName)
 	if !ok {
@@ -16,63 +17,46 @@ func EncodeDPTFromStringN(dptName, value string) ([]byte, error) {
 	return EncodeDPTFromString(dv, value)
 }
 
+// EncodeDPTFromString parses the value using the encoding.TextUnmarshaler of the datapoint value,
+// e.g. "on" for DPT 1.001 or "21.5 °C" for DPT 9.001, and returns its packed form. Values which
+// PackChecked rejects are rejected here as well.
 func EncodeDPTFromString(dv DatapointValue, value string) ([]byte, error) {
-	rv := reflect.ValueOf(dv)
-	if rv.Kind() != reflect.Pointer || rv.IsNil() {
-		return nil, fmt.Errorf("unexpected datapoint value: %s, %T", rv.Kind(), dv)
-	}
-
-	elem := rv.Elem()
-	if !elem.CanSet() {
-		return nil, fmt.Errorf("the value %s can not be set for %T", value, dv)
+	u, ok := dv.(encoding.TextUnmarshaler)
+	if !ok {
+		return nil, fmt.Errorf("%T can not be parsed from text", dv)
 	}
 
-	value = strings.TrimSpace(value)
-	if value == "" {
+	// The value is passed on untrimmed, as white space is significant for the string types.
+	if strings.TrimSpace(value) == "" {
 		return nil, errors.New("value must be provided")
 	}
 
-	switch elem.Kind() {
-	case reflect.Bool:
-		b, err := strconv.ParseBool(value)
-		if err != nil {
-			return nil, fmt.Errorf("bool not valid %q: %w", value, err)
-		}
-		elem.SetBool(b)
+	if err := u.UnmarshalText([]byte(value)); err != nil {
+		return nil, fmt.Errorf("value not valid for %T %q: %w", dv, value, err)
+	}
 
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		i, err := strconv.ParseInt(value, 10, elem.Type().Bits())
-		if err != nil {
-			return nil, fmt.Errorf("int not valid %q: %w", value, err)
-		}
-		if elem.OverflowInt(i) {
-			return nil, fmt.Errorf("overflow int %q for %s", value, elem.Type())
-		}
-		elem.SetInt(i)
+	return PackChecked(dv)
+}
 
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		u, err := strconv.ParseUint(value, 10, elem.Type().Bits())
-		if err != nil {
-			return nil, fmt.Errorf("uint not valid %q: %w", value, err)
-		}
-		if elem.OverflowUint(u) {
-			return nil, fmt.Errorf("overflow uint %q for %s", value, elem.Type())
-		}
-		elem.SetUint(u)
+// validator is implemented by datapoint values which have invalid states, e.g. a time of day
+// beyond 23:59:59, which Pack would clamp or wrap.
+type validator interface {
+	IsValid() bool
+}
 
-	case reflect.Float32, reflect.Float64:
-		f, err := strconv.ParseFloat(value, elem.Type().Bits())
-		if err != nil {
-			return nil, fmt.Errorf("float not valid %q: %w", value, err)
+// PackChecked packs the value like its Pack method, but rejects values which Pack would clamp or
+// wrap instead of sending them: NaN, infinite values, numbers outside of the range of the datapoint
+// type (see Lookup), reserved values of enumerations and values whose IsValid method fails.
+func PackChecked(value DatapointValue) ([]byte, error) {
+	if number, ok := value.(NumericValue); ok {
+		if err := checkFloat64(value, number.Float64()); err != nil {
+			return nil, err
 		}
-		elem.SetFloat(f)
-
-	case reflect.String:
-		elem.SetString(value)
+	}
 
-	default:
-		return nil, fmt.Errorf("kind %s not managed for kind %T", elem.Kind(), dv)
+	if v, ok := value.(validator); ok && !v.IsValid() {
+		return nil, fmt.Errorf("value %s is invalid", value)
 	}
 
-	return dv.Pack(), nil
+	return value.Pack(), nil
 }
@@ -1,6 +1,9 @@
 package dpt
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 func TestEncodeDPTFromString_OK(t *testing.T) {
 	t.Parallel()
@@ -17,24 +20,126 @@ func TestEncodeDPTFromString_OK(t *testing.T) {
 			in:      "true",
 			want:    DPT_1001(true).Pack(),
 		},
+		{
+			name:    "bool DPT 1.001 on",
+			dptName: "1.001",
+			in:      "on",
+			want:    DPT_1001(true).Pack(),
+		},
+		{
+			name:    "bool DPT 1.009 close",
+			dptName: "1.009",
+			in:      "close",
+			want:    DPT_1009(true).Pack(),
+		},
 		{
 			name:    "uint8 DPT 17.001 34",
 			dptName: "17.001",
 			in:      "34",
 			want:    DPT_17001(34).Pack(),
 		},
+		{
+			name:    "int16 DPT 8.001 -42",
+			dptName: "8.001",
+			in:      "-42",
+			want:    DPT_8001(-42).Pack(),
+		},
+		{
+			name:    "int64 DPT 29.010 beyond float precision",
+			dptName: "29.010",
+			in:      "9007199254740993",
+			want:    DPT_29010(9007199254740993).Pack(),
+		},
 		{
 			name:    "float DPT 9.001 21.5",
 			dptName: "9.001",
 			in:      "21.5",
 			want:    DPT_9001(21.5).Pack(),
 		},
+		{
+			name:    "float DPT 9.001 with unit",
+			dptName: "9.001",
+			in:      "21.5 °C",
+			want:    DPT_9001(21.5).Pack(),
+		},
+		{
+			name:    "time of day DPT 10.001",
+			dptName: "10.001",
+			in:      "Monday 07:30",
+			want:    DPT_10001{Weekday: 1, Hour: 7, Minutes: 30}.Pack(),
+		},
+		{
+			name:    "date DPT 11.001",
+			dptName: "11.001",
+			in:      "2024-05-17",
+			want:    DPT_11001{Year: 2024, Month: 5, Day: 17}.Pack(),
+		},
+		{
+			name:    "control DPT 2.001 control on",
+			dptName: "2.001",
+			in:      "control on",
+			want:    DPT_2001{Control: true, Value: true}.Pack(),
+		},
+		{
+			name:    "dimming DPT 3.007 increase 4",
+			dptName: "3.007",
+			in:      "increase 4",
+			want:    DPT_3007{Increase: true, StepCode: 4}.Pack(),
+		},
+		{
+			name:    "blinds DPT 3.008 down break",
+			dptName: "3.008",
+			in:      "down break",
+			want:    DPT_3008{Down: true}.Pack(),
+		},
+		{
+			name:    "enum DPT 20.102 by name",
+			dptName: "20.102",
+			in:      "Economy",
+			want:    HVACMode_Economy.Pack(),
+		},
+		{
+			name:    "enum DPT 20.1000 by number",
+			dptName: "20.1000",
+			in:      "6",
+			want:    DPT_20_1000(6).Pack(),
+		},
+		{
+			name:    "colour DPT 232.600 #RRGGBB",
+			dptName: "232.600",
+			in:      "#FF6000",
+			want:    DPT_232600{Red: 255, Green: 96}.Pack(),
+		},
+		{
+			name:    "colour DPT 251.600 #RRGGBB",
+			dptName: "251.600",
+			in:      "#ff6000",
+			want:    DPT_251600{Red: 255, Green: 96, RedValid: true, GreenValid: true, BlueValid: true}.Pack(),
+		},
+		{
+			name:    "status DPT 21.001 flags",
+			dptName: "21.001",
+			in:      "Fault, InAlarm",
+			want:    DPT_21001{Fault: true, InAlarm: true}.Pack(),
+		},
+		{
+			name:    "scene info DPT 26.001",
+			dptName: "26.001",
+			in:      "5 inactive",
+			want:    DPT_26001{Scene: 5, Inactive: true}.Pack(),
+		},
 		{
 			name:    "string DPT 16.001 ciao",
 			dptName: "16.001",
 			in:      "test",
 			want:    DPT_16001("test").Pack(),
 		},
+		{
+			name:    "string DPT 24.001 non-ASCII",
+			dptName: "24.001",
+			in:      "Grüße",
+			want:    []byte{0, 'G', 'r', 0xfc, 0xdf, 'e', 0},
+		},
 	}
 
 	for _, tc := range tests {
@@ -77,7 +182,37 @@ func TestEncodeDPTFromString_Errors(t *testing.T) {
 			in:      "999",
 		},
 		{
-			name:    "complex struct DPT not supported by generic parser",
+			name:    "invalid step code for DPT 3.008",
+			dptName: "3.008",
+			in:      "up 9",
+		},
+		{
+			name:    "reserved value for DPT 20.105",
+			dptName: "20.105",
+			in:      "reserved",
+		},
+		{
+			name:    "below range of DPT 9.001",
+			dptName: "9.001",
+			in:      "-300",
+		},
+		{
+			name:    "above range of DPT 5.001",
+			dptName: "5.001",
+			in:      "120 %",
+		},
+		{
+			name:    "above range of DPT 17.001",
+			dptName: "17.001",
+			in:      "64",
+		},
+		{
+			name:    "wrong unit for DPT 9.001",
+			dptName: "9.001",
+			in:      "21.5 K",
+		},
+		{
+			name:    "invalid colour for DPT 242.600",
 			dptName: "242.600",
 			in:      "whatever",
 		},
@@ -94,3 +229,61 @@ func TestEncodeDPTFromString_Errors(t *testing.T) {
 		})
 	}
 }
+
+func TestPackChecked(t *testing.T) {
+	t.Parallel()
+
+	valid := []DatapointValue{
+		ptr(DPT_1001(true)),
+		ptr(DPT_5001(100)),
+		ptr(DPT_9001(-273)),
+		ptr(DPT_9001(21.5)),
+		ptr(DPT_14068(-1e30)),
+		ptr(DPT_17001(63)),
+		ptr(DPT_18001(130)),
+		ptr(DPT_20107(2)),
+		ptr(DPT_29010(math.MaxInt64)),
+		ptr(DPT_3007{Increase: true, StepCode: 7}),
+		ptr(DPT_10001{Weekday: 1, Hour: 23, Minutes: 59, Seconds: 59}),
+		ptr(DPT_16000("KNX")),
+	}
+
+	for _, value := range valid {
+		got, err := PackChecked(value)
+		if err != nil {
+			t.Errorf("PackChecked(%s) returned error: %v", value, err)
+			continue
+		}
+
+		if string(got) != string(value.Pack()) {
+			t.Errorf("PackChecked(%s) = %v, want %v", value, got, value.Pack())
+		}
+	}
+
+	invalid := []DatapointValue{
+		ptr(DPT_5001(100.5)),
+		ptr(DPT_5003(-1)),
+		ptr(DPT_8010(400)),
+		ptr(DPT_9001(-274)),
+		ptr(DPT_9004(700000)),
+		ptr(DPT_9001(math.NaN())),
+		ptr(DPT_9001(math.Inf(1))),
+		ptr(DPT_14068(math.NaN())),
+		ptr(DPT_14068(math.Inf(-1))),
+		ptr(DPT_17001(64)),
+		ptr(DPT_18001(64)),
+		ptr(DPT_20107(3)),
+		ptr(DPT_18001(192)),
+		ptr(DPT_3007{StepCode: 8}),
+		ptr(DPT_26001{Scene: 64}),
+		ptr(DPT_250600{Brightness: DPT_3007{StepCode: 9}}),
+		ptr(DPT_10001{Hour: 24}),
+		ptr(DPT_16000("Grüße")),
+	}
+
+	for _, value := range invalid {
+		if got, err := PackChecked(value); err == nil {
+			t.Errorf("PackChecked(%s) = %v, want an error", value, got)
+		}
+	}
+}
@@ -7,6 +7,9 @@ import (
 	"encoding/binary"
 	"errors"
 	"math"
+	"strings"
+
+	"golang.org/x/text/encoding/charmap"
 )
 
 var (
@@ -90,6 +93,21 @@ func unpackB4(data byte, b0 *bool, b1 *bool, b2 *bool, b3 *bool) error {
 	return nil
 }
 
+func packB1U3(b bool, u uint8) byte {
+	return packB1(b)<<3 | u&0x7
+}
+
+func unpackB1U3(data []byte, b *bool, u *uint8) error {
+	if len(data) != 1 {
+		return ErrInvalidLength
+	}
+
+	*b = (data[0]>>3)&1 == 1
+	*u = data[0] & 0x7
+
+	return nil
+}
+
 func packF16(f float32) []byte {
 	buffer := []byte{0, 0, 0}
 
@@ -180,6 +198,21 @@ func unpackU16(data []byte, i *uint16) error {
 	return nil
 }
 
+func packV16(i int16) []byte {
+	return packU16(uint16(i))
+}
+
+func unpackV16(data []byte, i *int16) error {
+	var u uint16
+	if err := unpackU16(data, &u); err != nil {
+		return err
+	}
+
+	*i = int16(u)
+
+	return nil
+}
+
 func packU32(i uint32) []byte {
 	buffer := []byte{0, 0, 0, 0, 0}
 	binary.BigEndian.PutUint32(buffer[1:], i)
@@ -229,3 +262,55 @@ func unpackV32(data []byte, i *int32) error {
 
 	return nil
 }
+
+func packV64(i int64) []byte {
+	buffer := make([]byte, 9)
+	binary.BigEndian.PutUint64(buffer[1:], uint64(i))
+	return buffer
+}
+
+func unpackV64(data []byte, i *int64) error {
+	if len(data) != 9 {
+		return ErrInvalidLength
+	}
+
+	*i = int64(binary.BigEndian.Uint64(data[1:]))
+
+	return nil
+}
+
+// encodeLatin1 encodes a string as ISO-8859-1. Characters which cannot be represented are replaced
+// with a space. The string ends at the first NUL character, because NUL terminates strings on the
+// bus.
+func encodeLatin1(s string) []byte {
+	if i := strings.IndexByte(s, 0); i >= 0 {
+		s = s[:i]
+	}
+
+	buf := make([]byte, 0, len(s))
+	for _, r := range s {
+		b, ok := charmap.ISO8859_1.EncodeRune(r)
+		if !ok {
+			b = 0x20
+		}
+
+		buf = append(buf, b)
+	}
+
+	return buf
+}
+
+// decodeLatin1 decodes ISO-8859-1 data up to the first NUL character.
+func decodeLatin1(data []byte) string {
+	var b strings.Builder
+
+	for _, c := range data {
+		if c == 0 {
+			break
+		}
+
+		b.WriteRune(charmap.ISO8859_1.DecodeByte(c))
+	}
+
+	return b.String()
+}
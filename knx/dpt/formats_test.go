@@ -64,3 +64,23 @@ func TestPackUnpackU16(t *testing.T) {
 		t.Fatalf("unpackU16 short error = %v, want %v", err, ErrInvalidLength)
 	}
 }
+
+func TestPackUnpackV64(t *testing.T) {
+	for _, value := range []int64{math.MinInt64, -1, 0, 1 << 53, 1<<53 + 1, math.MaxInt64} {
+		data := packV64(value)
+		if len(data) != 9 || data[0] != 0 {
+			t.Fatalf("packV64(%d) = % x", value, data)
+		}
+		var decoded int64
+		if err := unpackV64(data, &decoded); err != nil {
+			t.Fatalf("unpackV64() error = %v", err)
+		}
+		if decoded != value {
+			t.Fatalf("unpackV64() = %d, want %d", decoded, value)
+		}
+	}
+	var decoded int64
+	if err := unpackV64(make([]byte, 8), &decoded); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("unpackV64 short error = %v, want %v", err, ErrInvalidLength)
+	}
+}
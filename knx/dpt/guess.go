@@ -0,0 +1,285 @@
+package dpt
+
+import (
+	"bytes"
+	"cmp"
+	"math"
+	"slices"
+)
+
+// Candidate is a datapoint type which may have produced the payloads of a group.
+type Candidate struct {
+	// DPT represents the main type, e.g. "9.001" for 9.xxx. The sub type cannot be told from the
+	// payload.
+	DPT DataPointType
+
+	// Score tells how plausible the candidate is, from 0 (excluded) to 1.
+	Score float64
+
+	// Value is the latest payload decoded as DPT.
+	Value DatapointValue
+}
+
+// guessRule rates how plausible it is that a payload belongs to a main type.
+type guessRule struct {
+	dpt DataPointType
+
+	// variable marks types whose payload length varies, the length of the zero value is checked
+	// otherwise.
+	variable bool
+
+	// rate returns the plausibility of the payload, which has been unpacked into value. 0
+	// excludes the type.
+	rate func(data []byte, value DatapointValue) float64
+}
+
+// constantRate rates every payload of the right length the same.
+func constantRate(score float64) func([]byte, DatapointValue) float64 {
+	return func([]byte, DatapointValue) float64 {
+		return score
+	}
+}
+
+// validRate rates payloads which decode to valid values, see IsValid.
+func validRate(score float64) func([]byte, DatapointValue) float64 {
+	return func(_ []byte, value DatapointValue) float64 {
+		if v, ok := value.(validator); ok && !v.IsValid() {
+			return 0
+		}
+
+		return score
+	}
+}
+
+// nulTerminatedRate rates variable strings, which end with NUL. Text is rarely empty and mostly
+// printable ASCII, other payloads often are not.
+func nulTerminatedRate(score float64) func([]byte, DatapointValue) float64 {
+	valid := validRate(score)
+
+	return func(data []byte, value DatapointValue) float64 {
+		if data[len(data)-1] != 0 {
+			return 0
+		}
+
+		text, _, _ := bytes.Cut(data[1:], []byte{0})
+		if len(text) == 0 {
+			return min(score, 0.1)
+		}
+		if slices.ContainsFunc(text, func(c byte) bool { return c < 0x20 || c == 0x7F }) {
+			return min(score, 0.05)
+		}
+		if slices.ContainsFunc(text, func(c byte) bool { return c >= 0x80 }) {
+			return min(score, 0.3)
+		}
+
+		return valid(data, value)
+	}
+}
+
+// f16Normalized tells whether a 2-byte float uses the smallest possible exponent, as encoders do.
+// Other 16-bit values rarely look like that.
+func f16Normalized(data []byte) bool {
+	raw := uint16(data[1])<<8 | uint16(data[2])
+	if raw == 0x7FFF {
+		// Invalid data
+		return true
+	}
+
+	exponent := raw >> 11 & 0xF
+	mantissa := int(raw & 0x7FF)
+	if raw&0x8000 != 0 {
+		mantissa -= 2048
+	}
+
+	return exponent == 0 || mantissa < -1024 || mantissa > 1023
+}
+
+// guessRules lists the main types which can be told apart by their payload, each with a
+// representative sub type. The scores reflect how common the types are.
+var guessRules = []guessRule{
+	{dpt: "1.001", rate: func(data []byte, _ DatapointValue) float64 {
+		if data[0]&0x3F > 1 {
+			return 0
+		}
+		return 0.9
+	}},
+	{dpt: "2.001", rate: func(data []byte, _ DatapointValue) float64 {
+		switch v := data[0] & 0x3F; {
+		case v > 3:
+			return 0
+		case v > 1:
+			return 0.7
+		}
+		return 0.2
+	}},
+	{dpt: "3.007", rate: func(data []byte, _ DatapointValue) float64 {
+		switch v := data[0] & 0x3F; {
+		case v > 15:
+			return 0
+		case v > 3:
+			return 0.8
+		}
+		return 0.3
+	}},
+
+	{dpt: "5.001", rate: constantRate(0.6)},
+	{dpt: "6.010", rate: constantRate(0.2)},
+	{dpt: "17.001", rate: func(data []byte, _ DatapointValue) float64 {
+		if data[1] > 63 {
+			return 0
+		}
+		return 0.3
+	}},
+	{dpt: "18.001", rate: validRate(0.2)},
+	{dpt: "20.102", rate: func(data []byte, _ DatapointValue) float64 {
+		if data[1] > 4 {
+			return 0
+		}
+		return 0.4
+	}},
+
+	{dpt: "9.001", rate: func(data []byte, _ DatapointValue) float64 {
+		if f16Normalized(data) {
+			return 0.8
+		}
+		return 0.1
+	}},
+	{dpt: "7.001", rate: func(data []byte, _ DatapointValue) float64 {
+		if f16Normalized(data) {
+			return 0.3
+		}
+		return 0.5
+	}},
+	{dpt: "8.001", rate: func(data []byte, value DatapointValue) float64 {
+		// Small negative numbers hint at a signed type.
+		if v := value.(NumericValue).Float64(); v < 0 && v > -1000 {
+			return 0.5
+		}
+		return 0.2
+	}},
+
+	{dpt: "10.001", rate: validRate(0.6)},
+	{dpt: "11.001", rate: validRate(0.6)},
+	{dpt: "232.600", rate: constantRate(0.3)},
+
+	{dpt: "14.056", rate: func(_ []byte, value DatapointValue) float64 {
+		// Measurements are neither tiny nor huge, random bit patterns often are.
+		v := math.Abs(value.(NumericValue).Float64())
+		if v == 0 || (v >= 1e-4 && v <= 1e9) {
+			return 0.7
+		}
+		return 0.05
+	}},
+	{dpt: "12.001", rate: func(_ []byte, value DatapointValue) float64 {
+		if value.(NumericValue).Float64() < 1e6 {
+			return 0.5
+		}
+		return 0.3
+	}},
+	{dpt: "13.001", rate: func(_ []byte, value DatapointValue) float64 {
+		if v := value.(NumericValue).Float64(); v < 0 && v > -1e6 {
+			return 0.5
+		}
+		return 0.2
+	}},
+
+	{dpt: "235.001", rate: constantRate(0.3)},
+	{dpt: "242.600", rate: constantRate(0.3)},
+	{dpt: "251.600", rate: constantRate(0.3)},
+
+	{dpt: "19.001", rate: validRate(0.8)},
+	{dpt: "29.010", rate: constantRate(0.3)},
+
+	{dpt: "16.000", rate: validRate(0.8)},
+	{dpt: "16.001", rate: validRate(0.5)},
+	{dpt: "24.001", variable: true, rate: nulTerminatedRate(0.8)},
+	{dpt: "28.001", variable: true, rate: nulTerminatedRate(0.6)},
+}
+
+// Guesser proposes datapoint types for a group whose type is unknown, e.g. while commissioning an
+// installation without its project. It rates the payloads which have been seen so far, hence
+// more payloads give better guesses. A Guesser is not safe for concurrent use.
+type Guesser struct {
+	payloads int
+	scores   []float64
+	excluded []bool
+	values   []DatapointValue
+}
+
+// Add rates another payload of the group, i.e. GroupEvent.Data.
+func (g *Guesser) Add(data []byte) {
+	if g.scores == nil {
+		g.scores = make([]float64, len(guessRules))
+		g.excluded = make([]bool, len(guessRules))
+		g.values = make([]DatapointValue, len(guessRules))
+	}
+
+	g.payloads++
+
+	for i, rule := range guessRules {
+		if g.excluded[i] {
+			continue
+		}
+
+		score := rateGuess(rule, data, &g.values[i])
+		if score <= 0 {
+			g.excluded[i] = true
+			g.values[i] = nil
+			continue
+		}
+
+		g.scores[i] += score
+	}
+}
+
+// rateGuess decodes the payload according to the rule and rates it.
+func rateGuess(rule guessRule, data []byte, value *DatapointValue) float64 {
+	v, ok := rule.dpt.Produce()
+	if !ok || len(data) == 0 {
+		return 0
+	}
+
+	if !rule.variable && len(v.Pack()) != len(data) {
+		return 0
+	}
+
+	if v.Unpack(data) != nil {
+		return 0
+	}
+
+	*value = v
+
+	return rule.rate(data, v)
+}
+
+// Candidates returns the types which fit all payloads, the most plausible first.
+func (g *Guesser) Candidates() []Candidate {
+	var candidates []Candidate
+
+	for i, rule := range guessRules {
+		if g.payloads == 0 || g.excluded[i] {
+			continue
+		}
+
+		candidates = append(candidates, Candidate{
+			DPT:   rule.dpt,
+			Score: g.scores[i] / float64(g.payloads),
+			Value: g.values[i],
+		})
+	}
+
+	slices.SortStableFunc(candidates, func(a, b Candidate) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+
+	return candidates
+}
+
+// Guess proposes datapoint types for a single payload, the most plausible first. Use a Guesser to
+// take several payloads of a group into account.
+func Guess(data []byte) []Candidate {
+	var g Guesser
+	g.Add(data)
+
+	return g.Candidates()
+}
@@ -0,0 +1,64 @@
+package dpt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuess(t *testing.T) {
+	tests := []struct {
+		data []byte
+		want DataPointType
+	}{
+		{[]byte{0x01}, "1.001"},
+		{[]byte{0x02}, "2.001"},
+		{[]byte{0x0B}, "3.007"},
+		{[]byte{0x00, 0x80}, "5.001"},
+		{[]byte{0x00, 0x0C, 0x33}, "9.001"},
+		{[]byte{0x00, 0x3F, 0xF0, 0x00, 0x00}, "14.056"},
+		{[]byte{0x00, 'K', 'N', 'X', 0x00}, "24.001"},
+	}
+
+	for _, test := range tests {
+		candidates := Guess(test.data)
+		if assert.NotEmpty(t, candidates, "% x", test.data) {
+			assert.Equal(t, test.want, candidates[0].DPT, "% x", test.data)
+		}
+
+		for i := 1; i < len(candidates); i++ {
+			assert.GreaterOrEqual(t, candidates[i-1].Score, candidates[i].Score)
+		}
+	}
+
+	// 3 bytes fit only the 2-byte types.
+	for _, candidate := range Guess([]byte{0x00, 0x0C, 0x33}) {
+		assert.NotEqual(t, DataPointType("5.001"), candidate.DPT)
+		assert.NotEqual(t, DataPointType("12.001"), candidate.DPT)
+	}
+
+	assert.Empty(t, Guess(nil))
+}
+
+func TestGuesser(t *testing.T) {
+	var g Guesser
+	assert.Empty(t, g.Candidates())
+
+	g.Add([]byte{0x01})
+	assert.Equal(t, DataPointType("1.001"), g.Candidates()[0].DPT)
+
+	// 1.001 cannot encode 2.
+	g.Add([]byte{0x02})
+	candidates := g.Candidates()
+	assert.Equal(t, DataPointType("2.001"), candidates[0].DPT)
+	for _, candidate := range candidates {
+		assert.NotEqual(t, DataPointType("1.001"), candidate.DPT)
+	}
+
+	// The value is the latest payload.
+	assert.Equal(t, "Control Off", candidates[0].Value.String())
+
+	// A payload of another length excludes every candidate.
+	g.Add([]byte{0x00, 0x80})
+	assert.Empty(t, g.Candidates())
+}
@@ -0,0 +1,419 @@
+package dpt
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Range limits the numeric value of a datapoint type.
+type Range struct {
+	Min float64
+	Max float64
+}
+
+// Contains tells whether the value lies within the range. NaN is never contained.
+func (r Range) Contains(value float64) bool {
+	return r.Min <= value && value <= r.Max
+}
+
+// String formats the range, e.g. "[-273, 670760]".
+func (r Range) String() string {
+	return fmt.Sprintf("[%s, %s]",
+		strconv.FormatFloat(r.Min, 'g', -1, 64), strconv.FormatFloat(r.Max, 'g', -1, 64))
+}
+
+// Metadata describes a datapoint type.
+type Metadata struct {
+	// ID is the identifier, e.g. "9.001".
+	ID string
+
+	// Name is the name which KNX assigns to the type, e.g. "DPT_Value_Temp".
+	Name string
+
+	// Description tells what the value represents, e.g. "temperature (°C)".
+	Description string
+
+	// Unit of the value, if any.
+	Unit string
+
+	// Range of the numeric value in the unit above. It is nil for types which are not numeric,
+	// e.g. strings and structured types.
+	Range *Range
+
+	// Resolution is the smallest difference between two numeric values which the payload can
+	// represent. It is zero if the type is not numeric or uses floating point numbers on the wire.
+	Resolution float64
+
+	// Enum maps the values of enumerations to their names.
+	Enum map[uint8]string
+}
+
+// typeInfo holds the metadata which cannot be derived from the Go type of a datapoint value.
+type typeInfo struct {
+	name        string
+	description string
+
+	// rng overrides the range of the Go type, e.g. for scaled values.
+	rng        *Range
+	resolution float64
+}
+
+var typeInfos = map[string]typeInfo{
+	"1.001":   {name: "DPT_Switch", description: "switch"},
+	"1.002":   {name: "DPT_Bool", description: "boolean"},
+	"1.003":   {name: "DPT_Enable", description: "enable"},
+	"1.004":   {name: "DPT_Ramp", description: "ramp"},
+	"1.005":   {name: "DPT_Alarm", description: "alarm"},
+	"1.006":   {name: "DPT_BinaryValue", description: "binary value"},
+	"1.007":   {name: "DPT_Step", description: "step"},
+	"1.008":   {name: "DPT_UpDown", description: "up/down"},
+	"1.009":   {name: "DPT_OpenClose", description: "open/close"},
+	"1.010":   {name: "DPT_Start", description: "start/stop"},
+	"1.011":   {name: "DPT_State", description: "state"},
+	"1.012":   {name: "DPT_Invert", description: "invert"},
+	"1.013":   {name: "DPT_DimSendStyle", description: "dim send style"},
+	"1.014":   {name: "DPT_InputSource", description: "input source"},
+	"1.015":   {name: "DPT_Reset", description: "reset"},
+	"1.016":   {name: "DPT_Ack", description: "acknowledge"},
+	"1.017":   {name: "DPT_Trigger", description: "trigger"},
+	"1.018":   {name: "DPT_Occupancy", description: "occupancy"},
+	"1.019":   {name: "DPT_Window_Door", description: "window/door"},
+	"1.021":   {name: "DPT_LogicalFunction", description: "logical function"},
+	"1.022":   {name: "DPT_Scene_AB", description: "scene A/B"},
+	"1.023":   {name: "DPT_ShutterBlinds_Mode", description: "shutter/blinds mode"},
+	"1.024":   {name: "DPT_DayNight", description: "day/night"},
+	"1.100":   {name: "DPT_Heat_Cool", description: "heating/cooling"},
+	"2.001":   {name: "DPT_Switch_Control", description: "switch with priority control"},
+	"2.002":   {name: "DPT_Bool_Control", description: "boolean with priority control"},
+	"2.003":   {name: "DPT_Enable_Control", description: "enable with priority control"},
+	"2.004":   {name: "DPT_Ramp_Control", description: "ramp with priority control"},
+	"2.005":   {name: "DPT_Alarm_Control", description: "alarm with priority control"},
+	"2.006":   {name: "DPT_BinaryValue_Control", description: "binary value with priority control"},
+	"2.007":   {name: "DPT_Step_Control", description: "step with priority control"},
+	"2.008":   {name: "DPT_Direction1_Control", description: "direction 1 with priority control"},
+	"2.009":   {name: "DPT_Direction2_Control", description: "direction 2 with priority control"},
+	"2.010":   {name: "DPT_Start_Control", description: "start with priority control"},
+	"2.011":   {name: "DPT_State_Control", description: "state with priority control"},
+	"2.012":   {name: "DPT_Invert_Control", description: "invert with priority control"},
+	"3.007":   {name: "DPT_Control_Dimming", description: "dimming control"},
+	"3.008":   {name: "DPT_Control_Blinds", description: "blinds control"},
+	"5.001":   {name: "DPT_Scaling", description: "percentage (0..100%)", rng: &Range{0, 100}, resolution: 100.0 / 255},
+	"5.003":   {name: "DPT_Angle", description: "angle", rng: &Range{0, 360}, resolution: 360.0 / 255},
+	"5.004":   {name: "DPT_Percent_U8", description: "percentage (0..255%)"},
+	"5.005":   {name: "DPT_DecimalFactor", description: "ratio (0..255)"},
+	"6.010":   {name: "DPT_Value_1_Count", description: "counter pulses (-128..127)"},
+	"7.001":   {name: "DPT_Value_2_Ucount", description: "pulses"},
+	"7.002":   {name: "DPT_TimePeriodMsec", description: "time period (ms)"},
+	"7.003":   {name: "DPT_TimePeriod10MSec", description: "time period (10 ms)"},
+	"7.004":   {name: "DPT_TimePeriod100MSec", description: "time period (100 ms)"},
+	"7.005":   {name: "DPT_TimePeriodSec", description: "time period (s)"},
+	"7.006":   {name: "DPT_TimePeriodMin", description: "time period (min)"},
+	"7.007":   {name: "DPT_TimePeriodHrs", description: "time period (h)"},
+	"7.010":   {name: "DPT_PropDataType", description: "interface object property data type"},
+	"7.011":   {name: "DPT_Length_mm", description: "length (mm)"},
+	"7.012":   {name: "DPT_UElCurrentmA", description: "current (mA)"},
+	"7.013":   {name: "DPT_Brightness", description: "brightness (lux)"},
+	"8.001":   {name: "DPT_Value_2_Count", description: "pulses difference"},
+	"8.002":   {name: "DPT_DeltaTimeMsec", description: "time lag (ms)"},
+	"8.005":   {name: "DPT_DeltaTimeSec", description: "time lag (s)"},
+	"8.006":   {name: "DPT_DeltaTimeMin", description: "time lag (min)"},
+	"8.007":   {name: "DPT_DeltaTimeHrs", description: "time lag (h)"},
+	"8.010":   {name: "DPT_Percent_V16", description: "percentage difference", rng: &Range{-327.68, 327.67}, resolution: 0.01},
+	"8.011":   {name: "DPT_Rotation_Angle", description: "rotation angle"},
+	"8.012":   {name: "DPT_Length_m", description: "length (m)"},
+	"9.001":   {name: "DPT_Value_Temp", description: "temperature (°C)", rng: &Range{-273, 670760}, resolution: 0.01},
+	"9.002":   {name: "DPT_Value_Tempd", description: "temperature difference (K)", rng: &Range{-670760, 670760}, resolution: 0.01},
+	"9.003":   {name: "DPT_Value_Tempa", description: "kelvin/hour (K/h)", rng: &Range{-670760, 670760}, resolution: 0.01},
+	"9.004":   {name: "DPT_Value_Lux", description: "illuminance (lux)", rng: &Range{0, 670760}, resolution: 0.01},
+	"9.005":   {name: "DPT_Value_Wsp", description: "wind speed (m/s)", rng: &Range{0, 670760}, resolution: 0.01},
+	"9.006":   {name: "DPT_Value_Pres", description: "pressure (Pa)", rng: &Range{0, 670760}, resolution: 0.01},
+	"9.007":   {name: "DPT_Value_Humidity", description: "humidity (%)", rng: &Range{0, 670760}, resolution: 0.01},
+	"9.008":   {name: "DPT_Value_AirQuality", description: "air quality (ppm)", rng: &Range{0, 670760}, resolution: 0.01},
+	"9.010":   {name: "DPT_Value_Time1", description: "time (s)", rng: &Range{-670760, 670760}, resolution: 0.01},
+	"9.011":   {name: "DPT_Value_Time2", description: "time (ms)", rng: &Range{-670760, 670760}, resolution: 0.01},
+	"9.020":   {name: "DPT_Value_Volt", description: "voltage (mV)", rng: &Range{-670760, 670760}, resolution: 0.01},
+	"9.021":   {name: "DPT_Value_Curr", description: "current (mA)", rng: &Range{-670760, 670760}, resolution: 0.01},
+	"9.022":   {name: "DPT_PowerDensity", description: "power density (W/m²)", rng: &Range{-670760, 670760}, resolution: 0.01},
+	"9.023":   {name: "DPT_KelvinPerPercent", description: "kelvin/percent (K/%)", rng: &Range{-670760, 670760}, resolution: 0.01},
+	"9.024":   {name: "DPT_Power", description: "power (kW)", rng: &Range{-670760, 670760}, resolution: 0.01},
+	"9.025":   {name: "DPT_Value_Volume_Flow", description: "volume flow (l/h)", rng: &Range{-670760, 670760}, resolution: 0.01},
+	"9.026":   {name: "DPT_Rain_Amount", description: "rain amount (l/m²)", rng: &Range{-670760, 670760}, resolution: 0.01},
+	"9.027":   {name: "DPT_Value_Temp_F", description: "temperature (°F)", rng: &Range{-459.6, 670760}, resolution: 0.01},
+	"9.028":   {name: "DPT_Value_Wsp_kmh", description: "wind speed (km/h)", rng: &Range{0, 670760}, resolution: 0.01},
+	"10.001":  {name: "DPT_TimeOfDay", description: "time of day"},
+	"11.001":  {name: "DPT_Date", description: "date"},
+	"12.001":  {name: "DPT_Value_4_Ucount", description: "counter pulses (unsigned)"},
+	"12.100":  {name: "DPT_LongTimePeriod_Sec", description: "counter timesec (s)"},
+	"12.101":  {name: "DPT_LongTimePeriod_Min", description: "counter timemin (min)"},
+	"12.102":  {name: "DPT_LongTimePeriod_Hrs", description: "counter timehrs (h)"},
+	"12.1200": {name: "DPT_VolumeLiquid_Litre", description: "volume liquid (l)"},
+	"12.1201": {name: "DPT_Volume_m3", description: "volume (m³)"},
+	"13.001":  {name: "DPT_Value_4_Count", description: "counter pulses (signed)"},
+	"13.002":  {name: "DPT_FlowRate_m3/h", description: "flow rate (m³/h)"},
+	"13.010":  {name: "DPT_ActiveEnergy", description: "active energy (Wh)"},
+	"13.011":  {name: "DPT_ApparantEnergy", description: "apparent energy (VAh)"},
+	"13.012":  {name: "DPT_ReactiveEnergy", description: "reactive energy (VARh)"},
+	"13.013":  {name: "DPT_ActiveEnergy_kWh", description: "active energy (kWh)"},
+	"13.014":  {name: "DPT_ApparantEnergy_kVAh", description: "apparent energy (kVAh)"},
+	"13.015":  {name: "DPT_ReactiveEnergy_kVARh", description: "reactive energy (kVARh)"},
+	"13.016":  {name: "DPT_ActiveEnergy_MWh", description: "active energy (MWh)"},
+	"13.100":  {name: "DPT_LongDeltaTimeSec", description: "time lag (s)"},
+	"13.1200": {name: "DPT_DeltaVolumeLiquid_Litre", description: "delta volume liquid (l)"},
+	"13.1201": {name: "DPT_DeltaVolume_m3", description: "delta volume (m³)"},
+	"14.000":  {name: "DPT_Value_Acceleration", description: "acceleration"},
+	"14.001":  {name: "DPT_Value_Acceleration_Angular", description: "acceleration angular"},
+	"14.002":  {name: "DPT_Value_Activation_Energy", description: "activation energy"},
+	"14.003":  {name: "DPT_Value_Activity", description: "activity"},
+	"14.004":  {name: "DPT_Value_Mol", description: "amount of substance"},
+	"14.005":  {name: "DPT_Value_Amplitude", description: "amplitude"},
+	"14.006":  {name: "DPT_Value_AngleRad", description: "angle (radian)"},
+	"14.007":  {name: "DPT_Value_AngleDeg", description: "angle (degree)"},
+	"14.008":  {name: "DPT_Value_Angular_Momentum", description: "angular momentum"},
+	"14.009":  {name: "DPT_Value_Angular_Velocity", description: "angular velocity"},
+	"14.010":  {name: "DPT_Value_Area", description: "area"},
+	"14.011":  {name: "DPT_Value_Capacitance", description: "capacitance"},
+	"14.012":  {name: "DPT_Value_Charge_DensitySurface", description: "surface charge density"},
+	"14.013":  {name: "DPT_Value_Charge_DensityVolume", description: "volume charge density"},
+	"14.014":  {name: "DPT_Value_Compressibility", description: "compressibility"},
+	"14.015":  {name: "DPT_Value_Conductance", description: "conductance"},
+	"14.016":  {name: "DPT_Value_Electrical_Conductivity", description: "conductivity, electrical"},
+	"14.017":  {name: "DPT_Value_Density", description: "density"},
+	"14.018":  {name: "DPT_Value_Electric_Charge", description: "electric charge"},
+	"14.019":  {name: "DPT_Value_Electric_Current", description: "electric current"},
+	"14.020":  {name: "DPT_Value_Electric_CurrentDensity", description: "electric current density"},
+	"14.021":  {name: "DPT_Value_Electric_DipoleMoment", description: "electric dipole moment"},
+	"14.022":  {name: "DPT_Value_Electric_Displacement", description: "electric displacement"},
+	"14.023":  {name: "DPT_Value_Electric_FieldStrength", description: "electric field strength"},
+	"14.024":  {name: "DPT_Value_Electric_Flux", description: "electric flux"},
+	"14.025":  {name: "DPT_Value_Electric_FluxDensity", description: "electric flux density"},
+	"14.026":  {name: "DPT_Value_Electric_Polarization", description: "electric polarization"},
+	"14.027":  {name: "DPT_Value_Electric_Potential", description: "electric potential"},
+	"14.028":  {name: "DPT_Value_Electric_PotentialDifference", description: "electric potential difference"},
+	"14.029":  {name: "DPT_Value_ElectromagneticMoment", description: "electromagnetic moment"},
+	"14.030":  {name: "DPT_Value_Electromotive_Force", description: "electromotive force"},
+	"14.031":  {name: "DPT_Value_Energy", description: "energy"},
+	"14.032":  {name: "DPT_Value_Force", description: "force"},
+	"14.033":  {name: "DPT_Value_Frequency", description: "frequency"},
+	"14.034":  {name: "DPT_Value_Angular_Frequency", description: "angular frequency"},
+	"14.035":  {name: "DPT_Value_Heat_Capacity", description: "heat capacity"},
+	"14.036":  {name: "DPT_Value_Heat_FlowRate", description: "heat flow rate"},
+	"14.037":  {name: "DPT_Value_Heat_Quantity", description: "heat quantity"},
+	"14.038":  {name: "DPT_Value_Impedance", description: "impedance"},
+	"14.039":  {name: "DPT_Value_Length", description: "length"},
+	"14.040":  {name: "DPT_Value_Light_Quantity", description: "light quantity"},
+	"14.041":  {name: "DPT_Value_Luminance", description: "luminance"},
+	"14.042":  {name: "DPT_Value_Luminous_Flux", description: "luminous flux"},
+	"14.043":  {name: "DPT_Value_Luminous_Intensity", description: "luminous intensity"},
+	"14.044":  {name: "DPT_Value_Magnetic_FieldStrength", description: "magnetic field strength"},
+	"14.045":  {name: "DPT_Value_Magnetic_Flux", description: "magnetic flux"},
+	"14.046":  {name: "DPT_Value_Magnetic_FluxDensity", description: "magnetic flux density"},
+	"14.047":  {name: "DPT_Value_Magnetic_Moment", description: "magnetic moment"},
+	"14.048":  {name: "DPT_Value_Magnetic_Polarization", description: "magnetic polarization"},
+	"14.049":  {name: "DPT_Value_Magnetization", description: "magnetization"},
+	"14.050":  {name: "DPT_Value_MagnetomotiveForce", description: "magnetomotive force"},
+	"14.051":  {name: "DPT_Value_Mass", description: "mass"},
+	"14.052":  {name: "DPT_Value_MassFlux", description: "mass flux"},
+	"14.053":  {name: "DPT_Value_Momentum", description: "momentum"},
+	"14.054":  {name: "DPT_Value_Phase_AngleRad", description: "phase angle (radian)"},
+	"14.055":  {name: "DPT_Value_Phase_AngleDeg", description: "phase angle (degree)"},
+	"14.056":  {name: "DPT_Value_Power", description: "power"},
+	"14.057":  {name: "DPT_Value_Power_Factor", description: "power factor"},
+	"14.058":  {name: "DPT_Value_Pressure", description: "pressure"},
+	"14.059":  {name: "DPT_Value_Reactance", description: "reactance"},
+	"14.060":  {name: "DPT_Value_Resistance", description: "resistance"},
+	"14.061":  {name: "DPT_Value_Resistivity", description: "resistivity"},
+	"14.062":  {name: "DPT_Value_SelfInductance", description: "self inductance"},
+	"14.063":  {name: "DPT_Value_SolidAngle", description: "solid angle"},
+	"14.064":  {name: "DPT_Value_Sound_Intensity", description: "sound intensity"},
+	"14.065":  {name: "DPT_Value_Speed", description: "speed"},
+	"14.066":  {name: "DPT_Value_Stress", description: "stress"},
+	"14.067":  {name: "DPT_Value_Surface_Tension", description: "surface tension"},
+	"14.068":  {name: "DPT_Value_Common_Temperature", description: "common temperature"},
+	"14.069":  {name: "DPT_Value_Absolute_Temperature", description: "absolute temperature"},
+	"14.070":  {name: "DPT_Value_TemperatureDifference", description: "temperature difference"},
+	"14.071":  {name: "DPT_Value_Thermal_Capacity", description: "thermal capacity"},
+	"14.072":  {name: "DPT_Value_Thermal_Conductivity", description: "thermal conductivity"},
+	"14.073":  {name: "DPT_Value_ThermoelectricPower", description: "thermoelectric power"},
+	"14.074":  {name: "DPT_Value_Time", description: "time"},
+	"14.075":  {name: "DPT_Value_Torque", description: "torque"},
+	"14.076":  {name: "DPT_Value_Volume", description: "volume"},
+	"14.077":  {name: "DPT_Value_Volume_Flux", description: "volume flux"},
+	"14.078":  {name: "DPT_Value_Weight", description: "weight"},
+	"14.079":  {name: "DPT_Value_Work", description: "work"},
+	"15.000":  {name: "DPT_Access_Data", description: "entrance access"},
+	"16.000":  {name: "DPT_String_ASCII", description: "character string (ASCII)"},
+	"16.001":  {name: "DPT_String_8859_1", description: "character string (ISO 8859-1)"},
+	"17.001":  {name: "DPT_SceneNumber", description: "scene number", rng: &Range{0, 63}},
+	"18.001":  {name: "DPT_SceneControl", description: "scene control", rng: &Range{0, 191}},
+	"19.001":  {name: "DPT_DateTime", description: "date and time"},
+	"20.001":  {name: "DPT_SCLOMode", description: "SCLO mode"},
+	"20.002":  {name: "DPT_BuildingMode", description: "building mode"},
+	"20.003":  {name: "DPT_OccMode", description: "occupancy mode"},
+	"20.004":  {name: "DPT_Priority", description: "priority"},
+	"20.005":  {name: "DPT_LightApplicationMode", description: "light application mode"},
+	"20.006":  {name: "DPT_ApplicationArea", description: "application area"},
+	"20.007":  {name: "DPT_AlarmClassType", description: "alarm class type"},
+	"20.008":  {name: "DPT_PSUMode", description: "PSU mode"},
+	"20.011":  {name: "DPT_ErrorClass_System", description: "system error class"},
+	"20.012":  {name: "DPT_ErrorClass_HVAC", description: "HVAC error class"},
+	"20.013":  {name: "DPT_Time_Delay", description: "time delay"},
+	"20.014":  {name: "DPT_Beaufort_Wind_Force_Scale", description: "wind force scale (Beaufort)"},
+	"20.017":  {name: "DPT_SensorSelect", description: "sensor mode"},
+	"20.020":  {name: "DPT_ActuatorConnectType", description: "actuator connect type"},
+	"20.022":  {name: "DPT_PowerReturnMode", description: "power return mode"},
+	"20.100":  {name: "DPT_FuelType", description: "fuel type"},
+	"20.101":  {name: "DPT_BurnerType", description: "burner type"},
+	"20.102":  {name: "DPT_HVACMode", description: "HVAC mode"},
+	"20.103":  {name: "DPT_DHWMode", description: "DHW mode"},
+	"20.104":  {name: "DPT_LoadPriority", description: "load priority"},
+	"20.105":  {name: "DPT_HVACContrMode", description: "HVAC control mode"},
+	"20.106":  {name: "DPT_HVACEmergMode", description: "HVAC emergency mode"},
+	"20.107":  {name: "DPT_ChangeoverMode", description: "changeover mode"},
+	"20.108":  {name: "DPT_ValveMode", description: "valve mode"},
+	"20.110":  {name: "DPT_HeaterMode", description: "heater mode"},
+	"20.111":  {name: "DPT_FanMode", description: "fan mode"},
+	"20.112":  {name: "DPT_MasterSlaveMode", description: "master/slave mode"},
+	"20.113":  {name: "DPT_StatusRoomSetp", description: "status room setpoint"},
+	"20.115":  {name: "DPT_HumDehumMode", description: "humidification/dehumidification mode"},
+	"20.116":  {name: "DPT_EnableHCStage", description: "enable heating/cooling stage"},
+	"20.120":  {name: "DPT_ADAType", description: "air damper actuator type"},
+	"20.121":  {name: "DPT_BackupMode", description: "backup mode"},
+	"20.122":  {name: "DPT_StartSynchronization", description: "start synchronization type"},
+	"20.600":  {name: "DPT_Behaviour_Lock_Unlock", description: "behaviour lock/unlock"},
+	"20.601":  {name: "DPT_Behaviour_Bus_Power_Up_Down", description: "behaviour bus power up/down"},
+	"20.602":  {name: "DPT_DALI_Fade_Time", description: "DALI fade time"},
+	"20.603":  {name: "DPT_BlinkingMode", description: "blinking mode"},
+	"20.604":  {name: "DPT_LightControlMode", description: "light control mode"},
+	"20.605":  {name: "DPT_SwitchPBModel", description: "PB switch mode"},
+	"20.606":  {name: "DPT_PBAction", description: "PB action mode"},
+	"20.607":  {name: "DPT_DimmPBModel", description: "PB dimming mode"},
+	"20.608":  {name: "DPT_SwitchOnMode", description: "switch on mode"},
+	"20.609":  {name: "DPT_LoadTypeSet", description: "load type"},
+	"20.610":  {name: "DPT_LoadTypeDetected", description: "load type detection"},
+	"20.611":  {name: "DPT_Converter_Test_Control", description: "converter test control"},
+	"20.801":  {name: "DPT_SABExcept-Behaviour", description: "SAB except behaviour"},
+	"20.802":  {name: "DPT_SABBehaviour_Lock_Unlock", description: "SAB behaviour on lock/unlock"},
+	"20.803":  {name: "DPT_SSSBMode", description: "SSSB mode"},
+	"20.804":  {name: "DPT_BlindsControlMode", description: "blinds control mode"},
+	"20.1000": {name: "DPT_CommMode", description: "communication mode"},
+	"20.1001": {name: "DPT_AddInfoTypes", description: "additional information type"},
+	"20.1002": {name: "DPT_RF_ModeSelect", description: "RF mode selection"},
+	"20.1003": {name: "DPT_RF_FilterSelect", description: "RF filter mode selection"},
+	"21.001":  {name: "DPT_StatusGen", description: "general status"},
+	"21.601":  {name: "DPT_LightActuatorErrorInfo", description: "light actuator error information"},
+	"22.101":  {name: "DPT_StatusRHCC", description: "RHCC status"},
+	"22.1000": {name: "DPT_Media", description: "media"},
+	"24.001":  {name: "DPT_VarString_8859_1", description: "variable string (ISO 8859-1)"},
+	"26.001":  {name: "DPT_SceneInfo", description: "scene information"},
+	"27.001":  {name: "DPT_CombinedInfoOnOff", description: "combined on/off information"},
+	"28.001":  {name: "DPT_UTF-8", description: "variable string (UTF-8)"},
+	"29.010":  {name: "DPT_ActiveEnergy_V64", description: "active energy (Wh)"},
+	"29.011":  {name: "DPT_ApparantEnergy_V64", description: "apparent energy (VAh)"},
+	"29.012":  {name: "DPT_ReactiveEnergy_V64", description: "reactive energy (VARh)"},
+	"225.001": {name: "DPT_ScalingSpeed", description: "scaling speed"},
+	"225.002": {name: "DPT_Scaling_Step_Time", description: "scaling step time"},
+	"232.600": {name: "DPT_Colour_RGB", description: "RGB colour"},
+	"235.001": {name: "DPT_Tariff_ActiveEnergy", description: "active energy with tariff"},
+	"242.600": {name: "DPT_Colour_xyY", description: "xyY colour"},
+	"246.600": {name: "DPT_Battery_Info", description: "battery information"},
+	"249.600": {name: "DPT_Brightness_Colour_Temperature_Transition", description: "brightness and colour temperature transition"},
+	"250.600": {name: "DPT_Brightness_Colour_Temperature_Control", description: "brightness and colour temperature control"},
+	"251.600": {name: "DPT_Colour_RGBW", description: "RGBW colour"},
+}
+
+// Lookup returns the metadata of the datapoint type with the given identifier, e.g. "9.001".
+func Lookup(id string) (Metadata, bool) {
+	value, ok := Produce(id)
+	if !ok {
+		return Metadata{}, false
+	}
+
+	info := typeInfos[id]
+	meta := Metadata{
+		ID:          id,
+		Name:        info.name,
+		Description: info.description,
+		Resolution:  info.resolution,
+	}
+
+	if unit, ok := value.(DatapointMeta); ok {
+		meta.Unit = unit.Unit()
+	}
+
+	if enum, ok := value.(enumeration); ok {
+		meta.Enum = maps.Clone(enum.names())
+	}
+
+	if info.rng != nil {
+		rng := *info.rng
+		meta.Range = &rng
+	} else {
+		meta.Range, meta.Resolution = kindRange(reflect.TypeOf(value).Elem().Kind())
+	}
+
+	return meta, true
+}
+
+// ListMetadata returns the metadata of all supported datapoint types, ordered by their identifier.
+func ListMetadata() []Metadata {
+	ids := ListSupportedTypes()
+	slices.SortFunc(ids, compareIDs)
+
+	list := make([]Metadata, 0, len(ids))
+	for _, id := range ids {
+		meta, _ := Lookup(id)
+		list = append(list, meta)
+	}
+
+	return list
+}
+
+// compareIDs orders identifiers like "9.001" numerically by their main and sub number.
+func compareIDs(a, b string) int {
+	split := func(id string) (int, int) {
+		main, sub, _ := strings.Cut(id, ".")
+		m, _ := strconv.Atoi(main)
+		s, _ := strconv.Atoi(sub)
+		return m, s
+	}
+
+	am, as := split(a)
+	bm, bs := split(b)
+
+	if am != bm {
+		return am - bm
+	}
+
+	return as - bs
+}
+
+// kindRange derives the range and resolution from the Go type of values which are stored as is.
+func kindRange(kind reflect.Kind) (*Range, float64) {
+	switch kind {
+	case reflect.Bool:
+		return &Range{0, 1}, 1
+	case reflect.Int8:
+		return &Range{math.MinInt8, math.MaxInt8}, 1
+	case reflect.Int16:
+		return &Range{math.MinInt16, math.MaxInt16}, 1
+	case reflect.Int32:
+		return &Range{math.MinInt32, math.MaxInt32}, 1
+	case reflect.Int64:
+		return &Range{math.MinInt64, math.MaxInt64}, 1
+	case reflect.Uint8:
+		return &Range{0, math.MaxUint8}, 1
+	case reflect.Uint16:
+		return &Range{0, math.MaxUint16}, 1
+	case reflect.Uint32:
+		return &Range{0, math.MaxUint32}, 1
+	case reflect.Float32:
+		return &Range{-math.MaxFloat32, math.MaxFloat32}, 0
+	}
+
+	return nil, 0
+}
@@ -0,0 +1,129 @@
+package dpt
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookup(t *testing.T) {
+	meta, ok := Lookup("9.001")
+	if assert.True(t, ok) {
+		assert.Equal(t, Metadata{
+			ID:          "9.001",
+			Name:        "DPT_Value_Temp",
+			Description: "temperature (°C)",
+			Unit:        "°C",
+			Range:       &Range{-273, 670760},
+			Resolution:  0.01,
+		}, meta)
+	}
+
+	meta, ok = Lookup("20.102")
+	if assert.True(t, ok) {
+		assert.Equal(t, "DPT_HVACMode", meta.Name)
+		assert.Equal(t, &Range{0, 255}, meta.Range)
+		assert.Equal(t, "Comfort", meta.Enum[1])
+		assert.Equal(t, "Building Protection", meta.Enum[4])
+	}
+
+	meta, ok = Lookup("1.001")
+	if assert.True(t, ok) {
+		assert.Equal(t, &Range{0, 1}, meta.Range)
+		assert.Equal(t, 1.0, meta.Resolution)
+	}
+
+	meta, ok = Lookup("14.068")
+	if assert.True(t, ok) {
+		assert.Equal(t, &Range{-math.MaxFloat32, math.MaxFloat32}, meta.Range)
+		assert.Zero(t, meta.Resolution)
+	}
+
+	meta, ok = Lookup("232.600")
+	if assert.True(t, ok) {
+		assert.Equal(t, "DPT_Colour_RGB", meta.Name)
+		assert.Nil(t, meta.Range)
+	}
+
+	_, ok = Lookup("999.999")
+	assert.False(t, ok)
+}
+
+func TestLookupAllTypes(t *testing.T) {
+	for _, id := range ListSupportedTypes() {
+		meta, ok := Lookup(id)
+		if !assert.True(t, ok, id) {
+			continue
+		}
+
+		assert.NotEmpty(t, meta.Name, id)
+		assert.NotEmpty(t, meta.Description, id)
+
+		// The metadata must not be shared.
+		if meta.Range != nil {
+			meta.Range.Min = 42
+			again, _ := Lookup(id)
+			assert.NotEqual(t, meta.Range, again.Range, id)
+		}
+	}
+
+	assert.Len(t, typeInfos, len(ListSupportedTypes()))
+}
+
+func TestListMetadata(t *testing.T) {
+	list := ListMetadata()
+	if assert.Len(t, list, len(ListSupportedTypes())) {
+		assert.Equal(t, "1.001", list[0].ID)
+		assert.Equal(t, "251.600", list[len(list)-1].ID)
+	}
+
+	for i := 1; i < len(list); i++ {
+		assert.Negative(t, compareIDs(list[i-1].ID, list[i].ID), "%s before %s", list[i-1].ID, list[i].ID)
+	}
+}
+
+func TestEncodeRangeBounds(t *testing.T) {
+	for _, meta := range ListMetadata() {
+		// The range of enumerations covers reserved values, which are rejected on purpose.
+		if meta.Range == nil || meta.Enum != nil {
+			continue
+		}
+
+		for _, bound := range []float64{meta.Range.Min, meta.Range.Max} {
+			// float64 cannot represent the limits of 64-bit integers exactly.
+			text := strconv.FormatFloat(bound, 'f', -1, 64)
+			switch {
+			case bound >= math.MaxInt64:
+				text = strconv.FormatInt(math.MaxInt64, 10)
+			case bound <= math.MinInt64:
+				text = strconv.FormatInt(math.MinInt64, 10)
+			}
+
+			if _, err := EncodeDPTFromStringN(meta.ID, text); err != nil {
+				t.Errorf("Encoding %s as %s failed: %v", text, meta.ID, err)
+			}
+		}
+	}
+
+	// The limits of float32 types are stored slightly beyond the range.
+	data, err := EncodeDPTFromStringN("8.010", "327.67")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte{0x00, 0x7F, 0xFF}, data)
+	}
+
+	data, err = EncodeDPTFromStringN("8.010", "-327.68")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte{0x00, 0x80, 0x00}, data)
+	}
+
+	_, err = EncodeDPTFromStringN("8.010", "327.68")
+	assert.Error(t, err)
+
+	_, err = EncodeDPTFromStringN("9.027", "-459.6")
+	assert.NoError(t, err)
+
+	_, err = EncodeDPTFromStringN("9.027", "-459.61")
+	assert.Error(t, err)
+}
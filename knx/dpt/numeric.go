@@ -0,0 +1,136 @@
+package dpt
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// NumericValue is implemented by the datapoint values which are a single number, i.e. the numeric,
+// boolean and enumerated types. Booleans are 0 or 1, enumerations are their raw value.
+type NumericValue interface {
+	// Float64 returns the value as float64. Values of 64-bit integer types beyond 2^53 are rounded.
+	Float64() float64
+
+	// SetFloat64 sets the value. It fails if the number is not finite, lies outside of the range
+	// of the datapoint type (see Lookup), is not a whole number for integer types or is reserved
+	// in an enumeration.
+	SetFloat64(value float64) error
+}
+
+// boolFloat64 converts a boolean to 0 or 1.
+func boolFloat64(b bool) float64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+// checkFloat64 checks that the number is finite, lies within the range of the datapoint type of
+// the value and is not a reserved value of an enumeration.
+func checkFloat64(value DatapointValue, number float64) error {
+	if math.IsNaN(number) || math.IsInf(number, 0) {
+		return fmt.Errorf("value %v is not a finite number", number)
+	}
+
+	id, ok := typeID(value)
+	if !ok {
+		return nil
+	}
+
+	if meta, _ := Lookup(id); meta.Range != nil && !inRange(value, *meta.Range, number) {
+		return fmt.Errorf("value %v is outside of the range %s of DPT %s", number, meta.Range, id)
+	}
+
+	// Reserved values of enumerations are rejected like UnmarshalText does. Fractions are left to
+	// the caller.
+	if enum, ok := value.(enumeration); ok && number == math.Trunc(number) {
+		if _, ok := enum.names()[uint8(number)]; !ok {
+			return fmt.Errorf("value %v is reserved in DPT %s", number, id)
+		}
+	}
+
+	return nil
+}
+
+// inRange tells whether the number lies within the range in the precision of the value. A float32
+// stores 327.67 as 327.670013, which must not exceed the range [-327.68, 327.67] of DPT 8.010.
+func inRange(value DatapointValue, r Range, number float64) bool {
+	if reflect.Indirect(reflect.ValueOf(value)).Kind() == reflect.Float32 {
+		f := float32(number)
+		return float32(r.Min) <= f && f <= float32(r.Max)
+	}
+
+	return r.Contains(number)
+}
+
+// setBoolFloat64 sets a boolean from 0 or 1.
+func setBoolFloat64(value DatapointValue, number float64, b *bool) error {
+	if err := checkFloat64(value, number); err != nil {
+		return err
+	}
+
+	if number != 0 && number != 1 {
+		return fmt.Errorf("value %v is neither 0 nor 1", number)
+	}
+
+	*b = number == 1
+
+	return nil
+}
+
+// setFloat32 sets a floating point value.
+func setFloat32(value DatapointValue, number float64, f *float32) error {
+	if err := checkFloat64(value, number); err != nil {
+		return err
+	}
+
+	*f = float32(number)
+
+	return nil
+}
+
+// setInteger sets an integer value. Fractions are rejected rather than truncated.
+func setInteger[T int8 | int16 | int32 | int64 | uint8 | uint16 | uint32](value DatapointValue, number float64, i *T) error {
+	if err := checkFloat64(value, number); err != nil {
+		return err
+	}
+
+	if number != math.Trunc(number) {
+		return fmt.Errorf("value %v is not a whole number", number)
+	}
+
+	// float64(math.MaxInt64) rounds up to 2^63, which would overflow. Only int64 is affected, the
+	// range check limits all other types.
+	if number >= math.MaxInt64 {
+		maxInt64 := int64(math.MaxInt64)
+		*i = T(maxInt64)
+
+		return nil
+	}
+
+	*i = T(number)
+
+	return nil
+}
+
+// DecodeFloat64 unpacks the payload of a group event, i.e. GroupEvent.Data, with the datapoint
+// type and returns its numeric value, e.g. for time series.
+func DecodeFloat64(t DataPointType, data []byte) (float64, error) {
+	value, ok := t.Produce()
+	if !ok {
+		return 0, fmt.Errorf("DPT not supported: %s", t)
+	}
+
+	number, ok := value.(NumericValue)
+	if !ok {
+		return 0, fmt.Errorf("DPT %s is not numeric", t)
+	}
+
+	if err := value.Unpack(data); err != nil {
+		return 0, err
+	}
+
+	return number.Float64(), nil
+}
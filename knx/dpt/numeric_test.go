@@ -0,0 +1,192 @@
+package dpt
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumericValueAllTypes(t *testing.T) {
+	for _, name := range ListSupportedTypes() {
+		t.Run(name, func(t *testing.T) {
+			src, _ := Produce(name)
+
+			number, ok := src.(NumericValue)
+			kind := reflect.TypeOf(src).Elem().Kind()
+			switch kind {
+			case reflect.String, reflect.Struct:
+				assert.False(t, ok, "%T must not implement NumericValue", src)
+				return
+			}
+
+			if !ok {
+				t.Fatalf("%T does not implement NumericValue", src)
+			}
+
+			length := len(src.Pack())
+
+			// A fixed seed keeps failures reproducible.
+			rng := rand.New(rand.NewSource(1))
+
+			for i := 0; i < 20; i++ {
+				data := make([]byte, length)
+				rng.Read(data)
+				if length > 1 {
+					data[0] = 0
+				}
+
+				if src.Unpack(data) != nil {
+					continue
+				}
+
+				f := number.Float64()
+				if math.IsNaN(f) || math.IsInf(f, 0) {
+					continue
+				}
+
+				dst, _ := Produce(name)
+				err := dst.(NumericValue).SetFloat64(f)
+
+				// Reserved values of enumerations are rejected on purpose.
+				if enum, ok := src.(enumeration); ok {
+					if _, named := enum.names()[uint8(f)]; !named {
+						assert.Error(t, err, "SetFloat64(%v) must fail for %T", f, src)
+						continue
+					}
+				}
+
+				if err != nil {
+					t.Errorf("SetFloat64(%v) failed for %s: %v", f, src, err)
+					continue
+				}
+
+				// 64-bit integers beyond 2^53 are rounded by Float64.
+				if kind == reflect.Int64 {
+					assert.Equal(t, f, dst.(NumericValue).Float64())
+					continue
+				}
+
+				if !bytes.Equal(src.Pack(), dst.Pack()) {
+					t.Errorf("Value %s changed to %s after SetFloat64(%v)", src, dst, f)
+				}
+			}
+		})
+	}
+}
+
+func TestSetFloat64(t *testing.T) {
+	tests := []struct {
+		name   string
+		number float64
+		want   DatapointValue
+	}{
+		{"1.001", 1, ptr(DPT_1001(true))},
+		{"1.001", 0, ptr(DPT_1001(false))},
+		{"5.001", 100, ptr(DPT_5001(100))},
+		{"9.001", 21.5, ptr(DPT_9001(21.5))},
+		{"13.001", -5, ptr(DPT_13001(-5))},
+		{"20.102", 3, ptr(DPT_20102(3))},
+		{"29.010", math.MaxInt64, ptr(DPT_29010(math.MaxInt64))},
+		{"29.010", math.MinInt64, ptr(DPT_29010(math.MinInt64))},
+	}
+
+	for _, test := range tests {
+		dst, _ := Produce(test.name)
+		if assert.NoError(t, dst.(NumericValue).SetFloat64(test.number), "%s %v", test.name, test.number) {
+			assert.Equal(t, test.want, dst, "%s %v", test.name, test.number)
+		}
+	}
+
+	errors := []struct {
+		name   string
+		number float64
+	}{
+		{"1.001", 0.5},
+		{"1.001", 2},
+		{"5.001", 100.5},
+		{"7.001", 1.5},
+		{"7.001", -1},
+		{"9.001", math.NaN()},
+		{"9.001", -274},
+		{"13.001", math.MaxInt32 + 1},
+		{"14.068", math.Inf(1)},
+		{"17.001", 64},
+		{"20.102", 256},
+		{"20.107", 3},
+		{"29.010", -math.MaxFloat64},
+	}
+
+	for _, test := range errors {
+		dst, _ := Produce(test.name)
+		before := dst.Pack()
+		if assert.Error(t, dst.(NumericValue).SetFloat64(test.number), "%s %v", test.name, test.number) {
+			assert.Equal(t, before, dst.Pack(), "%s %v must not change the value", test.name, test.number)
+		}
+	}
+}
+
+func TestDecodeFloat64(t *testing.T) {
+	f, err := DecodeFloat64("9.001", []byte{0x00, 0x0c, 0x33})
+	if assert.NoError(t, err) {
+		assert.Equal(t, 21.5, f)
+	}
+
+	f, err = DecodeFloat64("1.001", []byte{0x01})
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1.0, f)
+	}
+
+	f, err = DecodeFloat64("20.102", []byte{0x00, 0x03})
+	if assert.NoError(t, err) {
+		assert.Equal(t, 3.0, f)
+	}
+
+	_, err = DecodeFloat64("9.001", []byte{0x00, 0x0c})
+	assert.ErrorIs(t, err, ErrInvalidLength)
+
+	_, err = DecodeFloat64("232.600", []byte{0, 1, 2, 3})
+	assert.Error(t, err)
+
+	_, err = DecodeFloat64("999.999", []byte{0})
+	assert.Error(t, err)
+}
+
+func TestSetFloat64PackChecked(t *testing.T) {
+	// A fixed seed keeps failures reproducible.
+	rng := rand.New(rand.NewSource(1))
+
+	for _, meta := range ListMetadata() {
+		if meta.Range == nil {
+			continue
+		}
+
+		value, _ := Produce(meta.ID)
+		number, ok := value.(NumericValue)
+		if !ok {
+			continue
+		}
+
+		// The bounds, numbers close to them and some in between.
+		numbers := []float64{meta.Range.Min, meta.Range.Max}
+		for _, bound := range numbers[:2] {
+			numbers = append(numbers, math.Nextafter(bound, math.Inf(-1)), math.Nextafter(bound, math.Inf(1)))
+		}
+		for i := 0; i < 10; i++ {
+			numbers = append(numbers, meta.Range.Min+rng.Float64()*(meta.Range.Max-meta.Range.Min))
+		}
+
+		for _, n := range numbers {
+			if number.SetFloat64(n) != nil {
+				continue
+			}
+
+			if _, err := PackChecked(value); err != nil {
+				t.Errorf("PackChecked rejects %s after SetFloat64(%v) for %s: %v", value, n, meta.ID, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package dpt
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// appendUnit appends the unit, separated by a space, to the formatted value.
+func appendUnit(text []byte, unit string) []byte {
+	if unit == "" {
+		return text
+	}
+
+	return append(append(text, ' '), unit...)
+}
+
+// trimUnit removes the unit from the end of the text. The unit is optional and may or may not be
+// separated by white space.
+func trimUnit(text []byte, unit string) string {
+	value := strings.TrimSpace(string(text))
+
+	if unit != "" {
+		value = strings.TrimSpace(strings.TrimSuffix(value, unit))
+	}
+
+	return value
+}
+
+// numberError describes why the text could not be parsed as a number.
+func numberError(text string, err error) error {
+	if errors.Is(err, strconv.ErrRange) {
+		return fmt.Errorf("value %q is out of range", text)
+	}
+
+	return fmt.Errorf("value %q is not a number", text)
+}
+
+// formatFloatText formats the value with its unit, e.g. "21.5 °C". The shortest representation
+// which parses back to the same value is used.
+func formatFloatText(value float32, unit string) []byte {
+	return appendUnit(strconv.AppendFloat(nil, float64(value), 'f', -1, 32), unit)
+}
+
+// parseFloatText parses a floating point number which may be followed by the unit.
+func parseFloatText(text []byte, unit string, value *float32) error {
+	trimmed := trimUnit(text, unit)
+
+	f, err := strconv.ParseFloat(trimmed, 32)
+	if err != nil {
+		return numberError(trimmed, err)
+	}
+
+	*value = float32(f)
+
+	return nil
+}
+
+// formatIntText formats the value with its unit, e.g. "-5 s".
+func formatIntText[T int8 | int16 | int32 | int64](value T, unit string) []byte {
+	return appendUnit(strconv.AppendInt(nil, int64(value), 10), unit)
+}
+
+// parseIntText parses a signed integer which may be followed by the unit. Values which do not fit
+// into T are rejected.
+func parseIntText[T int8 | int16 | int32 | int64](text []byte, unit string, value *T) error {
+	trimmed := trimUnit(text, unit)
+
+	i, err := strconv.ParseInt(trimmed, 10, 64)
+	if err == nil && int64(T(i)) != i {
+		err = strconv.ErrRange
+	}
+
+	if err != nil {
+		return numberError(trimmed, err)
+	}
+
+	*value = T(i)
+
+	return nil
+}
+
+// formatUintText formats the value with its unit, e.g. "300 lux".
+func formatUintText[T uint8 | uint16 | uint32 | uint64](value T, unit string) []byte {
+	return appendUnit(strconv.AppendUint(nil, uint64(value), 10), unit)
+}
+
+// parseUintText parses an unsigned integer which may be followed by the unit. Values which do not
+// fit into T are rejected.
+func parseUintText[T uint8 | uint16 | uint32 | uint64](text []byte, unit string, value *T) error {
+	trimmed := trimUnit(text, unit)
+
+	u, err := strconv.ParseUint(trimmed, 10, 64)
+	if err == nil && uint64(T(u)) != u {
+		err = strconv.ErrRange
+	}
+
+	if err != nil {
+		return numberError(trimmed, err)
+	}
+
+	*value = T(u)
+
+	return nil
+}
+
+// parseBinaryText parses a B1 value which is given by one of its names or as 0/1 or false/true.
+func parseBinaryText(text []byte, value *bool, off, on fmt.Stringer) error {
+	b, err := parseBinary(string(text), off, on)
+	if err != nil {
+		return err
+	}
+
+	*value = b
+
+	return nil
+}
+
+// parseFieldList parses the form "Key: value Other: value, Flag: true" which the String methods of
+// several structured types produce. Values may consist of several words, trailing commas are
+// removed. Keys are case-sensitive.
+func parseFieldList(text string) (map[string]string, error) {
+	fields := make(map[string]string)
+
+	key := ""
+	for _, word := range strings.Fields(text) {
+		if name, found := strings.CutSuffix(word, ":"); found {
+			if _, exists := fields[name]; exists {
+				return nil, fmt.Errorf("field %q is given twice", name)
+			}
+
+			key = name
+			fields[key] = ""
+
+			continue
+		}
+
+		if key == "" {
+			return nil, fmt.Errorf("value %q is not preceded by a field name", word)
+		}
+
+		if fields[key] != "" {
+			fields[key] += " "
+		}
+
+		fields[key] += strings.TrimSuffix(word, ",")
+	}
+
+	if len(fields) == 0 {
+		return nil, errors.New("no fields given")
+	}
+
+	return fields, nil
+}
+
+// parseFieldBool parses the value of a boolean field.
+func parseFieldBool(text string, value *bool) error {
+	b, err := strconv.ParseBool(text)
+	if err != nil {
+		return fmt.Errorf("value %q is neither true nor false", text)
+	}
+
+	*value = b
+
+	return nil
+}
+
+// parseFieldPercentU8 parses a percentage, e.g. "50.2%", and scales it to the range 0-255.
+func parseFieldPercentU8(text string, value *uint8) error {
+	var percent float32
+	if err := parseFloatText([]byte(text), "%", &percent); err != nil {
+		return err
+	}
+
+	if percent < 0 || percent > 100 {
+		return fmt.Errorf("value %q is not between 0%% and 100%%", text)
+	}
+
+	*value = uint8(percent*255/100 + 0.5)
+
+	return nil
+}
+
+// fieldError reports an invalid value of the named field.
+func fieldError(name string, err error) error {
+	return fmt.Errorf("field %s: %w", name, err)
+}
+
+// unknownFieldError reports a field which the type does not have.
+func unknownFieldError(name string) error {
+	return fmt.Errorf("unknown field %q", name)
+}
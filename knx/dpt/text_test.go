@@ -0,0 +1,186 @@
+package dpt
+
+import (
+	"bytes"
+	"encoding"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTextRoundTripAllTypes(t *testing.T) {
+	for _, name := range ListSupportedTypes() {
+		t.Run(name, func(t *testing.T) {
+			src, _ := Produce(name)
+
+			marshaler, ok := src.(encoding.TextMarshaler)
+			if !ok {
+				t.Fatalf("%T does not implement encoding.TextMarshaler", src)
+			}
+
+			if _, ok := src.(encoding.TextUnmarshaler); !ok {
+				t.Fatalf("%T does not implement encoding.TextUnmarshaler", src)
+			}
+
+			length := len(src.Pack())
+
+			// A fixed seed keeps failures reproducible.
+			rng := rand.New(rand.NewSource(1))
+
+			for i := 0; i < 20; i++ {
+				data := make([]byte, length)
+				rng.Read(data)
+				if length > 1 {
+					data[0] = 0
+				}
+
+				if src.Unpack(data) != nil {
+					continue
+				}
+
+				if validator, ok := src.(interface{ IsValid() bool }); ok && !validator.IsValid() {
+					continue
+				}
+
+				text, err := marshaler.MarshalText()
+				if !assert.NoError(t, err) {
+					return
+				}
+
+				// Reserved values of enumerations are rejected on purpose.
+				if fmt.Sprint(src) == "reserved" {
+					continue
+				}
+
+				dst, _ := Produce(name)
+				if err := dst.(encoding.TextUnmarshaler).UnmarshalText(text); err != nil {
+					t.Errorf("UnmarshalText(%q) failed: %v", text, err)
+					continue
+				}
+
+				again, _ := dst.(encoding.TextMarshaler).MarshalText()
+				if !bytes.Equal(text, again) {
+					t.Errorf("Text %q changed to %q after unmarshalling", text, again)
+				}
+			}
+		})
+	}
+}
+
+func TestUnmarshalTextForms(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want DatapointValue
+	}{
+		{"1.001", "on", ptr(DPT_1001(true))},
+		{"1.001", "OFF", ptr(DPT_1001(false))},
+		{"1.009", "close", ptr(DPT_1009(true))},
+		{"1.009", "0", ptr(DPT_1009(false))},
+		{"5.001", "50 %", ptr(DPT_5001(50))},
+		{"5.001", "50%", ptr(DPT_5001(50))},
+		{"6.010", "-12 counter pulses", ptr(DPT_6010(-12))},
+		{"7.013", "300 lux", ptr(DPT_7013(300))},
+		{"9.001", "21.5 °C", ptr(DPT_9001(21.5))},
+		{"9.001", "21.5°C", ptr(DPT_9001(21.5))},
+		{"9.001", " -3 ", ptr(DPT_9001(-3))},
+		{"12.1200", "42 l", ptr(DPT_12_1200(42))},
+		{"13.010", "-5 Wh", ptr(DPT_13010(-5))},
+		{"14.068", "1e3", ptr(DPT_14068(1000))},
+		{"10.001", "Sat 22:15", ptr(DPT_10001{Weekday: 6, Hour: 22, Minutes: 15})},
+		{"11.001", "2024-02-29", ptr(DPT_11001{Year: 2024, Month: 2, Day: 29})},
+		{"15.000", "123456", ptr(DPT_15000{AccessCode: 123456})},
+		{"15.000", "AccessCode: 42 Index: 3 Permission: true", ptr(DPT_15000{AccessCode: 42, Index: 3, Permission: true})},
+		{"19.001", "2024-05-17 Friday 14:30:00 (summer time)", ptr(DPT_19001{
+			Year: 2024, Month: 5, Day: 17, Weekday: 5, Hour: 14, Minutes: 30, SummerTime: true, NoWorkingDay: true,
+		})},
+		{"19.001", "12:00", ptr(DPT_19001{Hour: 12, NoYear: true, NoDate: true, NoWeekday: true, NoWorkingDay: true})},
+		{"20.102", "comfort", ptr(HVACMode_Comfort)},
+		{"225.001", "50% in 1.5s", ptr(DPT_225001{TimePeriod: 1500, Scaling: 128})},
+		{"225.002", "100% with 20ms per step", ptr(DPT_225002{StepTime: 20, Scaling: 255})},
+		{"235.001", "1234 Wh (tariff 2)", ptr(DPT_235001{ActiveEnergy: 1234, Tariff: 2})},
+		{"235.001", "invalid energy", ptr(DPT_235001{EnergyInvalid: true, TariffInvalid: true})},
+		{"242.600", "x: 20000 y: 21000 Y: 255 ColorValid: true, BrightnessValid: true", ptr(DPT_242600{
+			X: 20000, Y: 21000, YBrightness: 255, ColorValid: true, BrightnessValid: true,
+		})},
+		{"246.600", "ChargeLevel: 100% BatteryFullyCharged: true", ptr(DPT_246600{ChargeLevel: 255, BatteryFullyCharged: true})},
+		{"249.600", "Brightness: 255 ColourTemperature: 2700 K TimePeriod: 2s BrightnessValid: true", ptr(DPT_249600{
+			Brightness: 255, ColourTemperature: 2700, TimePeriod: 20, BrightnessValid: true,
+		})},
+		{"250.600", "ColourTemperature: Increase 2 BrightnessValid: false, ColourTemperatureValid: true", ptr(DPT_250600{
+			ColourTemperature: DPT_3007{Increase: true, StepCode: 2}, ColourTemperatureValid: true,
+		})},
+		{"251.600", "Red: 1 WhiteValid: true", ptr(DPT_251600{Red: 1, WhiteValid: true})},
+		{"27.001", "None", ptr(DPT_27001{})},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name+" "+tc.text, func(t *testing.T) {
+			value, _ := Produce(tc.name)
+			if assert.NoError(t, value.(encoding.TextUnmarshaler).UnmarshalText([]byte(tc.text))) {
+				assert.Equal(t, tc.want, value)
+			}
+		})
+	}
+}
+
+func TestUnmarshalTextErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+	}{
+		{"1.001", "maybe"},
+		{"6.010", "128"},
+		{"7.001", "-1"},
+		{"9.001", "21.5 K"},
+		{"10.001", "Someday 12:00"},
+		{"10.001", "24:00"},
+		{"11.001", "1989-12-31"},
+		{"15.000", "1234567"},
+		{"15.000", "Code: 12"},
+		{"16.000", "Grüße"},
+		{"16.001", "more than fourteen"},
+		{"19.001", "2024-13-01"},
+		{"225.001", "50%"},
+		{"225.001", "50% in 2m"},
+		{"235.001", "12 Wh (tariff x)"},
+		{"246.600", "ChargeLevel: 101%"},
+		{"249.600", "TimePeriod: soon"},
+		{"27.001", "17:on"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name+" "+tc.text, func(t *testing.T) {
+			value, _ := Produce(tc.name)
+			assert.Error(t, value.(encoding.TextUnmarshaler).UnmarshalText([]byte(tc.text)))
+		})
+	}
+}
+
+func TestMarshalText(t *testing.T) {
+	tests := []struct {
+		value encoding.TextMarshaler
+		want  string
+	}{
+		{DPT_1009(false), "Open"},
+		{DPT_5001(12.5), "12.5 %"},
+		{DPT_9001(21.5), "21.5 °C"},
+		{DPT_13010(-5), "-5 Wh"},
+		{DPT_17001(4), "4"},
+		{DPT_20102(42), "42"},
+		{DPT_10001{Weekday: 1, Hour: 7, Minutes: 30}, "Monday 07:30:00"},
+		{DPT_225001{TimePeriod: 1500, Scaling: 128}, "50.2% in 1.5s"},
+	}
+
+	for _, tc := range tests {
+		text, err := tc.value.MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, tc.want, string(text))
+	}
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
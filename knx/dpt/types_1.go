@@ -26,6 +26,30 @@ func (d DPT_1001) String() string {
 	}
 }
 
+func (d DPT_1001) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_1001) UnmarshalText(text []byte) error {
+	return parseBinaryText(text, (*bool)(d), DPT_1001(false), DPT_1001(true))
+}
+
+func (d DPT_1001) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_1001) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*bool)(d))
+}
+
+func (d DPT_1001) Float64() float64 {
+	return boolFloat64(bool(d))
+}
+
+func (d *DPT_1001) SetFloat64(value float64) error {
+	return setBoolFloat64(d, value, (*bool)(d))
+}
+
 // DPT_1002 represents DPT 1.002 (G) / DPT_Bool.
 type DPT_1002 bool
 
@@ -49,6 +73,30 @@ func (d DPT_1002) String() string {
 	}
 }
 
+func (d DPT_1002) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_1002) UnmarshalText(text []byte) error {
+	return parseBinaryText(text, (*bool)(d), DPT_1002(false), DPT_1002(true))
+}
+
+func (d DPT_1002) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_1002) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*bool)(d))
+}
+
+func (d DPT_1002) Float64() float64 {
+	return boolFloat64(bool(d))
+}
+
+func (d *DPT_1002) SetFloat64(value float64) error {
+	return setBoolFloat64(d, value, (*bool)(d))
+}
+
 // DPT_1003 represents DPT 1.003 (G) / DPT_Enable.
 type DPT_1003 bool
 
@@ -72,6 +120,30 @@ func (d DPT_1003) String() string {
 	}
 }
 
+func (d DPT_1003) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_1003) UnmarshalText(text []byte) error {
+	return parseBinaryText(text, (*bool)(d), DPT_1003(false), DPT_1003(true))
+}
+
+func (d DPT_1003) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_1003) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*bool)(d))
+}
+
+func (d DPT_1003) Float64() float64 {
+	return boolFloat64(bool(d))
+}
+
+func (d *DPT_1003) SetFloat64(value float64) error {
+	return setBoolFloat64(d, value, (*bool)(d))
+}
+
 // DPT_1004 represents DPT 1.004 (FB) / DPT_Ramp.
 type DPT_1004 bool
 
@@ -95,6 +167,30 @@ func (d DPT_1004) String() string {
 	}
 }
 
+func (d DPT_1004) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_1004) UnmarshalText(text []byte) error {
+	return parseBinaryText(text, (*bool)(d), DPT_1004(false), DPT_1004(true))
+}
+
+func (d DPT_1004) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_1004) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*bool)(d))
+}
+
+func (d DPT_1004) Float64() float64 {
+	return boolFloat64(bool(d))
+}
+
+func (d *DPT_1004) SetFloat64(value float64) error {
+	return setBoolFloat64(d, value, (*bool)(d))
+}
+
 // DPT_1005 represents DPT 1.005 (FB) / DPT_Alarm.
 type DPT_1005 bool
 
@@ -118,6 +214,30 @@ func (d DPT_1005) String() string {
 	}
 }
 
+func (d DPT_1005) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_1005) UnmarshalText(text []byte) error {
+	return parseBinaryText(text, (*bool)(d), DPT_1005(false), DPT_1005(true))
+}
+
+func (d DPT_1005) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_1005) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*bool)(d))
+}
+
+func (d DPT_1005) Float64() float64 {
+	return boolFloat64(bool(d))
+}
+
+func (d *DPT_1005) SetFloat64(value float64) error {
+	return setBoolFloat64(d, value, (*bool)(d))
+}
+
 // DPT_1006 represents DPT 1.006 (FB) / DPT_BinaryValue.
 type DPT_1006 bool
 
@@ -141,6 +261,30 @@ func (d DPT_1006) String() string {
 	}
 }
 
+func (d DPT_1006) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_1006) UnmarshalText(text []byte) error {
+	return parseBinaryText(text, (*bool)(d), DPT_1006(false), DPT_1006(true))
+}
+
+func (d DPT_1006) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_1006) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*bool)(d))
+}
+
+func (d DPT_1006) Float64() float64 {
+	return boolFloat64(bool(d))
+}
+
+func (d *DPT_1006) SetFloat64(value float64) error {
+	return setBoolFloat64(d, value, (*bool)(d))
+}
+
 // DPT_1007 represents DPT 1.007 (FB) / DPT_Step.
 type DPT_1007 bool
 
@@ -164,6 +308,30 @@ func (d DPT_1007) String() string {
 	}
 }
 
+func (d DPT_1007) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_1007) UnmarshalText(text []byte) error {
+	return parseBinaryText(text, (*bool)(d), DPT_1007(false), DPT_1007(true))
+}
+
+func (d DPT_1007) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_1007) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*bool)(d))
+}
+
+func (d DPT_1007) Float64() float64 {
+	return boolFloat64(bool(d))
+}
+
+func (d *DPT_1007) SetFloat64(value float64) error {
+	return setBoolFloat64(d, value, (*bool)(d))
+}
+
 // DPT_1008 represents DPT 1.008 (G) / DPT_UpDown.
 type DPT_1008 bool
 
@@ -187,6 +355,30 @@ func (d DPT_1008) String() string {
 	}
 }
 
+func (d DPT_1008) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_1008) UnmarshalText(text []byte) error {
+	return parseBinaryText(text, (*bool)(d), DPT_1008(false), DPT_1008(true))
+}
+
+func (d DPT_1008) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_1008) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*bool)(d))
+}
+
+func (d DPT_1008) Float64() float64 {
+	return boolFloat64(bool(d))
+}
+
+func (d *DPT_1008) SetFloat64(value float64) error {
+	return setBoolFloat64(d, value, (*bool)(d))
+}
+
 // DPT_1009 represents DPT 1.009 (G) / DPT_OpenClose.
 type DPT_1009 bool
 
@@ -210,6 +402,30 @@ func (d DPT_1009) String() string {
 	}
 }
 
+func (d DPT_1009) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_1009) UnmarshalText(text []byte) error {
+	return parseBinaryText(text, (*bool)(d), DPT_1009(false), DPT_1009(true))
+}
+
+func (d DPT_1009) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_1009) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*bool)(d))
+}
+
+func (d DPT_1009) Float64() float64 {
+	return boolFloat64(bool(d))
+}
+
+func (d *DPT_1009) SetFloat64(value float64) error {
+	return setBoolFloat64(d, value, (*bool)(d))
+}
+
 // DPT_1010 represents DPT 1.010 (G) / DPT_Start.
 type DPT_1010 bool
 
@@ -233,6 +449,30 @@ func (d DPT_1010) String() string {
 	}
 }
 
+func (d DPT_1010) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_1010) UnmarshalText(text []byte) error {
+	return parseBinaryText(text, (*bool)(d), DPT_1010(false), DPT_1010(true))
+}
+
+func (d DPT_1010) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_1010) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*bool)(d))
+}
+
+func (d DPT_1010) Float64() float64 {
+	return boolFloat64(bool(d))
+}
+
+func (d *DPT_1010) SetFloat64(value float64) error {
+	return setBoolFloat64(d, value, (*bool)(d))
+}
+
 // DPT_1011 represents DPT 1.011 (FB) / DPT_State.
 type DPT_1011 bool
 
@@ -256,6 +496,30 @@ func (d DPT_1011) String() string {
 	}
 }
 
+func (d DPT_1011) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_1011) UnmarshalText(text []byte) error {
+	return parseBinaryText(text, (*bool)(d), DPT_1011(false), DPT_1011(true))
+}
+
+func (d DPT_1011) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_1011) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*bool)(d))
+}
+
+func (d DPT_1011) Float64() float64 {
+	return boolFloat64(bool(d))
+}
+
+func (d *DPT_1011) SetFloat64(value float64) error {
+	return setBoolFloat64(d, value, (*bool)(d))
+}
+
 // DPT_1012 represents DPT 1.012 (FB) / DPT_Invert.
 type DPT_1012 bool
 
@@ -279,6 +543,30 @@ func (d DPT_1012) String() string {
 	}
 }
 
+func (d DPT_1012) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_1012) UnmarshalText(text []byte) error {
+	return parseBinaryText(text, (*bool)(d), DPT_1012(false), DPT_1012(true))
+}
+
+func (d DPT_1012) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_1012) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*bool)(d))
+}
+
+func (d DPT_1012) Float64() float64 {
+	return boolFloat64(bool(d))
+}
+
+func (d *DPT_1012) SetFloat64(value float64) error {
+	return setBoolFloat64(d, value, (*bool)(d))
+}
+
 // DPT_1013 represents DPT 1.013 (FB) / DPT_DimSendStyle.
 type DPT_1013 bool
 
@@ -302,6 +590,30 @@ func (d DPT_1013) String() string {
 	}
 }
 
+func (d DPT_1013) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_1013) UnmarshalText(text []byte) error {
+	return parseBinaryText(text, (*bool)(d), DPT_1013(false), DPT_1013(true))
+}
+
+func (d DPT_1013) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_1013) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*bool)(d))
+}
+
+func (d DPT_1013) Float64() float64 {
+	return boolFloat64(bool(d))
+}
+
+func (d *DPT_1013) SetFloat64(value float64) error {
+	return setBoolFloat64(d, value, (*bool)(d))
+}
+
 // DPT_1014 represents DPT 1.014 (FB) / DPT_InputSource.
 type DPT_1014 bool
 
@@ -325,6 +637,30 @@ func (d DPT_1014) String() string {
 	}
 }
 
+func (d DPT_1014) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_1014) UnmarshalText(text []byte) error {
+	return parseBinaryText(text, (*bool)(d), DPT_1014(false), DPT_1014(true))
+}
+
+func (d DPT_1014) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_1014) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*bool)(d))
+}
+
+func (d DPT_1014) Float64() float64 {
+	return boolFloat64(bool(d))
+}
+
+func (d *DPT_1014) SetFloat64(value float64) error {
+	return setBoolFloat64(d, value, (*bool)(d))
+}
+
 // DPT_1015 represents DPT 1.015 (G) / DPT_Reset.
 type DPT_1015 bool
 
@@ -348,6 +684,30 @@ func (d DPT_1015) String() string {
 	}
 }
 
+func (d DPT_1015) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_1015) UnmarshalText(text []byte) error {
+	return parseBinaryText(text, (*bool)(d), DPT_1015(false), DPT_1015(true))
+}
+
+func (d DPT_1015) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_1015) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*bool)(d))
+}
+
+func (d DPT_1015) Float64() float64 {
+	return boolFloat64(bool(d))
+}
+
+func (d *DPT_1015) SetFloat64(value float64) error {
+	return setBoolFloat64(d, value, (*bool)(d))
+}
+
 // DPT_1016 represents DPT 1.016 (G) / DPT_Ack.
 type DPT_1016 bool
 
@@ -371,6 +731,30 @@ func (d DPT_1016) String() string {
 	}
 }
 
+func (d DPT_1016) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_1016) UnmarshalText(text []byte) error {
+	return parseBinaryText(text, (*bool)(d), DPT_1016(false), DPT_1016(true))
+}
+
+func (d DPT_1016) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_1016) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*bool)(d))
+}
+
+func (d DPT_1016) Float64() float64 {
+	return boolFloat64(bool(d))
+}
+
+func (d *DPT_1016) SetFloat64(value float64) error {
+	return setBoolFloat64(d, value, (*bool)(d))
+}
+
 // DPT_1017 represents DPT 1.017 (G) / DPT_Trigger.
 type DPT_1017 bool
 
@@ -394,6 +778,30 @@ func (d DPT_1017) String() string {
 	}
 }
 
+func (d DPT_1017) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_1017) UnmarshalText(text []byte) error {
+	return parseBinaryText(text, (*bool)(d), DPT_1017(false), DPT_1017(true))
+}
+
+func (d DPT_1017) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_1017) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*bool)(d))
+}
+
+func (d DPT_1017) Float64() float64 {
+	return boolFloat64(bool(d))
+}
+
+func (d *DPT_1017) SetFloat64(value float64) error {
+	return setBoolFloat64(d, value, (*bool)(d))
+}
+
 // DPT_1018 represents DPT 1.018 (G) / DPT_Occupancy.
 type DPT_1018 bool
 
@@ -417,6 +825,30 @@ func (d DPT_1018) String() string {
 	}
 }
 
+func (d DPT_1018) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_1018) UnmarshalText(text []byte) error {
+	return parseBinaryText(text, (*bool)(d), DPT_1018(false), DPT_1018(true))
+}
+
+func (d DPT_1018) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_1018) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*bool)(d))
+}
+
+func (d DPT_1018) Float64() float64 {
+	return boolFloat64(bool(d))
+}
+
+func (d *DPT_1018) SetFloat64(value float64) error {
+	return setBoolFloat64(d, value, (*bool)(d))
+}
+
 // DPT_1019 represents DPT 1.019 (G) / DPT_Window_Door.
 type DPT_1019 bool
 
@@ -440,6 +872,30 @@ func (d DPT_1019) String() string {
 	}
 }
 
+func (d DPT_1019) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_1019) UnmarshalText(text []byte) error {
+	return parseBinaryText(text, (*bool)(d), DPT_1019(false), DPT_1019(true))
+}
+
+func (d DPT_1019) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_1019) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*bool)(d))
+}
+
+func (d DPT_1019) Float64() float64 {
+	return boolFloat64(bool(d))
+}
+
+func (d *DPT_1019) SetFloat64(value float64) error {
+	return setBoolFloat64(d, value, (*bool)(d))
+}
+
 // DPT_1021 represents DPT 1.021 (FB) / DPT_LogicalFunction.
 type DPT_1021 bool
 
@@ -463,6 +919,30 @@ func (d DPT_1021) String() string {
 	}
 }
 
+func (d DPT_1021) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_1021) UnmarshalText(text []byte) error {
+	return parseBinaryText(text, (*bool)(d), DPT_1021(false), DPT_1021(true))
+}
+
+func (d DPT_1021) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_1021) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*bool)(d))
+}
+
+func (d DPT_1021) Float64() float64 {
+	return boolFloat64(bool(d))
+}
+
+func (d *DPT_1021) SetFloat64(value float64) error {
+	return setBoolFloat64(d, value, (*bool)(d))
+}
+
 // DPT_1022 represents DPT 1.022 (FB) / DPT_Scene_AB.
 type DPT_1022 bool
 
@@ -486,6 +966,30 @@ func (d DPT_1022) String() string {
 	}
 }
 
+func (d DPT_1022) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_1022) UnmarshalText(text []byte) error {
+	return parseBinaryText(text, (*bool)(d), DPT_1022(false), DPT_1022(true))
+}
+
+func (d DPT_1022) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_1022) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*bool)(d))
+}
+
+func (d DPT_1022) Float64() float64 {
+	return boolFloat64(bool(d))
+}
+
+func (d *DPT_1022) SetFloat64(value float64) error {
+	return setBoolFloat64(d, value, (*bool)(d))
+}
+
 // DPT_1023 represents DPT 1.023 (FB) / DPT_ShutterBlinds_Mode.
 type DPT_1023 bool
 
@@ -509,6 +1013,30 @@ func (d DPT_1023) String() string {
 	}
 }
 
+func (d DPT_1023) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_1023) UnmarshalText(text []byte) error {
+	return parseBinaryText(text, (*bool)(d), DPT_1023(false), DPT_1023(true))
+}
+
+func (d DPT_1023) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_1023) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*bool)(d))
+}
+
+func (d DPT_1023) Float64() float64 {
+	return boolFloat64(bool(d))
+}
+
+func (d *DPT_1023) SetFloat64(value float64) error {
+	return setBoolFloat64(d, value, (*bool)(d))
+}
+
 // DPT_1024 represents DPT 1.024 (G) / DPT_DayNight.
 type DPT_1024 bool
 
@@ -532,6 +1060,30 @@ func (d DPT_1024) String() string {
 	}
 }
 
+func (d DPT_1024) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_1024) UnmarshalText(text []byte) error {
+	return parseBinaryText(text, (*bool)(d), DPT_1024(false), DPT_1024(true))
+}
+
+func (d DPT_1024) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_1024) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*bool)(d))
+}
+
+func (d DPT_1024) Float64() float64 {
+	return boolFloat64(bool(d))
+}
+
+func (d *DPT_1024) SetFloat64(value float64) error {
+	return setBoolFloat64(d, value, (*bool)(d))
+}
+
 // DPT_1100 represents DPT 1.100 (FB) / DPT_Heat/Cool.
 type DPT_1100 bool
 
@@ -554,3 +1106,27 @@ func (d DPT_1100) String() string {
 		return "cooling"
 	}
 }
+
+func (d DPT_1100) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_1100) UnmarshalText(text []byte) error {
+	return parseBinaryText(text, (*bool)(d), DPT_1100(false), DPT_1100(true))
+}
+
+func (d DPT_1100) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_1100) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*bool)(d))
+}
+
+func (d DPT_1100) Float64() float64 {
+	return boolFloat64(bool(d))
+}
+
+func (d *DPT_1100) SetFloat64(value float64) error {
+	return setBoolFloat64(d, value, (*bool)(d))
+}
@@ -5,6 +5,8 @@ package dpt
 
 import (
 	"fmt"
+	"strings"
+	"time"
 )
 
 // DPT_10001 represents DPT 10.001 / TimeOfDay p. 34.
@@ -48,15 +50,78 @@ func (d DPT_10001) Unit() string {
 	return ""
 }
 
+// SetTime sets the weekday and the time of day from the given time.
+func (d *DPT_10001) SetTime(t time.Time) {
+	*d = DPT_10001{
+		Weekday: knxWeekday(t.Weekday()),
+		Hour:    uint8(t.Hour()),
+		Minutes: uint8(t.Minute()),
+		Seconds: uint8(t.Second()),
+	}
+}
+
+// Time returns the time of day on the date of the given day, in its location. The weekday is
+// ignored.
+func (d DPT_10001) Time(day time.Time) time.Time {
+	year, month, date := day.Date()
+	return time.Date(year, month, date, int(d.Hour), int(d.Minutes), int(d.Seconds), 0, day.Location())
+}
+
 func (d DPT_10001) IsValid() bool {
 	return (d.Weekday <= 7 && d.Hour <= 23 && d.Minutes <= 59 && d.Seconds <= 59)
 }
 
 func (d DPT_10001) String() string {
-	weekday := []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
 	if 0 < d.Weekday && d.Weekday <= 7 {
-		return fmt.Sprintf("%s %02d:%02d:%02d", weekday[d.Weekday-1], d.Hour, d.Minutes, d.Seconds)
+		return fmt.Sprintf("%s %02d:%02d:%02d", knxWeekdayNames[d.Weekday-1], d.Hour, d.Minutes, d.Seconds)
 	} else {
 		return fmt.Sprintf("%02d:%02d:%02d", d.Hour, d.Minutes, d.Seconds)
 	}
 }
+
+func (d DPT_10001) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses the time of day in the form HH:MM[:SS], optionally preceded by the weekday,
+// e.g. "Monday 07:30" or "Sat 22:15:00".
+func (d *DPT_10001) UnmarshalText(text []byte) error {
+	fields := strings.Fields(string(text))
+
+	var value DPT_10001
+	if len(fields) == 2 {
+		weekday, ok := parseKNXWeekday(fields[0])
+		if !ok {
+			return fmt.Errorf("weekday %q is unknown", fields[0])
+		}
+
+		value.Weekday = weekday
+		fields = fields[1:]
+	}
+
+	if len(fields) != 1 {
+		return fmt.Errorf("time of day %q is not in the form \"[weekday] HH:MM[:SS]\"", text)
+	}
+
+	var err error
+	if value.Hour, value.Minutes, value.Seconds, err = parseClock(fields[0]); err != nil {
+		return err
+	}
+
+	if !value.IsValid() {
+		return fmt.Errorf("time of day %q is out of range", text)
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d DPT_10001) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_10001) UnmarshalJSON(data []byte) error {
+	type plain DPT_10001
+	return unmarshalJSON(data, d, (*plain)(d))
+}
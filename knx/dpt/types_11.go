@@ -5,6 +5,7 @@ package dpt
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -71,6 +72,20 @@ func (d DPT_11001) Unit() string {
 	return ""
 }
 
+// SetTime sets the date from the given time.
+func (d *DPT_11001) SetTime(t time.Time) {
+	*d = DPT_11001{
+		Year:  uint16(t.Year()),
+		Month: uint8(t.Month()),
+		Day:   uint8(t.Day()),
+	}
+}
+
+// Time returns midnight at the beginning of the date in the given location.
+func (d DPT_11001) Time(loc *time.Location) time.Time {
+	return time.Date(int(d.Year), time.Month(d.Month), int(d.Day), 0, 0, 0, 0, loc)
+}
+
 func (d DPT_11001) IsValid() bool {
 	tm := time.Date(int(d.Year), time.Month(d.Month), int(d.Day), 0, 0, 0, 0, time.UTC)
 	if tm.Year() < 1990 || tm.Year() > 2089 {
@@ -83,3 +98,35 @@ func (d DPT_11001) IsValid() bool {
 func (d DPT_11001) String() string {
 	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
 }
+
+func (d DPT_11001) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses the date in the form YYYY-MM-DD.
+func (d *DPT_11001) UnmarshalText(text []byte) error {
+	t, err := time.Parse(time.DateOnly, strings.TrimSpace(string(text)))
+	if err != nil {
+		return fmt.Errorf("date %q is not in the form YYYY-MM-DD", text)
+	}
+
+	var value DPT_11001
+	value.SetTime(t)
+
+	if !value.IsValid() {
+		return fmt.Errorf("date %q is not between 1990 and 2089", text)
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d DPT_11001) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_11001) UnmarshalJSON(data []byte) error {
+	type plain DPT_11001
+	return unmarshalJSON(data, d, (*plain)(d))
+}
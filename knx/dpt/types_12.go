@@ -20,3 +20,242 @@ func (d DPT_12001) Unit() string {
 func (d DPT_12001) String() string {
 	return fmt.Sprintf("%d pulses", uint32(d))
 }
+
+func (d DPT_12001) MarshalText() ([]byte, error) {
+	return formatUintText(uint32(d), d.Unit()), nil
+}
+
+func (d *DPT_12001) UnmarshalText(text []byte) error {
+	return parseUintText(text, d.Unit(), (*uint32)(d))
+}
+
+func (d DPT_12001) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_12001) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint32)(d))
+}
+
+func (d DPT_12001) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_12001) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint32)(d))
+}
+
+// DPT_12100 represents DPT 12.100 / long time period (s).
+type DPT_12100 uint32
+
+func (d DPT_12100) Pack() []byte {
+	return packU32(uint32(d))
+}
+
+func (d *DPT_12100) Unpack(data []byte) error {
+	return unpackU32(data, (*uint32)(d))
+}
+
+func (d DPT_12100) Unit() string {
+	return "s"
+}
+
+func (d DPT_12100) String() string {
+	return fmt.Sprintf("%d s", uint32(d))
+}
+
+func (d DPT_12100) MarshalText() ([]byte, error) {
+	return formatUintText(uint32(d), d.Unit()), nil
+}
+
+func (d *DPT_12100) UnmarshalText(text []byte) error {
+	return parseUintText(text, d.Unit(), (*uint32)(d))
+}
+
+func (d DPT_12100) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_12100) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint32)(d))
+}
+
+func (d DPT_12100) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_12100) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint32)(d))
+}
+
+// DPT_12101 represents DPT 12.101 / long time period (min).
+type DPT_12101 uint32
+
+func (d DPT_12101) Pack() []byte {
+	return packU32(uint32(d))
+}
+
+func (d *DPT_12101) Unpack(data []byte) error {
+	return unpackU32(data, (*uint32)(d))
+}
+
+func (d DPT_12101) Unit() string {
+	return "min"
+}
+
+func (d DPT_12101) String() string {
+	return fmt.Sprintf("%d min", uint32(d))
+}
+
+func (d DPT_12101) MarshalText() ([]byte, error) {
+	return formatUintText(uint32(d), d.Unit()), nil
+}
+
+func (d *DPT_12101) UnmarshalText(text []byte) error {
+	return parseUintText(text, d.Unit(), (*uint32)(d))
+}
+
+func (d DPT_12101) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_12101) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint32)(d))
+}
+
+func (d DPT_12101) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_12101) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint32)(d))
+}
+
+// DPT_12102 represents DPT 12.102 / long time period (h).
+type DPT_12102 uint32
+
+func (d DPT_12102) Pack() []byte {
+	return packU32(uint32(d))
+}
+
+func (d *DPT_12102) Unpack(data []byte) error {
+	return unpackU32(data, (*uint32)(d))
+}
+
+func (d DPT_12102) Unit() string {
+	return "h"
+}
+
+func (d DPT_12102) String() string {
+	return fmt.Sprintf("%d h", uint32(d))
+}
+
+func (d DPT_12102) MarshalText() ([]byte, error) {
+	return formatUintText(uint32(d), d.Unit()), nil
+}
+
+func (d *DPT_12102) UnmarshalText(text []byte) error {
+	return parseUintText(text, d.Unit(), (*uint32)(d))
+}
+
+func (d DPT_12102) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_12102) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint32)(d))
+}
+
+func (d DPT_12102) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_12102) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint32)(d))
+}
+
+// DPT_12_1200 represents DPT 12.1200 / volume liquid (l).
+type DPT_12_1200 uint32
+
+func (d DPT_12_1200) Pack() []byte {
+	return packU32(uint32(d))
+}
+
+func (d *DPT_12_1200) Unpack(data []byte) error {
+	return unpackU32(data, (*uint32)(d))
+}
+
+func (d DPT_12_1200) Unit() string {
+	return "l"
+}
+
+func (d DPT_12_1200) String() string {
+	return fmt.Sprintf("%d l", uint32(d))
+}
+
+func (d DPT_12_1200) MarshalText() ([]byte, error) {
+	return formatUintText(uint32(d), d.Unit()), nil
+}
+
+func (d *DPT_12_1200) UnmarshalText(text []byte) error {
+	return parseUintText(text, d.Unit(), (*uint32)(d))
+}
+
+func (d DPT_12_1200) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_12_1200) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint32)(d))
+}
+
+func (d DPT_12_1200) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_12_1200) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint32)(d))
+}
+
+// DPT_12_1201 represents DPT 12.1201 / volume (m^3).
+type DPT_12_1201 uint32
+
+func (d DPT_12_1201) Pack() []byte {
+	return packU32(uint32(d))
+}
+
+func (d *DPT_12_1201) Unpack(data []byte) error {
+	return unpackU32(data, (*uint32)(d))
+}
+
+func (d DPT_12_1201) Unit() string {
+	return "m^3"
+}
+
+func (d DPT_12_1201) String() string {
+	return fmt.Sprintf("%d m^3", uint32(d))
+}
+
+func (d DPT_12_1201) MarshalText() ([]byte, error) {
+	return formatUintText(uint32(d), d.Unit()), nil
+}
+
+func (d *DPT_12_1201) UnmarshalText(text []byte) error {
+	return parseUintText(text, d.Unit(), (*uint32)(d))
+}
+
+func (d DPT_12_1201) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_12_1201) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint32)(d))
+}
+
+func (d DPT_12_1201) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_12_1201) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint32)(d))
+}
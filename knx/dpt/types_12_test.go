@@ -1,6 +1,7 @@
 package dpt
 
 import (
+	"bytes"
 	"math/rand"
 	"testing"
 )
@@ -25,3 +26,41 @@ func TestDPT_12001(t *testing.T) {
 		}
 	}
 }
+
+// Test the long time period and volume types 12.100 - 12.102 and 12.1200 - 12.1201
+func TestDPT_121xx(t *testing.T) {
+	types := []struct {
+		value DatapointValue
+		unit  string
+	}{
+		{new(DPT_12100), "s"},
+		{new(DPT_12101), "min"},
+		{new(DPT_12102), "h"},
+		{new(DPT_12_1200), "l"},
+		{new(DPT_12_1201), "m^3"},
+	}
+
+	for _, typ := range types {
+		if unit := typ.value.(DatapointMeta).Unit(); unit != typ.unit {
+			t.Errorf("Unit of %T is \"%s\", expected \"%s\"", typ.value, unit, typ.unit)
+		}
+
+		buf := []byte{0, 0xDE, 0xAD, 0xBE, 0xEF}
+		if err := typ.value.Unpack(buf); err != nil {
+			t.Errorf("Unpacking of %T failed: %v", typ.value, err)
+			continue
+		}
+
+		if got := typ.value.Pack(); !bytes.Equal(got, buf) {
+			t.Errorf("Value \"%s\" packed to %v, expected %v", typ.value, got, buf)
+		}
+
+		if err := typ.value.Unpack(buf[:4]); err != ErrInvalidLength {
+			t.Errorf("Unpacking of %T with an invalid length returned %v", typ.value, err)
+		}
+	}
+
+	if s := DPT_12_1200(42).String(); s != "42 l" {
+		t.Errorf("String of DPT 12.1200 is \"%s\", expected \"42 l\"", s)
+	}
+}
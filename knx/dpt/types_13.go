@@ -26,6 +26,30 @@ func (d DPT_13001) String() string {
 	return fmt.Sprintf("%d pulses", int32(d))
 }
 
+func (d DPT_13001) MarshalText() ([]byte, error) {
+	return formatIntText(int32(d), d.Unit()), nil
+}
+
+func (d *DPT_13001) UnmarshalText(text []byte) error {
+	return parseIntText(text, d.Unit(), (*int32)(d))
+}
+
+func (d DPT_13001) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_13001) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*int32)(d))
+}
+
+func (d DPT_13001) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_13001) SetFloat64(value float64) error {
+	return setInteger(d, value, (*int32)(d))
+}
+
 // DPT_13002 represents DPT 13.002 / flow rate (m^3/h).
 type DPT_13002 int32
 
@@ -45,6 +69,30 @@ func (d DPT_13002) String() string {
 	return fmt.Sprintf("%d m^3/h", int32(d))
 }
 
+func (d DPT_13002) MarshalText() ([]byte, error) {
+	return formatIntText(int32(d), d.Unit()), nil
+}
+
+func (d *DPT_13002) UnmarshalText(text []byte) error {
+	return parseIntText(text, d.Unit(), (*int32)(d))
+}
+
+func (d DPT_13002) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_13002) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*int32)(d))
+}
+
+func (d DPT_13002) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_13002) SetFloat64(value float64) error {
+	return setInteger(d, value, (*int32)(d))
+}
+
 // DPT_13010 represents DPT 13.010 / active energy (Wh).
 type DPT_13010 int32
 
@@ -64,6 +112,30 @@ func (d DPT_13010) String() string {
 	return fmt.Sprintf("%d Wh", int32(d))
 }
 
+func (d DPT_13010) MarshalText() ([]byte, error) {
+	return formatIntText(int32(d), d.Unit()), nil
+}
+
+func (d *DPT_13010) UnmarshalText(text []byte) error {
+	return parseIntText(text, d.Unit(), (*int32)(d))
+}
+
+func (d DPT_13010) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_13010) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*int32)(d))
+}
+
+func (d DPT_13010) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_13010) SetFloat64(value float64) error {
+	return setInteger(d, value, (*int32)(d))
+}
+
 // DPT_13011 represents DPT 13.011 / apparant energy (VAh).
 type DPT_13011 int32
 
@@ -83,6 +155,30 @@ func (d DPT_13011) String() string {
 	return fmt.Sprintf("%d VAh", int32(d))
 }
 
+func (d DPT_13011) MarshalText() ([]byte, error) {
+	return formatIntText(int32(d), d.Unit()), nil
+}
+
+func (d *DPT_13011) UnmarshalText(text []byte) error {
+	return parseIntText(text, d.Unit(), (*int32)(d))
+}
+
+func (d DPT_13011) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_13011) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*int32)(d))
+}
+
+func (d DPT_13011) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_13011) SetFloat64(value float64) error {
+	return setInteger(d, value, (*int32)(d))
+}
+
 // DPT_13012 represents DPT 13.012 / reactive energy (VARh).
 type DPT_13012 int32
 
@@ -102,6 +198,30 @@ func (d DPT_13012) String() string {
 	return fmt.Sprintf("%d VARh", int32(d))
 }
 
+func (d DPT_13012) MarshalText() ([]byte, error) {
+	return formatIntText(int32(d), d.Unit()), nil
+}
+
+func (d *DPT_13012) UnmarshalText(text []byte) error {
+	return parseIntText(text, d.Unit(), (*int32)(d))
+}
+
+func (d DPT_13012) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_13012) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*int32)(d))
+}
+
+func (d DPT_13012) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_13012) SetFloat64(value float64) error {
+	return setInteger(d, value, (*int32)(d))
+}
+
 // DPT_13013 represents DPT 13.013 / active energy (kWh).
 type DPT_13013 int32
 
@@ -121,6 +241,30 @@ func (d DPT_13013) String() string {
 	return fmt.Sprintf("%d kWh", int32(d))
 }
 
+func (d DPT_13013) MarshalText() ([]byte, error) {
+	return formatIntText(int32(d), d.Unit()), nil
+}
+
+func (d *DPT_13013) UnmarshalText(text []byte) error {
+	return parseIntText(text, d.Unit(), (*int32)(d))
+}
+
+func (d DPT_13013) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_13013) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*int32)(d))
+}
+
+func (d DPT_13013) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_13013) SetFloat64(value float64) error {
+	return setInteger(d, value, (*int32)(d))
+}
+
 // DPT_13014 represents DPT 13.014 / apparant energy (kVAh).
 type DPT_13014 int32
 
@@ -140,6 +284,30 @@ func (d DPT_13014) String() string {
 	return fmt.Sprintf("%d kVAh", int32(d))
 }
 
+func (d DPT_13014) MarshalText() ([]byte, error) {
+	return formatIntText(int32(d), d.Unit()), nil
+}
+
+func (d *DPT_13014) UnmarshalText(text []byte) error {
+	return parseIntText(text, d.Unit(), (*int32)(d))
+}
+
+func (d DPT_13014) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_13014) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*int32)(d))
+}
+
+func (d DPT_13014) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_13014) SetFloat64(value float64) error {
+	return setInteger(d, value, (*int32)(d))
+}
+
 // DPT_13015 represents DPT 13.015 / reactive energy (kVARh).
 type DPT_13015 int32
 
@@ -159,6 +327,30 @@ func (d DPT_13015) String() string {
 	return fmt.Sprintf("%d kVARh", int32(d))
 }
 
+func (d DPT_13015) MarshalText() ([]byte, error) {
+	return formatIntText(int32(d), d.Unit()), nil
+}
+
+func (d *DPT_13015) UnmarshalText(text []byte) error {
+	return parseIntText(text, d.Unit(), (*int32)(d))
+}
+
+func (d DPT_13015) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_13015) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*int32)(d))
+}
+
+func (d DPT_13015) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_13015) SetFloat64(value float64) error {
+	return setInteger(d, value, (*int32)(d))
+}
+
 // DPT_13016 represents DPT 13.016 / apparant energy (MWh).
 type DPT_13016 int32
 
@@ -178,6 +370,30 @@ func (d DPT_13016) String() string {
 	return fmt.Sprintf("%d MWh", int32(d))
 }
 
+func (d DPT_13016) MarshalText() ([]byte, error) {
+	return formatIntText(int32(d), d.Unit()), nil
+}
+
+func (d *DPT_13016) UnmarshalText(text []byte) error {
+	return parseIntText(text, d.Unit(), (*int32)(d))
+}
+
+func (d DPT_13016) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_13016) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*int32)(d))
+}
+
+func (d DPT_13016) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_13016) SetFloat64(value float64) error {
+	return setInteger(d, value, (*int32)(d))
+}
+
 // DPT_13100 represents DPT 13.100 / delta time (s).
 type DPT_13100 int32
 
@@ -196,3 +412,113 @@ func (d DPT_13100) Unit() string {
 func (d DPT_13100) String() string {
 	return fmt.Sprintf("%d s", int32(d))
 }
+
+func (d DPT_13100) MarshalText() ([]byte, error) {
+	return formatIntText(int32(d), d.Unit()), nil
+}
+
+func (d *DPT_13100) UnmarshalText(text []byte) error {
+	return parseIntText(text, d.Unit(), (*int32)(d))
+}
+
+func (d DPT_13100) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_13100) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*int32)(d))
+}
+
+func (d DPT_13100) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_13100) SetFloat64(value float64) error {
+	return setInteger(d, value, (*int32)(d))
+}
+
+// DPT_13_1200 represents DPT 13.1200 / delta volume liquid (l).
+type DPT_13_1200 int32
+
+func (d DPT_13_1200) Pack() []byte {
+	return packV32(int32(d))
+}
+
+func (d *DPT_13_1200) Unpack(data []byte) error {
+	return unpackV32(data, (*int32)(d))
+}
+
+func (d DPT_13_1200) Unit() string {
+	return "l"
+}
+
+func (d DPT_13_1200) String() string {
+	return fmt.Sprintf("%d l", int32(d))
+}
+
+func (d DPT_13_1200) MarshalText() ([]byte, error) {
+	return formatIntText(int32(d), d.Unit()), nil
+}
+
+func (d *DPT_13_1200) UnmarshalText(text []byte) error {
+	return parseIntText(text, d.Unit(), (*int32)(d))
+}
+
+func (d DPT_13_1200) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_13_1200) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*int32)(d))
+}
+
+func (d DPT_13_1200) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_13_1200) SetFloat64(value float64) error {
+	return setInteger(d, value, (*int32)(d))
+}
+
+// DPT_13_1201 represents DPT 13.1201 / delta volume (m^3).
+type DPT_13_1201 int32
+
+func (d DPT_13_1201) Pack() []byte {
+	return packV32(int32(d))
+}
+
+func (d *DPT_13_1201) Unpack(data []byte) error {
+	return unpackV32(data, (*int32)(d))
+}
+
+func (d DPT_13_1201) Unit() string {
+	return "m^3"
+}
+
+func (d DPT_13_1201) String() string {
+	return fmt.Sprintf("%d m^3", int32(d))
+}
+
+func (d DPT_13_1201) MarshalText() ([]byte, error) {
+	return formatIntText(int32(d), d.Unit()), nil
+}
+
+func (d *DPT_13_1201) UnmarshalText(text []byte) error {
+	return parseIntText(text, d.Unit(), (*int32)(d))
+}
+
+func (d DPT_13_1201) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_13_1201) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*int32)(d))
+}
+
+func (d DPT_13_1201) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_13_1201) SetFloat64(value float64) error {
+	return setInteger(d, value, (*int32)(d))
+}
@@ -499,3 +499,21 @@ func TestDPT_13100(t *testing.T) {
 		}
 	}
 }
+
+// Test the delta volume types 13.1200 and 13.1201
+func TestDPT_131xx(t *testing.T) {
+	var dst DPT_13_1201
+
+	for i := 1; i <= 10; i++ {
+		value := rand.Int31() - rand.Int31()
+
+		src := DPT_13_1200(value)
+		if err := dst.Unpack(src.Pack()); err != nil || int32(dst) != value {
+			t.Errorf("Wrong value \"%s\" after pack/unpack! Original value was \"%v\".", dst, value)
+		}
+	}
+
+	if s := DPT_13_1201(-3).String(); s != "-3 m^3" {
+		t.Errorf("String of DPT 13.1201 is \"%s\", expected \"-3 m^3\"", s)
+	}
+}
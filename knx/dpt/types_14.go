@@ -34,6 +34,30 @@ func (d DPT_14000) String() string {
 	return fmt.Sprintf("%.2f m/s²", float32(d))
 }
 
+func (d DPT_14000) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14000) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14000) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14000) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14000) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14000) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14001 represents DPT 14.001 / Acceleration Angular
 type DPT_14001 float32
 
@@ -61,6 +85,30 @@ func (d DPT_14001) String() string {
 	return fmt.Sprintf("%.2f rad/s²", float32(d))
 }
 
+func (d DPT_14001) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14001) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14001) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14001) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14001) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14001) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14002 represents DPT 14.002 / ActivationEnergy
 type DPT_14002 float32
 
@@ -88,6 +136,30 @@ func (d DPT_14002) String() string {
 	return fmt.Sprintf("%.2f J/mol", float32(d))
 }
 
+func (d DPT_14002) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14002) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14002) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14002) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14002) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14002) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14003 represents DPT 14.003 / Activity
 type DPT_14003 float32
 
@@ -115,6 +187,30 @@ func (d DPT_14003) String() string {
 	return fmt.Sprintf("%.2f s⁻¹", float32(d))
 }
 
+func (d DPT_14003) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14003) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14003) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14003) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14003) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14003) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14004 represents DPT 14.004 / Mol
 type DPT_14004 float32
 
@@ -142,6 +238,30 @@ func (d DPT_14004) String() string {
 	return fmt.Sprintf("%.2f mol", float32(d))
 }
 
+func (d DPT_14004) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14004) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14004) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14004) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14004) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14004) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14005 represents DPT 14.005 / Amplitude
 type DPT_14005 float32
 
@@ -169,6 +289,30 @@ func (d DPT_14005) String() string {
 	return fmt.Sprintf("%.2f", float32(d))
 }
 
+func (d DPT_14005) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14005) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14005) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14005) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14005) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14005) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14006 represents DPT 14.006 / AngleRad
 type DPT_14006 float32
 
@@ -196,6 +340,30 @@ func (d DPT_14006) String() string {
 	return fmt.Sprintf("%.2f rad", float32(d))
 }
 
+func (d DPT_14006) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14006) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14006) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14006) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14006) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14006) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14007 represents DPT 14.007 / AngleDeg
 type DPT_14007 float32
 
@@ -223,6 +391,30 @@ func (d DPT_14007) String() string {
 	return fmt.Sprintf("%.2f °", float32(d))
 }
 
+func (d DPT_14007) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14007) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14007) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14007) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14007) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14007) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14008 represents DPT 14.008 / Angular Momentum
 type DPT_14008 float32
 
@@ -250,6 +442,30 @@ func (d DPT_14008) String() string {
 	return fmt.Sprintf("%.2f J s", float32(d))
 }
 
+func (d DPT_14008) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14008) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14008) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14008) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14008) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14008) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14009 represents DPT 14.009 / Angular Velocity
 type DPT_14009 float32
 
@@ -277,6 +493,30 @@ func (d DPT_14009) String() string {
 	return fmt.Sprintf("%.2f rad/s", float32(d))
 }
 
+func (d DPT_14009) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14009) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14009) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14009) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14009) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14009) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14010 represents DPT 14.010 / Area
 type DPT_14010 float32
 
@@ -304,6 +544,30 @@ func (d DPT_14010) String() string {
 	return fmt.Sprintf("%.2f m²", float32(d))
 }
 
+func (d DPT_14010) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14010) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14010) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14010) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14010) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14010) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14011 represents DPT 14.011 / Capacitance
 type DPT_14011 float32
 
@@ -331,6 +595,30 @@ func (d DPT_14011) String() string {
 	return fmt.Sprintf("%.2f F", float32(d))
 }
 
+func (d DPT_14011) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14011) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14011) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14011) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14011) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14011) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14012 represents DPT 14.012 / Charge DensitySurface
 type DPT_14012 float32
 
@@ -358,6 +646,30 @@ func (d DPT_14012) String() string {
 	return fmt.Sprintf("%.2f C/m²", float32(d))
 }
 
+func (d DPT_14012) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14012) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14012) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14012) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14012) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14012) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14013 represents DPT 14.013 / Charge DensityVolume
 type DPT_14013 float32
 
@@ -385,6 +697,30 @@ func (d DPT_14013) String() string {
 	return fmt.Sprintf("%.2f C/m³", float32(d))
 }
 
+func (d DPT_14013) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14013) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14013) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14013) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14013) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14013) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14014 represents DPT 14.014 / Compressibility
 type DPT_14014 float32
 
@@ -412,6 +748,30 @@ func (d DPT_14014) String() string {
 	return fmt.Sprintf("%.2f m²/N", float32(d))
 }
 
+func (d DPT_14014) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14014) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14014) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14014) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14014) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14014) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14015 represents DPT 14.015 / Conductance
 type DPT_14015 float32
 
@@ -439,6 +799,30 @@ func (d DPT_14015) String() string {
 	return fmt.Sprintf("%.2f S", float32(d))
 }
 
+func (d DPT_14015) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14015) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14015) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14015) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14015) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14015) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14016 represents DPT 14.016 / Electrical Conductivity
 type DPT_14016 float32
 
@@ -466,6 +850,30 @@ func (d DPT_14016) String() string {
 	return fmt.Sprintf("%.2f S/m", float32(d))
 }
 
+func (d DPT_14016) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14016) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14016) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14016) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14016) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14016) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14017 represents DPT 14.017 / Density
 type DPT_14017 float32
 
@@ -493,6 +901,30 @@ func (d DPT_14017) String() string {
 	return fmt.Sprintf("%.2f kg/m³", float32(d))
 }
 
+func (d DPT_14017) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14017) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14017) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14017) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14017) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14017) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14018 represents DPT 14.018 / Electric Charge
 type DPT_14018 float32
 
@@ -520,6 +952,30 @@ func (d DPT_14018) String() string {
 	return fmt.Sprintf("%.2f C", float32(d))
 }
 
+func (d DPT_14018) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14018) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14018) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14018) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14018) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14018) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14019 represents DPT 14.019 / Electric Current
 type DPT_14019 float32
 
@@ -547,6 +1003,30 @@ func (d DPT_14019) String() string {
 	return fmt.Sprintf("%.2f A", float32(d))
 }
 
+func (d DPT_14019) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14019) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14019) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14019) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14019) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14019) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14020 represents DPT 14.020 / Electric CurrentDensity
 type DPT_14020 float32
 
@@ -574,6 +1054,30 @@ func (d DPT_14020) String() string {
 	return fmt.Sprintf("%.2f A/m²", float32(d))
 }
 
+func (d DPT_14020) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14020) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14020) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14020) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14020) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14020) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14021 represents DPT 14.021 / Electric DipoleMoment
 type DPT_14021 float32
 
@@ -601,10 +1105,34 @@ func (d DPT_14021) String() string {
 	return fmt.Sprintf("%.2f C.m", float32(d))
 }
 
-// DPT_14022 represents DPT 14.022 / Electric Displacement
-type DPT_14022 float32
+func (d DPT_14021) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
 
-func (d DPT_14022) Pack() []byte {
+func (d *DPT_14021) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14021) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14021) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14021) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14021) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
+// DPT_14022 represents DPT 14.022 / Electric Displacement
+type DPT_14022 float32
+
+func (d DPT_14022) Pack() []byte {
 	return packF32(float32(d))
 }
 
@@ -628,6 +1156,30 @@ func (d DPT_14022) String() string {
 	return fmt.Sprintf("%.2f C/m²", float32(d))
 }
 
+func (d DPT_14022) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14022) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14022) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14022) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14022) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14022) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14023 represents DPT 14.023 / Electric FieldStrength
 type DPT_14023 float32
 
@@ -655,6 +1207,30 @@ func (d DPT_14023) String() string {
 	return fmt.Sprintf("%.2f V/m", float32(d))
 }
 
+func (d DPT_14023) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14023) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14023) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14023) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14023) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14023) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14024 represents DPT 14.024 / Electric Flux
 type DPT_14024 float32
 
@@ -682,6 +1258,30 @@ func (d DPT_14024) String() string {
 	return fmt.Sprintf("%.2f c", float32(d))
 }
 
+func (d DPT_14024) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14024) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14024) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14024) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14024) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14024) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14025 represents DPT 14.025 / Electric FluxDensity
 type DPT_14025 float32
 
@@ -709,6 +1309,30 @@ func (d DPT_14025) String() string {
 	return fmt.Sprintf("%.2f C/m²", float32(d))
 }
 
+func (d DPT_14025) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14025) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14025) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14025) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14025) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14025) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14026 represents DPT 14.026 / Electric Polarization
 type DPT_14026 float32
 
@@ -736,6 +1360,30 @@ func (d DPT_14026) String() string {
 	return fmt.Sprintf("%.2f C/m²", float32(d))
 }
 
+func (d DPT_14026) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14026) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14026) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14026) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14026) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14026) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14027 represents DPT 14.027 / Electric Potential
 type DPT_14027 float32
 
@@ -763,6 +1411,30 @@ func (d DPT_14027) String() string {
 	return fmt.Sprintf("%.2f V", float32(d))
 }
 
+func (d DPT_14027) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14027) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14027) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14027) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14027) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14027) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14028 represents DPT 14.028 / Electric PotentialDifference
 type DPT_14028 float32
 
@@ -790,6 +1462,30 @@ func (d DPT_14028) String() string {
 	return fmt.Sprintf("%.2f V", float32(d))
 }
 
+func (d DPT_14028) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14028) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14028) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14028) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14028) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14028) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14029 represents DPT 14.029 / ElectromagneticMoment
 type DPT_14029 float32
 
@@ -817,6 +1513,30 @@ func (d DPT_14029) String() string {
 	return fmt.Sprintf("%.2f A.m²", float32(d))
 }
 
+func (d DPT_14029) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14029) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14029) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14029) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14029) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14029) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14030 represents DPT 14.030 / Electromotive_Force
 type DPT_14030 float32
 
@@ -844,6 +1564,30 @@ func (d DPT_14030) String() string {
 	return fmt.Sprintf("%.2f V", float32(d))
 }
 
+func (d DPT_14030) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14030) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14030) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14030) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14030) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14030) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14031 represents DPT 14.031 / Energy
 type DPT_14031 float32
 
@@ -871,6 +1615,30 @@ func (d DPT_14031) String() string {
 	return fmt.Sprintf("%.2f J", float32(d))
 }
 
+func (d DPT_14031) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14031) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14031) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14031) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14031) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14031) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14032 represents DPT 14.032 / Force
 type DPT_14032 float32
 
@@ -898,6 +1666,30 @@ func (d DPT_14032) String() string {
 	return fmt.Sprintf("%.2f N", float32(d))
 }
 
+func (d DPT_14032) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14032) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14032) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14032) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14032) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14032) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14033 represents DPT 14.033 / Frequency
 type DPT_14033 float32
 
@@ -925,6 +1717,30 @@ func (d DPT_14033) String() string {
 	return fmt.Sprintf("%.2f Hz", float32(d))
 }
 
+func (d DPT_14033) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14033) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14033) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14033) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14033) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14033) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14034 represents DPT 14.034 / Angular Frequency
 type DPT_14034 float32
 
@@ -952,6 +1768,30 @@ func (d DPT_14034) String() string {
 	return fmt.Sprintf("%.2f rad/s", float32(d))
 }
 
+func (d DPT_14034) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14034) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14034) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14034) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14034) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14034) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14035 represents DPT 14.035 / Heat Capacity
 type DPT_14035 float32
 
@@ -979,6 +1819,30 @@ func (d DPT_14035) String() string {
 	return fmt.Sprintf("%.2f J/K", float32(d))
 }
 
+func (d DPT_14035) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14035) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14035) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14035) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14035) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14035) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14036 represents DPT 14.036 / Heat Flow Rate
 type DPT_14036 float32
 
@@ -1006,6 +1870,30 @@ func (d DPT_14036) String() string {
 	return fmt.Sprintf("%.2f W", float32(d))
 }
 
+func (d DPT_14036) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14036) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14036) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14036) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14036) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14036) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14037 represents DPT 14.037 / Heat Quantity
 type DPT_14037 float32
 
@@ -1033,6 +1921,30 @@ func (d DPT_14037) String() string {
 	return fmt.Sprintf("%.2f J", float32(d))
 }
 
+func (d DPT_14037) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14037) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14037) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14037) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14037) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14037) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14038 represents DPT 14.038 / Impedance
 type DPT_14038 float32
 
@@ -1060,6 +1972,30 @@ func (d DPT_14038) String() string {
 	return fmt.Sprintf("%.2f Ω", float32(d))
 }
 
+func (d DPT_14038) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14038) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14038) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14038) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14038) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14038) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14039 represents DPT 14.039 / Length
 type DPT_14039 float32
 
@@ -1087,6 +2023,30 @@ func (d DPT_14039) String() string {
 	return fmt.Sprintf("%.2f m", float32(d))
 }
 
+func (d DPT_14039) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14039) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14039) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14039) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14039) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14039) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14040 represents DPT 14.040 / Light_Quantity
 type DPT_14040 float32
 
@@ -1114,6 +2074,30 @@ func (d DPT_14040) String() string {
 	return fmt.Sprintf("%.2f lm.s", float32(d))
 }
 
+func (d DPT_14040) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14040) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14040) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14040) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14040) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14040) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14041 represents DPT 14.041 / Luminance
 type DPT_14041 float32
 
@@ -1141,6 +2125,30 @@ func (d DPT_14041) String() string {
 	return fmt.Sprintf("%.2f cd/m²", float32(d))
 }
 
+func (d DPT_14041) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14041) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14041) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14041) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14041) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14041) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14042 represents DPT 14.042 / Luminous Flux
 type DPT_14042 float32
 
@@ -1168,6 +2176,30 @@ func (d DPT_14042) String() string {
 	return fmt.Sprintf("%.2f lm", float32(d))
 }
 
+func (d DPT_14042) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14042) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14042) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14042) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14042) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14042) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14043 represents DPT 14.043 / Luminous Intensity
 type DPT_14043 float32
 
@@ -1195,6 +2227,30 @@ func (d DPT_14043) String() string {
 	return fmt.Sprintf("%.2f cd", float32(d))
 }
 
+func (d DPT_14043) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14043) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14043) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14043) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14043) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14043) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14044 represents DPT 14.044 / Magnetic FieldStrength
 type DPT_14044 float32
 
@@ -1222,6 +2278,30 @@ func (d DPT_14044) String() string {
 	return fmt.Sprintf("%.2f A/m", float32(d))
 }
 
+func (d DPT_14044) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14044) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14044) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14044) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14044) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14044) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14045 represents DPT 14.045 / Magnetic Flux
 type DPT_14045 float32
 
@@ -1249,6 +2329,30 @@ func (d DPT_14045) String() string {
 	return fmt.Sprintf("%.2f Wb", float32(d))
 }
 
+func (d DPT_14045) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14045) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14045) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14045) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14045) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14045) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14046 represents DPT 14.046 / Magnetic FluxDensity
 type DPT_14046 float32
 
@@ -1276,6 +2380,30 @@ func (d DPT_14046) String() string {
 	return fmt.Sprintf("%.2f T", float32(d))
 }
 
+func (d DPT_14046) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14046) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14046) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14046) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14046) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14046) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14047 represents DPT 14.047 / Magnetic Moment
 type DPT_14047 float32
 
@@ -1303,6 +2431,30 @@ func (d DPT_14047) String() string {
 	return fmt.Sprintf("%.2f A.m²", float32(d))
 }
 
+func (d DPT_14047) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14047) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14047) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14047) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14047) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14047) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14048 represents DPT 14.048 / Magnetic Polarization
 type DPT_14048 float32
 
@@ -1330,6 +2482,30 @@ func (d DPT_14048) String() string {
 	return fmt.Sprintf("%.2f T", float32(d))
 }
 
+func (d DPT_14048) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14048) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14048) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14048) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14048) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14048) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14049 represents DPT 14.049 / Magnetization
 type DPT_14049 float32
 
@@ -1357,6 +2533,30 @@ func (d DPT_14049) String() string {
 	return fmt.Sprintf("%.2f A/m", float32(d))
 }
 
+func (d DPT_14049) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14049) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14049) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14049) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14049) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14049) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14050 represents DPT 14.050 / MagnetomotiveForce
 type DPT_14050 float32
 
@@ -1384,6 +2584,30 @@ func (d DPT_14050) String() string {
 	return fmt.Sprintf("%.2f A", float32(d))
 }
 
+func (d DPT_14050) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14050) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14050) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14050) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14050) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14050) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14051 represents DPT 14.051 / Mass
 type DPT_14051 float32
 
@@ -1411,6 +2635,30 @@ func (d DPT_14051) String() string {
 	return fmt.Sprintf("%.2f kg", float32(d))
 }
 
+func (d DPT_14051) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14051) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14051) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14051) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14051) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14051) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14052 represents DPT 14.052 / MassFlux
 type DPT_14052 float32
 
@@ -1438,6 +2686,30 @@ func (d DPT_14052) String() string {
 	return fmt.Sprintf("%.2f kg/s", float32(d))
 }
 
+func (d DPT_14052) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14052) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14052) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14052) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14052) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14052) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14053 represents DPT 14.053 / Momentum
 type DPT_14053 float32
 
@@ -1465,6 +2737,30 @@ func (d DPT_14053) String() string {
 	return fmt.Sprintf("%.2f N/s", float32(d))
 }
 
+func (d DPT_14053) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14053) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14053) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14053) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14053) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14053) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14054 represents DPT 14.054 / Phase Angle, Radiant
 type DPT_14054 float32
 
@@ -1492,6 +2788,30 @@ func (d DPT_14054) String() string {
 	return fmt.Sprintf("%.2f rad", float32(d))
 }
 
+func (d DPT_14054) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14054) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14054) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14054) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14054) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14054) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14055 represents DPT 14.055 / Phase Angle, Degree
 type DPT_14055 float32
 
@@ -1519,6 +2839,30 @@ func (d DPT_14055) String() string {
 	return fmt.Sprintf("%.2f °", float32(d))
 }
 
+func (d DPT_14055) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14055) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14055) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14055) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14055) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14055) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14056 represents DPT 14.056 / Power
 type DPT_14056 float32
 
@@ -1546,6 +2890,30 @@ func (d DPT_14056) String() string {
 	return fmt.Sprintf("%.2f W", float32(d))
 }
 
+func (d DPT_14056) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14056) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14056) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14056) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14056) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14056) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14057 represents DPT 14.057 / Power Factor
 type DPT_14057 float32
 
@@ -1573,6 +2941,30 @@ func (d DPT_14057) String() string {
 	return fmt.Sprintf("%.2f cosΦ", float32(d))
 }
 
+func (d DPT_14057) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14057) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14057) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14057) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14057) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14057) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14058 represents DPT 14.058 / Pressure
 type DPT_14058 float32
 
@@ -1592,12 +2984,36 @@ func (d *DPT_14058) Unpack(data []byte) error {
 	return nil
 }
 
-func (d DPT_14058) Unit() string {
-	return "Pa"
+func (d DPT_14058) Unit() string {
+	return "Pa"
+}
+
+func (d DPT_14058) String() string {
+	return fmt.Sprintf("%.2f Pa", float32(d))
+}
+
+func (d DPT_14058) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14058) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14058) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14058) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14058) Float64() float64 {
+	return float64(d)
 }
 
-func (d DPT_14058) String() string {
-	return fmt.Sprintf("%.2f Pa", float32(d))
+func (d *DPT_14058) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
 }
 
 // DPT_14059 represents DPT 14.059 / Reactance
@@ -1627,6 +3043,30 @@ func (d DPT_14059) String() string {
 	return fmt.Sprintf("%.2f Ω", float32(d))
 }
 
+func (d DPT_14059) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14059) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14059) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14059) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14059) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14059) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14060 represents DPT 14.060 / Resistance
 type DPT_14060 float32
 
@@ -1654,6 +3094,30 @@ func (d DPT_14060) String() string {
 	return fmt.Sprintf("%.2f Ω", float32(d))
 }
 
+func (d DPT_14060) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14060) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14060) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14060) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14060) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14060) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14061 represents DPT 14.061 / Resistivity
 type DPT_14061 float32
 
@@ -1681,6 +3145,30 @@ func (d DPT_14061) String() string {
 	return fmt.Sprintf("%.2f Ω.m", float32(d))
 }
 
+func (d DPT_14061) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14061) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14061) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14061) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14061) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14061) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14062 represents DPT 14.062 / SelfInductance
 type DPT_14062 float32
 
@@ -1708,6 +3196,30 @@ func (d DPT_14062) String() string {
 	return fmt.Sprintf("%.2f H", float32(d))
 }
 
+func (d DPT_14062) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14062) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14062) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14062) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14062) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14062) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14063 represents DPT 14.063 / SolidAngle
 type DPT_14063 float32
 
@@ -1735,6 +3247,30 @@ func (d DPT_14063) String() string {
 	return fmt.Sprintf("%.2f sr", float32(d))
 }
 
+func (d DPT_14063) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14063) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14063) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14063) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14063) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14063) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14064 represents DPT 14.064 / Sound Intensity
 type DPT_14064 float32
 
@@ -1762,6 +3298,30 @@ func (d DPT_14064) String() string {
 	return fmt.Sprintf("%.2f W/m²", float32(d))
 }
 
+func (d DPT_14064) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14064) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14064) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14064) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14064) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14064) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14065 represents DPT 14.065 / Speed
 type DPT_14065 float32
 
@@ -1789,6 +3349,30 @@ func (d DPT_14065) String() string {
 	return fmt.Sprintf("%.2f m/s", float32(d))
 }
 
+func (d DPT_14065) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14065) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14065) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14065) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14065) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14065) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14066 represents DPT 14.066 / Stress
 type DPT_14066 float32
 
@@ -1816,6 +3400,30 @@ func (d DPT_14066) String() string {
 	return fmt.Sprintf("%.2f Pa", float32(d))
 }
 
+func (d DPT_14066) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14066) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14066) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14066) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14066) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14066) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14067 represents DPT 14.067 / Surface Tension
 type DPT_14067 float32
 
@@ -1843,6 +3451,30 @@ func (d DPT_14067) String() string {
 	return fmt.Sprintf("%.2f N/m", float32(d))
 }
 
+func (d DPT_14067) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14067) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14067) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14067) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14067) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14067) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14068 represents DPT 14.068 / Common Temperature
 type DPT_14068 float32
 
@@ -1870,6 +3502,30 @@ func (d DPT_14068) String() string {
 	return fmt.Sprintf("%.2f °C", float32(d))
 }
 
+func (d DPT_14068) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14068) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14068) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14068) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14068) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14068) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14069 represents DPT 14.069 / Absolute Temperature
 type DPT_14069 float32
 
@@ -1897,6 +3553,30 @@ func (d DPT_14069) String() string {
 	return fmt.Sprintf("%.2f K", float32(d))
 }
 
+func (d DPT_14069) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14069) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14069) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14069) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14069) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14069) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14070 represents DPT 14.070 / Temperature Difference
 type DPT_14070 float32
 
@@ -1924,6 +3604,30 @@ func (d DPT_14070) String() string {
 	return fmt.Sprintf("%.2f K", float32(d))
 }
 
+func (d DPT_14070) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14070) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14070) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14070) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14070) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14070) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14071 represents DPT 14.071 / Thermal Capacity
 type DPT_14071 float32
 
@@ -1951,6 +3655,30 @@ func (d DPT_14071) String() string {
 	return fmt.Sprintf("%.2f J/K", float32(d))
 }
 
+func (d DPT_14071) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14071) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14071) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14071) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14071) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14071) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14072 represents DPT 14.072 / Thermal Conductivity
 type DPT_14072 float32
 
@@ -1978,6 +3706,30 @@ func (d DPT_14072) String() string {
 	return fmt.Sprintf("%.2f W/mK", float32(d))
 }
 
+func (d DPT_14072) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14072) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14072) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14072) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14072) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14072) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14073 represents DPT 14.073 / Thermoelectric Power
 type DPT_14073 float32
 
@@ -2005,6 +3757,30 @@ func (d DPT_14073) String() string {
 	return fmt.Sprintf("%.2f V/K", float32(d))
 }
 
+func (d DPT_14073) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14073) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14073) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14073) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14073) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14073) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14074 represents DPT 14.074 / Time
 type DPT_14074 float32
 
@@ -2032,6 +3808,30 @@ func (d DPT_14074) String() string {
 	return fmt.Sprintf("%.2f s", float32(d))
 }
 
+func (d DPT_14074) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14074) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14074) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14074) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14074) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14074) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14075 represents DPT 14.075 / Torque
 type DPT_14075 float32
 
@@ -2059,6 +3859,30 @@ func (d DPT_14075) String() string {
 	return fmt.Sprintf("%.2f N.m", float32(d))
 }
 
+func (d DPT_14075) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14075) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14075) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14075) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14075) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14075) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14076 represents DPT 14.076 / Volume
 type DPT_14076 float32
 
@@ -2086,6 +3910,30 @@ func (d DPT_14076) String() string {
 	return fmt.Sprintf("%.2f m³", float32(d))
 }
 
+func (d DPT_14076) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14076) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14076) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14076) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14076) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14076) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14077 represents DPT 14.077 / Volume Flux
 type DPT_14077 float32
 
@@ -2113,6 +3961,30 @@ func (d DPT_14077) String() string {
 	return fmt.Sprintf("%.2f m³/s", float32(d))
 }
 
+func (d DPT_14077) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14077) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14077) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14077) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14077) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14077) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14078 represents DPT 14.078 / Weight
 type DPT_14078 float32
 
@@ -2140,6 +4012,30 @@ func (d DPT_14078) String() string {
 	return fmt.Sprintf("%.2f N", float32(d))
 }
 
+func (d DPT_14078) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14078) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14078) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14078) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14078) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14078) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_14079 represents DPT 14.079 / Work
 type DPT_14079 float32
 
@@ -2166,3 +4062,27 @@ func (d DPT_14079) Unit() string {
 func (d DPT_14079) String() string {
 	return fmt.Sprintf("%.2f J", float32(d))
 }
+
+func (d DPT_14079) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_14079) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_14079) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_14079) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_14079) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_14079) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
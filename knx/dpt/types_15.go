@@ -0,0 +1,142 @@
+package dpt
+
+import (
+	"fmt"
+)
+
+// DPT_15000 represents DPT 15.000 / DPT_Access_Data.
+// The access identification code consists of six decimal digits, i.e. 0 - 999999.
+// U4 U4 U4 U4 U4 U4 B4 N4
+type DPT_15000 struct {
+	AccessCode uint32
+
+	// Error indicates that the access could not be detected.
+	Error bool
+
+	// Permission indicates that the access has been accepted.
+	Permission bool
+
+	// ReadRightToLeft indicates that the code has been read from right to left.
+	ReadRightToLeft bool
+
+	// Encrypted indicates that the access code is encrypted.
+	Encrypted bool
+
+	// Index of the access identification code, 0 - 15.
+	Index uint8
+}
+
+func (d DPT_15000) Pack() []byte {
+	buf := make([]byte, 5)
+
+	code := d.AccessCode % 1000000
+	for i := 3; i > 0; i-- {
+		low := code % 10
+		code /= 10
+		high := code % 10
+		code /= 10
+
+		buf[i] = uint8(high<<4 | low)
+	}
+
+	buf[4] = packB4([4]bool{d.Encrypted, d.ReadRightToLeft, d.Permission, d.Error})<<4 | d.Index&0x0F
+
+	return buf
+}
+
+func (d *DPT_15000) Unpack(data []byte) error {
+	if len(data) != 5 {
+		return ErrInvalidLength
+	}
+
+	var code uint32
+	for _, b := range data[1:4] {
+		high, low := b>>4, b&0x0F
+		if high > 9 || low > 9 {
+			return fmt.Errorf("payload is out of range")
+		}
+
+		code = code*100 + uint32(high)*10 + uint32(low)
+	}
+
+	*d = DPT_15000{
+		AccessCode: code,
+		Index:      data[4] & 0x0F,
+	}
+
+	return unpackB4(data[4]>>4, &d.Encrypted, &d.ReadRightToLeft, &d.Permission, &d.Error)
+}
+
+func (d DPT_15000) Unit() string {
+	return ""
+}
+
+// IsValid checks whether the access code consists of at most six digits and the index fits into
+// four bits.
+func (d DPT_15000) IsValid() bool {
+	return d.AccessCode <= 999999 && d.Index <= 15
+}
+
+func (d DPT_15000) String() string {
+	return fmt.Sprintf("AccessCode: %06d Index: %d Error: %t, Permission: %t, ReadRightToLeft: %t, Encrypted: %t",
+		d.AccessCode, d.Index, d.Error, d.Permission, d.ReadRightToLeft, d.Encrypted)
+}
+
+func (d DPT_15000) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses either the access code alone, e.g. "123456", or the form which String
+// produces. Fields which are left out are zero.
+func (d *DPT_15000) UnmarshalText(text []byte) error {
+	var value DPT_15000
+
+	if err := parseUintText(text, "", &value.AccessCode); err != nil {
+		fields, err := parseFieldList(string(text))
+		if err != nil {
+			return err
+		}
+
+		for name, field := range fields {
+			var err error
+
+			switch name {
+			case "AccessCode":
+				err = parseUintText([]byte(field), "", &value.AccessCode)
+			case "Index":
+				err = parseUintText([]byte(field), "", &value.Index)
+			case "Error":
+				err = parseFieldBool(field, &value.Error)
+			case "Permission":
+				err = parseFieldBool(field, &value.Permission)
+			case "ReadRightToLeft":
+				err = parseFieldBool(field, &value.ReadRightToLeft)
+			case "Encrypted":
+				err = parseFieldBool(field, &value.Encrypted)
+			default:
+				return unknownFieldError(name)
+			}
+
+			if err != nil {
+				return fieldError(name, err)
+			}
+		}
+	}
+
+	if !value.IsValid() {
+		return fmt.Errorf("access data %q is out of range", text)
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d DPT_15000) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_15000) UnmarshalJSON(data []byte) error {
+	type plain DPT_15000
+	return unmarshalJSON(data, d, (*plain)(d))
+}
@@ -0,0 +1,58 @@
+package dpt
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// Test DPT 15.000 (access data)
+func TestDPT_15000(t *testing.T) {
+	var dst DPT_15000
+
+	src := DPT_15000{AccessCode: 123456, Error: true, ReadRightToLeft: true, Index: 9}
+	buf := src.Pack()
+	if !reflect.DeepEqual(buf, []byte{0, 0x12, 0x34, 0x56, 0xa9}) {
+		t.Errorf("Error packing \"%s\" => % x", src, buf)
+	}
+
+	for i := 0; i < 20; i++ {
+		src = DPT_15000{
+			AccessCode:      uint32(rand.Intn(1000000)),
+			Error:           rand.Intn(2) == 1,
+			Permission:      rand.Intn(2) == 1,
+			ReadRightToLeft: rand.Intn(2) == 1,
+			Encrypted:       rand.Intn(2) == 1,
+			Index:           uint8(rand.Intn(16)),
+		}
+
+		if !src.IsValid() {
+			t.Errorf("Value \"%s\" should be valid", src)
+		}
+
+		if err := dst.Unpack(src.Pack()); err != nil {
+			t.Errorf("Error unpacking \"%s\": %v", src, err)
+		}
+
+		if dst != src {
+			t.Errorf("Value \"%s\" after pack/unpack differs. Original value was \"%s\"!", dst, src)
+		}
+	}
+
+	if src = (DPT_15000{AccessCode: 42, Permission: true}); src.String() != "AccessCode: 000042 Index: 0 Error: false, Permission: true, ReadRightToLeft: false, Encrypted: false" {
+		t.Errorf("Unexpected string \"%s\"", src)
+	}
+
+	if (DPT_15000{AccessCode: 1000000}).IsValid() {
+		t.Errorf("Seven digits should be invalid")
+	}
+
+	// Digits must be BCD
+	if err := dst.Unpack([]byte{0, 0x1a, 0x34, 0x56, 0x00}); err == nil {
+		t.Errorf("Expected error for an invalid digit")
+	}
+
+	if err := dst.Unpack([]byte{0, 0x12, 0x34, 0x56}); err == nil {
+		t.Errorf("Expected error for invalid length")
+	}
+}
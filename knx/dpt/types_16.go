@@ -4,7 +4,9 @@
 package dpt
 
 import (
+	"fmt"
 	"unicode"
+	"unicode/utf8"
 )
 
 // DPT_16000 represents DPT 16.000 / String ASCII.
@@ -63,6 +65,30 @@ func (d DPT_16000) String() string {
 	return string(d)
 }
 
+func (d DPT_16000) MarshalText() ([]byte, error) {
+	return []byte(d), nil
+}
+
+// UnmarshalText takes the text as it is. It fails unless the text is an ASCII string of at most 14 characters.
+func (d *DPT_16000) UnmarshalText(text []byte) error {
+	value := DPT_16000(text)
+	if !value.IsValid() {
+		return fmt.Errorf("text %q is not an ASCII string of at most 14 characters", text)
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d DPT_16000) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_16000) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*string)(d))
+}
+
 // DPT_16001 represents DPT 16.001 / String 8859-1.
 // The string must be ISO-8859-1 and contain at most 14 chars.
 // A string longer than 14 chars will be silently truncated.
@@ -72,16 +98,13 @@ type DPT_16001 string
 func (d DPT_16001) Pack() []byte {
 	buf := make([]byte, 15)
 
-	r := []rune(d)
-
-	for i := 0; i < len(r) && i < 14; i++ {
-		if r[i] > unicode.MaxLatin1 {
-			buf[i+1] = 0x20
-		} else {
-			buf[i+1] = byte(r[i])
-		}
+	encoded := encodeLatin1(string(d))
+	if len(encoded) > 14 {
+		encoded = encoded[:14]
 	}
 
+	copy(buf[1:], encoded)
+
 	return buf
 }
 
@@ -90,13 +113,7 @@ func (d *DPT_16001) Unpack(data []byte) error {
 		return ErrInvalidLength
 	}
 
-	var buf = []rune{}
-
-	for i := 1; i < len(data) && data[i] != 0x00; i++ {
-		buf = append(buf, rune(data[i]))
-	}
-
-	*d = DPT_16001(buf)
+	*d = DPT_16001(decodeLatin1(data[1:]))
 
 	return nil
 }
@@ -112,9 +129,33 @@ func (d DPT_16001) IsValid() bool {
 		}
 	}
 
-	return len(d) <= 14
+	return utf8.RuneCountInString(string(d)) <= 14
 }
 
 func (d DPT_16001) String() string {
 	return string(d)
 }
+
+func (d DPT_16001) MarshalText() ([]byte, error) {
+	return []byte(d), nil
+}
+
+// UnmarshalText takes the text as it is. It fails unless the text is an ISO-8859-1 string of at most 14 characters.
+func (d *DPT_16001) UnmarshalText(text []byte) error {
+	value := DPT_16001(text)
+	if !value.IsValid() {
+		return fmt.Errorf("text %q is not an ISO-8859-1 string of at most 14 characters", text)
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d DPT_16001) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_16001) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*string)(d))
+}
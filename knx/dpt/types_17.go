@@ -36,3 +36,27 @@ func (d DPT_17001) Unit() string {
 func (d DPT_17001) String() string {
 	return fmt.Sprintf("%d", uint8(d))
 }
+
+func (d DPT_17001) MarshalText() ([]byte, error) {
+	return formatUintText(uint8(d), d.Unit()), nil
+}
+
+func (d *DPT_17001) UnmarshalText(text []byte) error {
+	return parseUintText(text, d.Unit(), (*uint8)(d))
+}
+
+func (d DPT_17001) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_17001) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_17001) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_17001) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
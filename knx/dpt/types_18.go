@@ -33,8 +33,37 @@ func (d DPT_18001) Unit() string {
 	return ""
 }
 
+// IsValid checks that the value is a scene number 0 - 63, which may have the learn bit (128) set.
+func (d DPT_18001) IsValid() bool {
+	return d <= 63 || (d >= 128 && d <= 191)
+}
+
 // KNX Association recommends to display the scene numbers [1..64].
 // See note 6 of the KNX Specifications v2.1.
 func (d DPT_18001) String() string {
 	return fmt.Sprintf("%d", uint8(d))
 }
+
+func (d DPT_18001) MarshalText() ([]byte, error) {
+	return formatUintText(uint8(d), d.Unit()), nil
+}
+
+func (d *DPT_18001) UnmarshalText(text []byte) error {
+	return parseUintText(text, d.Unit(), (*uint8)(d))
+}
+
+func (d DPT_18001) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_18001) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_18001) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_18001) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
@@ -0,0 +1,335 @@
+package dpt
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// knxWeekday converts a weekday to a KNX day, i.e. 1 for Monday up to 7 for Sunday.
+func knxWeekday(weekday time.Weekday) uint8 {
+	if weekday == time.Sunday {
+		return 7
+	}
+
+	return uint8(weekday)
+}
+
+// knxWeekdayNames are the names of the KNX days 1 (Monday) to 7 (Sunday).
+var knxWeekdayNames = []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+
+// parseKNXWeekday parses the name of a weekday, which may be abbreviated to three letters.
+func parseKNXWeekday(text string) (uint8, bool) {
+	for i, name := range knxWeekdayNames {
+		if strings.EqualFold(text, name) || strings.EqualFold(text, name[:3]) {
+			return uint8(i + 1), true
+		}
+	}
+
+	return 0, false
+}
+
+// parseClock parses a time of day in the form HH:MM or HH:MM:SS.
+func parseClock(text string) (hour, minutes, seconds uint8, err error) {
+	parts := strings.Split(text, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, 0, 0, fmt.Errorf("time %q is not in the form HH:MM[:SS]", text)
+	}
+
+	var values [3]uint8
+	for i, part := range parts {
+		if err := parseUintText([]byte(part), "", &values[i]); err != nil {
+			return 0, 0, 0, fmt.Errorf("time %q is not in the form HH:MM[:SS]", text)
+		}
+	}
+
+	return values[0], values[1], values[2], nil
+}
+
+// DPT_19001 represents DPT 19.001 / DateTime p. 41.
+// Weekday is NOT a golang Weekday, but a KNX Day [0,...,7]. It may be 0, indicating "any day".
+// Valid years are limited to 1900 - 2155.
+type DPT_19001 struct {
+	Year    uint16
+	Month   uint8
+	Day     uint8
+	Weekday uint8
+	Hour    uint8
+	Minutes uint8
+	Seconds uint8
+
+	// Fault indicates that the clock is faulty, the other fields must not be used then.
+	Fault bool
+
+	// WorkingDay tells whether the day is a working day. It is only valid if NoWorkingDay is not
+	// set.
+	WorkingDay   bool
+	NoWorkingDay bool
+
+	// NoYear, NoDate, NoWeekday and NoTime mark the respective fields as invalid.
+	NoYear    bool
+	NoDate    bool
+	NoWeekday bool
+	NoTime    bool
+
+	// SummerTime indicates that daylight saving time is in effect.
+	SummerTime bool
+
+	// ExternalSync indicates a clock which is synchronised by an external signal, e.g. DCF77.
+	ExternalSync bool
+
+	// ReliableSource indicates that the synchronisation source is reliable.
+	ReliableSource bool
+}
+
+func (d DPT_19001) Pack() []byte {
+	buf := make([]byte, 9)
+
+	if d.Year >= 1900 && d.Year <= 2155 {
+		buf[1] = uint8(d.Year - 1900)
+	} else {
+		d.NoYear = true
+	}
+
+	buf[2] = d.Month & 0x0F
+	buf[3] = d.Day & 0x1F
+	buf[4] = d.Weekday<<5 | d.Hour&0x1F
+	buf[5] = d.Minutes & 0x3F
+	buf[6] = d.Seconds & 0x3F
+
+	flags := []bool{
+		d.SummerTime, d.NoTime, d.NoWeekday, d.NoDate, d.NoYear, d.NoWorkingDay, d.WorkingDay, d.Fault,
+	}
+	for i, flag := range flags {
+		if flag {
+			buf[7] |= 1 << i
+		}
+	}
+
+	if d.ExternalSync {
+		buf[8] |= 0x80
+	}
+
+	if d.ReliableSource {
+		buf[8] |= 0x40
+	}
+
+	return buf
+}
+
+func (d *DPT_19001) Unpack(data []byte) error {
+	if len(data) != 9 {
+		return ErrInvalidLength
+	}
+
+	*d = DPT_19001{
+		Year:           1900 + uint16(data[1]),
+		Month:          data[2] & 0x0F,
+		Day:            data[3] & 0x1F,
+		Weekday:        data[4] >> 5,
+		Hour:           data[4] & 0x1F,
+		Minutes:        data[5] & 0x3F,
+		Seconds:        data[6] & 0x3F,
+		SummerTime:     data[7]&0x01 != 0,
+		NoTime:         data[7]&0x02 != 0,
+		NoWeekday:      data[7]&0x04 != 0,
+		NoDate:         data[7]&0x08 != 0,
+		NoYear:         data[7]&0x10 != 0,
+		NoWorkingDay:   data[7]&0x20 != 0,
+		WorkingDay:     data[7]&0x40 != 0,
+		Fault:          data[7]&0x80 != 0,
+		ExternalSync:   data[8]&0x80 != 0,
+		ReliableSource: data[8]&0x40 != 0,
+	}
+
+	if !d.IsValid() {
+		return fmt.Errorf("payload is out of range")
+	}
+
+	return nil
+}
+
+func (d DPT_19001) Unit() string {
+	return ""
+}
+
+// IsValid checks the ranges of the fields which are not marked as invalid.
+func (d DPT_19001) IsValid() bool {
+	if !d.NoDate && (d.Month < 1 || d.Month > 12 || d.Day < 1 || d.Day > 31) {
+		return false
+	}
+
+	if !d.NoTime {
+		// 24:00:00 denotes the end of the day.
+		if d.Hour == 24 {
+			return d.Minutes == 0 && d.Seconds == 0
+		}
+
+		return d.Hour <= 23 && d.Minutes <= 59 && d.Seconds <= 59
+	}
+
+	return true
+}
+
+// SetTime sets the date and time fields from the given time. The time zone of t is kept, hence
+// convert it to the local time of the installation beforehand. Whether t is a working day is not
+// known, therefore NoWorkingDay is set.
+func (d *DPT_19001) SetTime(t time.Time) {
+	*d = DPT_19001{
+		Year:         uint16(t.Year()),
+		Month:        uint8(t.Month()),
+		Day:          uint8(t.Day()),
+		Weekday:      knxWeekday(t.Weekday()),
+		Hour:         uint8(t.Hour()),
+		Minutes:      uint8(t.Minute()),
+		Seconds:      uint8(t.Second()),
+		NoWorkingDay: true,
+		NoYear:       t.Year() < 1900 || t.Year() > 2155,
+		SummerTime:   t.IsDST(),
+	}
+}
+
+// Time converts the date and time into a time.Time in the given location. It fails if the clock
+// is faulty or if the year, date or time is invalid.
+func (d DPT_19001) Time(loc *time.Location) (time.Time, error) {
+	switch {
+	case d.Fault:
+		return time.Time{}, errors.New("clock is faulty")
+
+	case d.NoYear || d.NoDate || d.NoTime:
+		return time.Time{}, errors.New("date and time are incomplete")
+
+	case !d.IsValid():
+		return time.Time{}, errors.New("date and time are out of range")
+	}
+
+	return time.Date(
+		int(d.Year), time.Month(d.Month), int(d.Day),
+		int(d.Hour), int(d.Minutes), int(d.Seconds), 0, loc,
+	), nil
+}
+
+func (d DPT_19001) String() string {
+	if d.Fault {
+		return "Fault"
+	}
+
+	var parts []string
+
+	if !d.NoDate {
+		if d.NoYear {
+			parts = append(parts, fmt.Sprintf("%02d-%02d", d.Month, d.Day))
+		} else {
+			parts = append(parts, fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day))
+		}
+	}
+
+	if !d.NoWeekday && 0 < d.Weekday && d.Weekday <= 7 {
+		parts = append(parts, knxWeekdayNames[d.Weekday-1])
+	}
+
+	if !d.NoTime {
+		parts = append(parts, fmt.Sprintf("%02d:%02d:%02d", d.Hour, d.Minutes, d.Seconds))
+	}
+
+	if d.SummerTime {
+		parts = append(parts, "(summer time)")
+	}
+
+	if len(parts) == 0 {
+		return "No date and time"
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func (d DPT_19001) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses the form which String produces, e.g. "2024-05-17 Friday 14:30:00". Date,
+// weekday and time may each be left out, they are marked as invalid then. The date may also be given
+// without the year, e.g. "05-17". A trailing "(summer time)" sets SummerTime, "Fault" marks the
+// clock as faulty. Whether the day is a working day is not known, hence NoWorkingDay is set.
+func (d *DPT_19001) UnmarshalText(text []byte) error {
+	trimmed := strings.TrimSpace(string(text))
+
+	value := DPT_19001{NoYear: true, NoDate: true, NoWeekday: true, NoTime: true, NoWorkingDay: true}
+
+	switch {
+	case strings.EqualFold(trimmed, "fault"):
+		*d = DPT_19001{Fault: true}
+		return nil
+
+	case strings.EqualFold(trimmed, "no date and time"):
+		*d = value
+		return nil
+	}
+
+	if rest, found := strings.CutSuffix(trimmed, "(summer time)"); found {
+		value.SummerTime = true
+		trimmed = rest
+	}
+
+	for _, field := range strings.Fields(trimmed) {
+		switch {
+		case strings.Contains(field, ":"):
+			hour, minutes, seconds, err := parseClock(field)
+			if err != nil {
+				return err
+			}
+
+			value.Hour, value.Minutes, value.Seconds = hour, minutes, seconds
+			value.NoTime = false
+
+		case strings.Contains(field, "-"):
+			// The parts are checked by IsValid, like a received date.
+			parts := strings.Split(field, "-")
+			if len(parts) < 2 || len(parts) > 3 {
+				return fmt.Errorf("date %q is neither in the form YYYY-MM-DD nor MM-DD", field)
+			}
+
+			if len(parts) == 3 {
+				if err := parseUintText([]byte(parts[0]), "", &value.Year); err != nil || value.Year < 1900 || value.Year > 2155 {
+					return fmt.Errorf("year of date %q is not between 1900 and 2155", field)
+				}
+
+				value.NoYear = false
+				parts = parts[1:]
+			}
+
+			if parseUintText([]byte(parts[0]), "", &value.Month) != nil || parseUintText([]byte(parts[1]), "", &value.Day) != nil {
+				return fmt.Errorf("date %q is neither in the form YYYY-MM-DD nor MM-DD", field)
+			}
+
+			value.NoDate = false
+
+		default:
+			weekday, ok := parseKNXWeekday(field)
+			if !ok {
+				return fmt.Errorf("weekday %q is unknown", field)
+			}
+
+			value.Weekday = weekday
+			value.NoWeekday = false
+		}
+	}
+
+	if !value.IsValid() {
+		return fmt.Errorf("date and time %q are out of range", text)
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d DPT_19001) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_19001) UnmarshalJSON(data []byte) error {
+	type plain DPT_19001
+	return unmarshalJSON(data, d, (*plain)(d))
+}
@@ -0,0 +1,137 @@
+package dpt
+
+import (
+	"testing"
+	"time"
+)
+
+// Test DPT 19.001 (Date Time)
+func TestDPT_19001(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		berlin = time.FixedZone("CEST", 2*60*60)
+	}
+
+	src := time.Date(2024, time.July, 14, 13, 37, 42, 0, berlin)
+
+	var dpv DPT_19001
+	dpv.SetTime(src)
+
+	buf := dpv.Pack()
+	expected := []byte{0, 124, 7, 14, 7<<5 | 13, 37, 42, 0x20, 0x00}
+	if berlin.String() == "Europe/Berlin" {
+		expected[7] |= 0x01
+	}
+
+	if string(buf) != string(expected) {
+		t.Fatalf("Wrong data % x, want % x", buf, expected)
+	}
+
+	var dst DPT_19001
+	if err := dst.Unpack(buf); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if dst != dpv {
+		t.Errorf("Value %+v after pack/unpack differs from %+v", dst, dpv)
+	}
+
+	tm, err := dst.Time(berlin)
+	if err != nil || !tm.Equal(src) {
+		t.Errorf("Wrong time %v (%v), want %v", tm, err, src)
+	}
+
+	if dst.String() != "2024-07-14 Sunday 13:37:42 (summer time)" && berlin.String() == "Europe/Berlin" {
+		t.Errorf("Wrong string %q", dst.String())
+	}
+}
+
+// Test the flags of DPT 19.001
+func TestDPT_19001_Flags(t *testing.T) {
+	var dst DPT_19001
+
+	// Fault, no year, no weekday, external sync with reliable source
+	if err := dst.Unpack([]byte{0, 0, 1, 1, 0, 0, 0, 0x94, 0xc0}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !dst.Fault || !dst.NoYear || !dst.NoWeekday || !dst.ExternalSync || !dst.ReliableSource {
+		t.Errorf("Wrong flags %+v", dst)
+	}
+
+	if dst.NoDate || dst.NoTime || dst.SummerTime || dst.WorkingDay || dst.NoWorkingDay {
+		t.Errorf("Wrong flags %+v", dst)
+	}
+
+	if _, err := dst.Time(time.UTC); err == nil {
+		t.Error("Faulty clock should not yield a time")
+	}
+
+	if buf := dst.Pack(); buf[7] != 0x94 || buf[8] != 0xc0 {
+		t.Errorf("Wrong flags % x after packing", buf)
+	}
+
+	// Invalid date and time are not checked.
+	if err := dst.Unpack([]byte{0, 0, 0, 0, 31, 63, 63, 0x0a, 0}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if dst.String() != "No date and time" {
+		t.Errorf("Wrong string %q", dst.String())
+	}
+
+	// End of the day
+	if err := dst.Unpack([]byte{0, 124, 1, 1, 24, 0, 0, 0, 0}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if tm, err := dst.Time(time.UTC); err != nil || !tm.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Wrong time %v (%v)", tm, err)
+	}
+}
+
+// Test DPT 19.001 with invalid data
+func TestDPT_19001_Invalid(t *testing.T) {
+	var dst DPT_19001
+
+	tests := [][]byte{
+		{0, 124, 1, 1, 0, 0, 0, 0},
+		{0, 124, 13, 1, 0, 0, 0, 0, 0},
+		{0, 124, 1, 0, 0, 0, 0, 0, 0},
+		{0, 124, 1, 1, 24, 1, 0, 0, 0},
+		{0, 124, 1, 1, 12, 60, 0, 0, 0},
+	}
+
+	for _, data := range tests {
+		if err := dst.Unpack(data); err == nil {
+			t.Errorf("Expected error for % x, got %v", data, dst)
+		}
+	}
+}
+
+// Test the time helpers of DPT 10.001 and DPT 11.001
+func TestDPT_10001_11001_Time(t *testing.T) {
+	src := time.Date(2023, time.December, 31, 23, 59, 58, 0, time.UTC)
+
+	var tod DPT_10001
+	tod.SetTime(src)
+
+	if tod != (DPT_10001{Weekday: 7, Hour: 23, Minutes: 59, Seconds: 58}) {
+		t.Errorf("Wrong time of day %+v", tod)
+	}
+
+	if tm := tod.Time(time.Date(2024, time.January, 5, 8, 0, 0, 0, time.UTC)); !tm.Equal(time.Date(2024, 1, 5, 23, 59, 58, 0, time.UTC)) {
+		t.Errorf("Wrong time %v", tm)
+	}
+
+	var date DPT_11001
+	date.SetTime(src)
+
+	if date != (DPT_11001{Year: 2023, Month: 12, Day: 31}) {
+		t.Errorf("Wrong date %+v", date)
+	}
+
+	if tm := date.Time(time.UTC); !tm.Equal(time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Wrong time %v", tm)
+	}
+}
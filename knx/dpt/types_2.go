@@ -0,0 +1,540 @@
+package dpt
+
+import (
+	"fmt"
+	"strings"
+)
+
+// unpackControl unpacks a B2 value which consists of a control bit and a value bit.
+func unpackControl(data []byte, control, value *bool) error {
+	if len(data) != 1 {
+		return ErrInvalidLength
+	}
+
+	return unpackB2(data[0]&0x3, value, control)
+}
+
+// controlString formats a B2 value. The value is only meaningful if the control bit is set.
+func controlString(control bool, value fmt.Stringer) string {
+	if !control {
+		return "No control"
+	}
+
+	return "Control " + value.String()
+}
+
+// parseBinary parses a B1 value which is given by one of its names or as 0/1 or false/true.
+func parseBinary(text string, off, on fmt.Stringer) (bool, error) {
+	text = strings.TrimSpace(text)
+
+	switch {
+	case strings.EqualFold(text, on.String()), text == "1", strings.EqualFold(text, "true"):
+		return true, nil
+
+	case strings.EqualFold(text, off.String()), text == "0", strings.EqualFold(text, "false"):
+		return false, nil
+	}
+
+	return false, fmt.Errorf("value %q is neither %q nor %q", text, off, on)
+}
+
+// parseControl parses a B2 value in the form "no control", "control <value>" or "<value>".
+func parseControl(text string, control, value *bool, off, on fmt.Stringer) error {
+	fields := strings.Fields(strings.ToLower(text))
+
+	switch {
+	case len(fields) >= 2 && fields[0] == "no" && fields[1] == "control":
+		*control = false
+		*value = false
+
+		if len(fields) == 2 {
+			return nil
+		}
+
+		fields = fields[2:]
+
+	case len(fields) >= 1 && fields[0] == "control":
+		*control = true
+		fields = fields[1:]
+
+	default:
+		*control = true
+	}
+
+	v, err := parseBinary(strings.Join(fields, " "), off, on)
+	if err != nil {
+		return err
+	}
+
+	*value = v
+
+	return nil
+}
+
+// DPT_2001 represents DPT 2.001 (G) / DPT_Switch_Control.
+type DPT_2001 struct {
+	Control bool
+	Value   DPT_1001
+}
+
+func (d DPT_2001) Pack() []byte {
+	return []byte{packB2([2]bool{bool(d.Value), d.Control})}
+}
+
+func (d *DPT_2001) Unpack(data []byte) error {
+	return unpackControl(data, &d.Control, (*bool)(&d.Value))
+}
+
+func (d DPT_2001) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_2001) UnmarshalText(text []byte) error {
+	return parseControl(string(text), &d.Control, (*bool)(&d.Value), DPT_1001(false), DPT_1001(true))
+}
+
+func (d DPT_2001) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_2001) UnmarshalJSON(data []byte) error {
+	type plain DPT_2001
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+func (d DPT_2001) Unit() string {
+	return ""
+}
+
+func (d DPT_2001) String() string {
+	return controlString(d.Control, d.Value)
+}
+
+// DPT_2002 represents DPT 2.002 (G) / DPT_Bool_Control.
+type DPT_2002 struct {
+	Control bool
+	Value   DPT_1002
+}
+
+func (d DPT_2002) Pack() []byte {
+	return []byte{packB2([2]bool{bool(d.Value), d.Control})}
+}
+
+func (d *DPT_2002) Unpack(data []byte) error {
+	return unpackControl(data, &d.Control, (*bool)(&d.Value))
+}
+
+func (d DPT_2002) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_2002) UnmarshalText(text []byte) error {
+	return parseControl(string(text), &d.Control, (*bool)(&d.Value), DPT_1002(false), DPT_1002(true))
+}
+
+func (d DPT_2002) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_2002) UnmarshalJSON(data []byte) error {
+	type plain DPT_2002
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+func (d DPT_2002) Unit() string {
+	return ""
+}
+
+func (d DPT_2002) String() string {
+	return controlString(d.Control, d.Value)
+}
+
+// DPT_2003 represents DPT 2.003 (G) / DPT_Enable_Control.
+type DPT_2003 struct {
+	Control bool
+	Value   DPT_1003
+}
+
+func (d DPT_2003) Pack() []byte {
+	return []byte{packB2([2]bool{bool(d.Value), d.Control})}
+}
+
+func (d *DPT_2003) Unpack(data []byte) error {
+	return unpackControl(data, &d.Control, (*bool)(&d.Value))
+}
+
+func (d DPT_2003) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_2003) UnmarshalText(text []byte) error {
+	return parseControl(string(text), &d.Control, (*bool)(&d.Value), DPT_1003(false), DPT_1003(true))
+}
+
+func (d DPT_2003) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_2003) UnmarshalJSON(data []byte) error {
+	type plain DPT_2003
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+func (d DPT_2003) Unit() string {
+	return ""
+}
+
+func (d DPT_2003) String() string {
+	return controlString(d.Control, d.Value)
+}
+
+// DPT_2004 represents DPT 2.004 (FB) / DPT_Ramp_Control.
+type DPT_2004 struct {
+	Control bool
+	Value   DPT_1004
+}
+
+func (d DPT_2004) Pack() []byte {
+	return []byte{packB2([2]bool{bool(d.Value), d.Control})}
+}
+
+func (d *DPT_2004) Unpack(data []byte) error {
+	return unpackControl(data, &d.Control, (*bool)(&d.Value))
+}
+
+func (d DPT_2004) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_2004) UnmarshalText(text []byte) error {
+	return parseControl(string(text), &d.Control, (*bool)(&d.Value), DPT_1004(false), DPT_1004(true))
+}
+
+func (d DPT_2004) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_2004) UnmarshalJSON(data []byte) error {
+	type plain DPT_2004
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+func (d DPT_2004) Unit() string {
+	return ""
+}
+
+func (d DPT_2004) String() string {
+	return controlString(d.Control, d.Value)
+}
+
+// DPT_2005 represents DPT 2.005 (FB) / DPT_Alarm_Control.
+type DPT_2005 struct {
+	Control bool
+	Value   DPT_1005
+}
+
+func (d DPT_2005) Pack() []byte {
+	return []byte{packB2([2]bool{bool(d.Value), d.Control})}
+}
+
+func (d *DPT_2005) Unpack(data []byte) error {
+	return unpackControl(data, &d.Control, (*bool)(&d.Value))
+}
+
+func (d DPT_2005) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_2005) UnmarshalText(text []byte) error {
+	return parseControl(string(text), &d.Control, (*bool)(&d.Value), DPT_1005(false), DPT_1005(true))
+}
+
+func (d DPT_2005) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_2005) UnmarshalJSON(data []byte) error {
+	type plain DPT_2005
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+func (d DPT_2005) Unit() string {
+	return ""
+}
+
+func (d DPT_2005) String() string {
+	return controlString(d.Control, d.Value)
+}
+
+// DPT_2006 represents DPT 2.006 (FB) / DPT_BinaryValue_Control.
+type DPT_2006 struct {
+	Control bool
+	Value   DPT_1006
+}
+
+func (d DPT_2006) Pack() []byte {
+	return []byte{packB2([2]bool{bool(d.Value), d.Control})}
+}
+
+func (d *DPT_2006) Unpack(data []byte) error {
+	return unpackControl(data, &d.Control, (*bool)(&d.Value))
+}
+
+func (d DPT_2006) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_2006) UnmarshalText(text []byte) error {
+	return parseControl(string(text), &d.Control, (*bool)(&d.Value), DPT_1006(false), DPT_1006(true))
+}
+
+func (d DPT_2006) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_2006) UnmarshalJSON(data []byte) error {
+	type plain DPT_2006
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+func (d DPT_2006) Unit() string {
+	return ""
+}
+
+func (d DPT_2006) String() string {
+	return controlString(d.Control, d.Value)
+}
+
+// DPT_2007 represents DPT 2.007 (FB) / DPT_Step_Control.
+type DPT_2007 struct {
+	Control bool
+	Value   DPT_1007
+}
+
+func (d DPT_2007) Pack() []byte {
+	return []byte{packB2([2]bool{bool(d.Value), d.Control})}
+}
+
+func (d *DPT_2007) Unpack(data []byte) error {
+	return unpackControl(data, &d.Control, (*bool)(&d.Value))
+}
+
+func (d DPT_2007) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_2007) UnmarshalText(text []byte) error {
+	return parseControl(string(text), &d.Control, (*bool)(&d.Value), DPT_1007(false), DPT_1007(true))
+}
+
+func (d DPT_2007) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_2007) UnmarshalJSON(data []byte) error {
+	type plain DPT_2007
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+func (d DPT_2007) Unit() string {
+	return ""
+}
+
+func (d DPT_2007) String() string {
+	return controlString(d.Control, d.Value)
+}
+
+// DPT_2008 represents DPT 2.008 (FB) / DPT_Direction1_Control.
+type DPT_2008 struct {
+	Control bool
+	Value   DPT_1008
+}
+
+func (d DPT_2008) Pack() []byte {
+	return []byte{packB2([2]bool{bool(d.Value), d.Control})}
+}
+
+func (d *DPT_2008) Unpack(data []byte) error {
+	return unpackControl(data, &d.Control, (*bool)(&d.Value))
+}
+
+func (d DPT_2008) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_2008) UnmarshalText(text []byte) error {
+	return parseControl(string(text), &d.Control, (*bool)(&d.Value), DPT_1008(false), DPT_1008(true))
+}
+
+func (d DPT_2008) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_2008) UnmarshalJSON(data []byte) error {
+	type plain DPT_2008
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+func (d DPT_2008) Unit() string {
+	return ""
+}
+
+func (d DPT_2008) String() string {
+	return controlString(d.Control, d.Value)
+}
+
+// DPT_2009 represents DPT 2.009 (FB) / DPT_Direction2_Control.
+type DPT_2009 struct {
+	Control bool
+	Value   DPT_1009
+}
+
+func (d DPT_2009) Pack() []byte {
+	return []byte{packB2([2]bool{bool(d.Value), d.Control})}
+}
+
+func (d *DPT_2009) Unpack(data []byte) error {
+	return unpackControl(data, &d.Control, (*bool)(&d.Value))
+}
+
+func (d DPT_2009) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_2009) UnmarshalText(text []byte) error {
+	return parseControl(string(text), &d.Control, (*bool)(&d.Value), DPT_1009(false), DPT_1009(true))
+}
+
+func (d DPT_2009) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_2009) UnmarshalJSON(data []byte) error {
+	type plain DPT_2009
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+func (d DPT_2009) Unit() string {
+	return ""
+}
+
+func (d DPT_2009) String() string {
+	return controlString(d.Control, d.Value)
+}
+
+// DPT_2010 represents DPT 2.010 (FB) / DPT_Start_Control.
+type DPT_2010 struct {
+	Control bool
+	Value   DPT_1010
+}
+
+func (d DPT_2010) Pack() []byte {
+	return []byte{packB2([2]bool{bool(d.Value), d.Control})}
+}
+
+func (d *DPT_2010) Unpack(data []byte) error {
+	return unpackControl(data, &d.Control, (*bool)(&d.Value))
+}
+
+func (d DPT_2010) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_2010) UnmarshalText(text []byte) error {
+	return parseControl(string(text), &d.Control, (*bool)(&d.Value), DPT_1010(false), DPT_1010(true))
+}
+
+func (d DPT_2010) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_2010) UnmarshalJSON(data []byte) error {
+	type plain DPT_2010
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+func (d DPT_2010) Unit() string {
+	return ""
+}
+
+func (d DPT_2010) String() string {
+	return controlString(d.Control, d.Value)
+}
+
+// DPT_2011 represents DPT 2.011 (FB) / DPT_State_Control.
+type DPT_2011 struct {
+	Control bool
+	Value   DPT_1011
+}
+
+func (d DPT_2011) Pack() []byte {
+	return []byte{packB2([2]bool{bool(d.Value), d.Control})}
+}
+
+func (d *DPT_2011) Unpack(data []byte) error {
+	return unpackControl(data, &d.Control, (*bool)(&d.Value))
+}
+
+func (d DPT_2011) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_2011) UnmarshalText(text []byte) error {
+	return parseControl(string(text), &d.Control, (*bool)(&d.Value), DPT_1011(false), DPT_1011(true))
+}
+
+func (d DPT_2011) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_2011) UnmarshalJSON(data []byte) error {
+	type plain DPT_2011
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+func (d DPT_2011) Unit() string {
+	return ""
+}
+
+func (d DPT_2011) String() string {
+	return controlString(d.Control, d.Value)
+}
+
+// DPT_2012 represents DPT 2.012 (FB) / DPT_Invert_Control.
+type DPT_2012 struct {
+	Control bool
+	Value   DPT_1012
+}
+
+func (d DPT_2012) Pack() []byte {
+	return []byte{packB2([2]bool{bool(d.Value), d.Control})}
+}
+
+func (d *DPT_2012) Unpack(data []byte) error {
+	return unpackControl(data, &d.Control, (*bool)(&d.Value))
+}
+
+func (d DPT_2012) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_2012) UnmarshalText(text []byte) error {
+	return parseControl(string(text), &d.Control, (*bool)(&d.Value), DPT_1012(false), DPT_1012(true))
+}
+
+func (d DPT_2012) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_2012) UnmarshalJSON(data []byte) error {
+	type plain DPT_2012
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+func (d DPT_2012) Unit() string {
+	return ""
+}
+
+func (d DPT_2012) String() string {
+	return controlString(d.Control, d.Value)
+}
@@ -1,107 +1,3055 @@
 package dpt
 
-const (
-	HVACMode_Auto DPT_20102 = iota
-	HVACMode_Comfort
-	HVACMode_Standby
-	HVACMode_Economy
-	HVACMode_BuildingProtection
+import (
+	"fmt"
+	"strconv"
+	"strings"
 )
 
-// DPT_20102 represents DPT 20.102 / HVAC Mode.
-type DPT_20102 uint8
+// enumNames maps the values of an enumerated DPT to their names. Values without a name are
+// reserved.
+type enumNames map[uint8]string
 
-func (d DPT_20102) Pack() []byte {
+// format returns the name of the value or "reserved".
+func (names enumNames) format(value uint8) string {
+	if name, ok := names[value]; ok {
+		return name
+	}
+
+	return "reserved"
+}
+
+// text returns the name of the value, or its number if it is reserved.
+func (names enumNames) text(value uint8) []byte {
+	if name, ok := names[value]; ok {
+		return []byte(name)
+	}
+
+	return strconv.AppendUint(nil, uint64(value), 10)
+}
+
+// normalizeEnumName makes names comparable regardless of case, white space, dashes and
+// underscores.
+func normalizeEnumName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\t', '_', '-':
+			return -1
+		}
+		return r
+	}, strings.ToLower(name))
+}
+
+// parse looks up the value of the given name. The value may also be given as a number. Reserved
+// values are rejected.
+func (names enumNames) parse(text string, value *uint8) error {
+	text = strings.TrimSpace(text)
+	normalized := normalizeEnumName(text)
+
+	for v, name := range names {
+		if normalizeEnumName(name) == normalized {
+			*value = v
+			return nil
+		}
+	}
+
+	if v, err := strconv.ParseUint(text, 10, 8); err == nil {
+		if _, ok := names[uint8(v)]; ok {
+			*value = uint8(v)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown value %q", text)
+}
+
+// DPT_20001 represents DPT 20.001 / SCLOMode.
+type DPT_20001 uint8
+
+var dpt20001Names = enumNames{
+	0: "Autonomous",
+	1: "Slave",
+	2: "Master",
+}
+
+func (d DPT_20001) Pack() []byte {
 	return packU8(uint8(d))
 }
 
-func (d *DPT_20102) Unpack(data []byte) error {
+func (d *DPT_20001) Unpack(data []byte) error {
 	return unpackU8(data, (*uint8)(d))
 }
 
-func (d DPT_20102) Unit() string {
+func (d DPT_20001) Unit() string {
 	return ""
 }
 
-func (d DPT_20102) String() string {
-	switch d {
-	case HVACMode_Auto:
-		return "Auto"
-	case HVACMode_Comfort:
-		return "Comfort"
-	case HVACMode_Standby:
-		return "Standby"
-	case HVACMode_Economy:
-		return "Economy"
-	case HVACMode_BuildingProtection:
-		return "Building Protection"
-	default:
-		return "reserved"
-	}
+func (d DPT_20001) String() string {
+	return dpt20001Names.format(uint8(d))
 }
 
-// DPT_20105 represents DPT 20.105 / HVACContrMode.
-type DPT_20105 uint8
+func (d DPT_20001) names() enumNames {
+	return dpt20001Names
+}
 
-func (d DPT_20105) Pack() []byte {
+func (d DPT_20001) MarshalText() ([]byte, error) {
+	return dpt20001Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20001) UnmarshalText(text []byte) error {
+	return dpt20001Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20001) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20001) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20001) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20001) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20002 represents DPT 20.002 / BuildingMode.
+type DPT_20002 uint8
+
+var dpt20002Names = enumNames{
+	0: "Building in use",
+	1: "Building not used",
+	2: "Building protection",
+}
+
+func (d DPT_20002) Pack() []byte {
 	return packU8(uint8(d))
 }
 
-func (d *DPT_20105) Unpack(data []byte) error {
-	var value uint8
+func (d *DPT_20002) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
 
-	if err := unpackU8(data, &value); err != nil {
-		return err
-	}
+func (d DPT_20002) Unit() string {
+	return ""
+}
 
-	*d = DPT_20105(value)
-	return nil
+func (d DPT_20002) String() string {
+	return dpt20002Names.format(uint8(d))
 }
 
-func (d DPT_20105) Unit() string {
+func (d DPT_20002) names() enumNames {
+	return dpt20002Names
+}
+
+func (d DPT_20002) MarshalText() ([]byte, error) {
+	return dpt20002Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20002) UnmarshalText(text []byte) error {
+	return dpt20002Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20002) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20002) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20002) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20002) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20003 represents DPT 20.003 / OccMode.
+type DPT_20003 uint8
+
+var dpt20003Names = enumNames{
+	0: "Occupied",
+	1: "Standby",
+	2: "Not occupied",
+}
+
+func (d DPT_20003) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20003) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20003) Unit() string {
 	return ""
 }
 
-func (d DPT_20105) String() string {
-	switch d {
-	case 0:
-		return "Auto"
-	case 1:
-		return "Heat"
-	case 2:
-		return "Morning Warmup"
-	case 3:
-		return "Cool"
-	case 4:
-		return "Night Purge"
-	case 5:
-		return "Precool"
-	case 6:
-		return "Off"
-	case 7:
-		return "Test"
-	case 8:
-		return "Emergency Heat"
-	case 9:
-		return "Fan only"
-	case 10:
-		return "Free Cool"
-	case 11:
-		return "Ice"
-	case 12:
-		return "Maximum Heating Mode"
-	case 13:
-		return "Economic Heat/Cool Mode"
-	case 14:
-		return "Dehumidification"
-	case 15:
-		return "Calibration Mode"
-	case 16:
-		return "Emergency Cool Mode"
-	case 17:
-		return "Emergency Steam Mode"
-	case 20:
-		return "NoDem"
-	}
-	return "reserved"
+func (d DPT_20003) String() string {
+	return dpt20003Names.format(uint8(d))
+}
+
+func (d DPT_20003) names() enumNames {
+	return dpt20003Names
+}
+
+func (d DPT_20003) MarshalText() ([]byte, error) {
+	return dpt20003Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20003) UnmarshalText(text []byte) error {
+	return dpt20003Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20003) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20003) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20003) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20003) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20004 represents DPT 20.004 / Priority.
+type DPT_20004 uint8
+
+var dpt20004Names = enumNames{
+	0: "High",
+	1: "Medium",
+	2: "Low",
+	3: "Void",
+}
+
+func (d DPT_20004) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20004) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20004) Unit() string {
+	return ""
+}
+
+func (d DPT_20004) String() string {
+	return dpt20004Names.format(uint8(d))
+}
+
+func (d DPT_20004) names() enumNames {
+	return dpt20004Names
+}
+
+func (d DPT_20004) MarshalText() ([]byte, error) {
+	return dpt20004Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20004) UnmarshalText(text []byte) error {
+	return dpt20004Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20004) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20004) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20004) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20004) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20005 represents DPT 20.005 / LightApplicationMode.
+type DPT_20005 uint8
+
+var dpt20005Names = enumNames{
+	0: "Normal",
+	1: "Presence simulation",
+	2: "Night round",
+}
+
+func (d DPT_20005) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20005) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20005) Unit() string {
+	return ""
+}
+
+func (d DPT_20005) String() string {
+	return dpt20005Names.format(uint8(d))
+}
+
+func (d DPT_20005) names() enumNames {
+	return dpt20005Names
+}
+
+func (d DPT_20005) MarshalText() ([]byte, error) {
+	return dpt20005Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20005) UnmarshalText(text []byte) error {
+	return dpt20005Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20005) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20005) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20005) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20005) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20006 represents DPT 20.006 / ApplicationArea.
+type DPT_20006 uint8
+
+var dpt20006Names = enumNames{
+	0:  "No fault",
+	1:  "System and functions of common interest",
+	10: "HVAC general FBs",
+	11: "HVAC hot water heating",
+	12: "HVAC direct electrical heating",
+	13: "HVAC terminal units",
+	14: "HVAC VAC",
+	20: "Lighting",
+	30: "Security",
+	40: "Load management",
+	50: "Shutters and blinds",
+}
+
+func (d DPT_20006) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20006) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20006) Unit() string {
+	return ""
+}
+
+func (d DPT_20006) String() string {
+	return dpt20006Names.format(uint8(d))
+}
+
+func (d DPT_20006) names() enumNames {
+	return dpt20006Names
+}
+
+func (d DPT_20006) MarshalText() ([]byte, error) {
+	return dpt20006Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20006) UnmarshalText(text []byte) error {
+	return dpt20006Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20006) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20006) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20006) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20006) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20007 represents DPT 20.007 / AlarmClassType.
+type DPT_20007 uint8
+
+var dpt20007Names = enumNames{
+	1: "Simple alarm",
+	2: "Basic alarm",
+	3: "Extended alarm",
+}
+
+func (d DPT_20007) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20007) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20007) Unit() string {
+	return ""
+}
+
+func (d DPT_20007) String() string {
+	return dpt20007Names.format(uint8(d))
+}
+
+func (d DPT_20007) names() enumNames {
+	return dpt20007Names
+}
+
+func (d DPT_20007) MarshalText() ([]byte, error) {
+	return dpt20007Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20007) UnmarshalText(text []byte) error {
+	return dpt20007Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20007) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20007) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20007) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20007) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20008 represents DPT 20.008 / PSUMode.
+type DPT_20008 uint8
+
+var dpt20008Names = enumNames{
+	0: "Disabled",
+	1: "Enabled",
+	2: "Auto",
+}
+
+func (d DPT_20008) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20008) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20008) Unit() string {
+	return ""
+}
+
+func (d DPT_20008) String() string {
+	return dpt20008Names.format(uint8(d))
+}
+
+func (d DPT_20008) names() enumNames {
+	return dpt20008Names
+}
+
+func (d DPT_20008) MarshalText() ([]byte, error) {
+	return dpt20008Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20008) UnmarshalText(text []byte) error {
+	return dpt20008Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20008) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20008) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20008) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20008) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20011 represents DPT 20.011 / ErrorClass_System.
+type DPT_20011 uint8
+
+var dpt20011Names = enumNames{
+	0:  "No fault",
+	1:  "General device fault",
+	2:  "Communication fault",
+	3:  "Configuration fault",
+	4:  "Hardware fault",
+	5:  "Software fault",
+	6:  "Insufficient non volatile memory",
+	7:  "Insufficient volatile memory",
+	8:  "Memory allocation command with size 0 received",
+	9:  "CRC error",
+	10: "Watchdog reset detected",
+	11: "Invalid opcode detected",
+	12: "General protection fault",
+	13: "Maximal table length exceeded",
+	14: "Undefined load command received",
+	15: "Group address table is not sorted",
+	16: "Invalid connection number (TSAP)",
+	17: "Invalid group object number (ASAP)",
+	18: "Group object type exceeds PID_MAX_APDU_LENGTH - 2",
+}
+
+func (d DPT_20011) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20011) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20011) Unit() string {
+	return ""
+}
+
+func (d DPT_20011) String() string {
+	return dpt20011Names.format(uint8(d))
+}
+
+func (d DPT_20011) names() enumNames {
+	return dpt20011Names
+}
+
+func (d DPT_20011) MarshalText() ([]byte, error) {
+	return dpt20011Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20011) UnmarshalText(text []byte) error {
+	return dpt20011Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20011) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20011) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20011) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20011) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20012 represents DPT 20.012 / ErrorClass_HVAC.
+type DPT_20012 uint8
+
+var dpt20012Names = enumNames{
+	0: "No fault",
+	1: "Sensor fault",
+	2: "Process fault",
+	3: "Actuator fault",
+	4: "Other fault",
+}
+
+func (d DPT_20012) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20012) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20012) Unit() string {
+	return ""
+}
+
+func (d DPT_20012) String() string {
+	return dpt20012Names.format(uint8(d))
+}
+
+func (d DPT_20012) names() enumNames {
+	return dpt20012Names
+}
+
+func (d DPT_20012) MarshalText() ([]byte, error) {
+	return dpt20012Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20012) UnmarshalText(text []byte) error {
+	return dpt20012Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20012) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20012) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20012) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20012) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20013 represents DPT 20.013 / Time_Delay.
+type DPT_20013 uint8
+
+var dpt20013Names = enumNames{
+	0:  "Not active",
+	1:  "1 s",
+	2:  "2 s",
+	3:  "3 s",
+	4:  "5 s",
+	5:  "10 s",
+	6:  "15 s",
+	7:  "20 s",
+	8:  "30 s",
+	9:  "45 s",
+	10: "1 min",
+	11: "1.25 min",
+	12: "1.5 min",
+	13: "2 min",
+	14: "2.5 min",
+	15: "3 min",
+	16: "5 min",
+	17: "15 min",
+	18: "20 min",
+	19: "30 min",
+	20: "1 h",
+	21: "2 h",
+	22: "3 h",
+	23: "5 h",
+	24: "12 h",
+	25: "24 h",
+}
+
+func (d DPT_20013) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20013) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20013) Unit() string {
+	return ""
+}
+
+func (d DPT_20013) String() string {
+	return dpt20013Names.format(uint8(d))
+}
+
+func (d DPT_20013) names() enumNames {
+	return dpt20013Names
+}
+
+func (d DPT_20013) MarshalText() ([]byte, error) {
+	return dpt20013Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20013) UnmarshalText(text []byte) error {
+	return dpt20013Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20013) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20013) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20013) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20013) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20014 represents DPT 20.014 / Beaufort_Wind_Force_Scale.
+type DPT_20014 uint8
+
+var dpt20014Names = enumNames{
+	0:  "Calm",
+	1:  "Light air",
+	2:  "Light breeze",
+	3:  "Gentle breeze",
+	4:  "Moderate breeze",
+	5:  "Fresh breeze",
+	6:  "Strong breeze",
+	7:  "Near gale",
+	8:  "Fresh gale",
+	9:  "Strong gale",
+	10: "Whole gale",
+	11: "Violent storm",
+	12: "Hurricane",
+}
+
+func (d DPT_20014) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20014) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20014) Unit() string {
+	return ""
+}
+
+func (d DPT_20014) String() string {
+	return dpt20014Names.format(uint8(d))
+}
+
+func (d DPT_20014) names() enumNames {
+	return dpt20014Names
+}
+
+func (d DPT_20014) MarshalText() ([]byte, error) {
+	return dpt20014Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20014) UnmarshalText(text []byte) error {
+	return dpt20014Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20014) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20014) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20014) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20014) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20017 represents DPT 20.017 / SensorSelect.
+type DPT_20017 uint8
+
+var dpt20017Names = enumNames{
+	0: "Inactive",
+	1: "Digital input not inverted",
+	2: "Digital input inverted",
+	3: "Analog input",
+	4: "Temperature sensor input",
+}
+
+func (d DPT_20017) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20017) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20017) Unit() string {
+	return ""
+}
+
+func (d DPT_20017) String() string {
+	return dpt20017Names.format(uint8(d))
+}
+
+func (d DPT_20017) names() enumNames {
+	return dpt20017Names
+}
+
+func (d DPT_20017) MarshalText() ([]byte, error) {
+	return dpt20017Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20017) UnmarshalText(text []byte) error {
+	return dpt20017Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20017) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20017) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20017) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20017) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20020 represents DPT 20.020 / ActuatorConnectType.
+type DPT_20020 uint8
+
+var dpt20020Names = enumNames{
+	1: "Sensor connection",
+	2: "Controller connection",
+}
+
+func (d DPT_20020) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20020) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20020) Unit() string {
+	return ""
+}
+
+func (d DPT_20020) String() string {
+	return dpt20020Names.format(uint8(d))
+}
+
+func (d DPT_20020) names() enumNames {
+	return dpt20020Names
+}
+
+func (d DPT_20020) MarshalText() ([]byte, error) {
+	return dpt20020Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20020) UnmarshalText(text []byte) error {
+	return dpt20020Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20020) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20020) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20020) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20020) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20022 represents DPT 20.022 / PowerReturnMode.
+type DPT_20022 uint8
+
+var dpt20022Names = enumNames{
+	0: "Do not send",
+	1: "Send always",
+	2: "Send if value changed during powerdown",
+}
+
+func (d DPT_20022) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20022) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20022) Unit() string {
+	return ""
+}
+
+func (d DPT_20022) String() string {
+	return dpt20022Names.format(uint8(d))
+}
+
+func (d DPT_20022) names() enumNames {
+	return dpt20022Names
+}
+
+func (d DPT_20022) MarshalText() ([]byte, error) {
+	return dpt20022Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20022) UnmarshalText(text []byte) error {
+	return dpt20022Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20022) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20022) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20022) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20022) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20100 represents DPT 20.100 / FuelType.
+type DPT_20100 uint8
+
+var dpt20100Names = enumNames{
+	0: "Auto",
+	1: "Oil",
+	2: "Gas",
+	3: "Solid state fuel",
+}
+
+func (d DPT_20100) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20100) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20100) Unit() string {
+	return ""
+}
+
+func (d DPT_20100) String() string {
+	return dpt20100Names.format(uint8(d))
+}
+
+func (d DPT_20100) names() enumNames {
+	return dpt20100Names
+}
+
+func (d DPT_20100) MarshalText() ([]byte, error) {
+	return dpt20100Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20100) UnmarshalText(text []byte) error {
+	return dpt20100Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20100) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20100) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20100) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20100) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20101 represents DPT 20.101 / BurnerType.
+type DPT_20101 uint8
+
+var dpt20101Names = enumNames{
+	1: "1 stage",
+	2: "2 stage",
+	3: "Modulating",
+}
+
+func (d DPT_20101) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20101) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20101) Unit() string {
+	return ""
+}
+
+func (d DPT_20101) String() string {
+	return dpt20101Names.format(uint8(d))
+}
+
+func (d DPT_20101) names() enumNames {
+	return dpt20101Names
+}
+
+func (d DPT_20101) MarshalText() ([]byte, error) {
+	return dpt20101Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20101) UnmarshalText(text []byte) error {
+	return dpt20101Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20101) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20101) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20101) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20101) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+const (
+	HVACMode_Auto DPT_20102 = iota
+	HVACMode_Comfort
+	HVACMode_Standby
+	HVACMode_Economy
+	HVACMode_BuildingProtection
+)
+
+// DPT_20102 represents DPT 20.102 / HVAC Mode.
+type DPT_20102 uint8
+
+var dpt20102Names = enumNames{
+	0: "Auto",
+	1: "Comfort",
+	2: "Standby",
+	3: "Economy",
+	4: "Building Protection",
+}
+
+func (d DPT_20102) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20102) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20102) Unit() string {
+	return ""
+}
+
+func (d DPT_20102) String() string {
+	return dpt20102Names.format(uint8(d))
+}
+
+func (d DPT_20102) names() enumNames {
+	return dpt20102Names
+}
+
+func (d DPT_20102) MarshalText() ([]byte, error) {
+	return dpt20102Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20102) UnmarshalText(text []byte) error {
+	return dpt20102Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20102) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20102) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20102) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20102) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20103 represents DPT 20.103 / DHWMode.
+type DPT_20103 uint8
+
+var dpt20103Names = enumNames{
+	0: "Auto",
+	1: "Legio protect",
+	2: "Normal",
+	3: "Reduced",
+	4: "Off/Frost protect",
+}
+
+func (d DPT_20103) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20103) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20103) Unit() string {
+	return ""
+}
+
+func (d DPT_20103) String() string {
+	return dpt20103Names.format(uint8(d))
+}
+
+func (d DPT_20103) names() enumNames {
+	return dpt20103Names
+}
+
+func (d DPT_20103) MarshalText() ([]byte, error) {
+	return dpt20103Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20103) UnmarshalText(text []byte) error {
+	return dpt20103Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20103) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20103) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20103) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20103) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20104 represents DPT 20.104 / LoadPriority.
+type DPT_20104 uint8
+
+var dpt20104Names = enumNames{
+	0: "None",
+	1: "Shift load priority",
+	2: "Absolute load priority",
+}
+
+func (d DPT_20104) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20104) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20104) Unit() string {
+	return ""
+}
+
+func (d DPT_20104) String() string {
+	return dpt20104Names.format(uint8(d))
+}
+
+func (d DPT_20104) names() enumNames {
+	return dpt20104Names
+}
+
+func (d DPT_20104) MarshalText() ([]byte, error) {
+	return dpt20104Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20104) UnmarshalText(text []byte) error {
+	return dpt20104Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20104) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20104) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20104) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20104) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20105 represents DPT 20.105 / HVACContrMode.
+type DPT_20105 uint8
+
+var dpt20105Names = enumNames{
+	0:  "Auto",
+	1:  "Heat",
+	2:  "Morning Warmup",
+	3:  "Cool",
+	4:  "Night Purge",
+	5:  "Precool",
+	6:  "Off",
+	7:  "Test",
+	8:  "Emergency Heat",
+	9:  "Fan only",
+	10: "Free Cool",
+	11: "Ice",
+	12: "Maximum Heating Mode",
+	13: "Economic Heat/Cool Mode",
+	14: "Dehumidification",
+	15: "Calibration Mode",
+	16: "Emergency Cool Mode",
+	17: "Emergency Steam Mode",
+	20: "NoDem",
+}
+
+func (d DPT_20105) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20105) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20105) Unit() string {
+	return ""
+}
+
+func (d DPT_20105) String() string {
+	return dpt20105Names.format(uint8(d))
+}
+
+func (d DPT_20105) names() enumNames {
+	return dpt20105Names
+}
+
+func (d DPT_20105) MarshalText() ([]byte, error) {
+	return dpt20105Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20105) UnmarshalText(text []byte) error {
+	return dpt20105Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20105) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20105) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20105) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20105) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20106 represents DPT 20.106 / HVACEmergMode.
+type DPT_20106 uint8
+
+var dpt20106Names = enumNames{
+	0: "Normal",
+	1: "Emergency pressure",
+	2: "Emergency depressure",
+	3: "Emergency purge",
+	4: "Emergency shutdown",
+	5: "Emergency fire",
+}
+
+func (d DPT_20106) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20106) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20106) Unit() string {
+	return ""
+}
+
+func (d DPT_20106) String() string {
+	return dpt20106Names.format(uint8(d))
+}
+
+func (d DPT_20106) names() enumNames {
+	return dpt20106Names
+}
+
+func (d DPT_20106) MarshalText() ([]byte, error) {
+	return dpt20106Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20106) UnmarshalText(text []byte) error {
+	return dpt20106Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20106) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20106) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20106) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20106) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20107 represents DPT 20.107 / ChangeoverMode.
+type DPT_20107 uint8
+
+var dpt20107Names = enumNames{
+	0: "Auto",
+	1: "Cooling only",
+	2: "Heating only",
+}
+
+func (d DPT_20107) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20107) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20107) Unit() string {
+	return ""
+}
+
+func (d DPT_20107) String() string {
+	return dpt20107Names.format(uint8(d))
+}
+
+func (d DPT_20107) names() enumNames {
+	return dpt20107Names
+}
+
+func (d DPT_20107) MarshalText() ([]byte, error) {
+	return dpt20107Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20107) UnmarshalText(text []byte) error {
+	return dpt20107Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20107) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20107) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20107) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20107) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20108 represents DPT 20.108 / ValveMode.
+type DPT_20108 uint8
+
+var dpt20108Names = enumNames{
+	1: "Heat stage A",
+	2: "Heat stage B",
+	3: "Cool stage A",
+	4: "Cool stage B",
+	5: "Heat/Cool",
+}
+
+func (d DPT_20108) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20108) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20108) Unit() string {
+	return ""
+}
+
+func (d DPT_20108) String() string {
+	return dpt20108Names.format(uint8(d))
+}
+
+func (d DPT_20108) names() enumNames {
+	return dpt20108Names
+}
+
+func (d DPT_20108) MarshalText() ([]byte, error) {
+	return dpt20108Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20108) UnmarshalText(text []byte) error {
+	return dpt20108Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20108) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20108) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20108) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20108) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20110 represents DPT 20.110 / HeaterMode.
+type DPT_20110 uint8
+
+var dpt20110Names = enumNames{
+	1: "Heat stage A on/off",
+	2: "Heat stage A proportional",
+	3: "Heat stage B proportional",
+}
+
+func (d DPT_20110) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20110) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20110) Unit() string {
+	return ""
+}
+
+func (d DPT_20110) String() string {
+	return dpt20110Names.format(uint8(d))
+}
+
+func (d DPT_20110) names() enumNames {
+	return dpt20110Names
+}
+
+func (d DPT_20110) MarshalText() ([]byte, error) {
+	return dpt20110Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20110) UnmarshalText(text []byte) error {
+	return dpt20110Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20110) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20110) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20110) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20110) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20111 represents DPT 20.111 / FanMode.
+type DPT_20111 uint8
+
+var dpt20111Names = enumNames{
+	0: "Not running",
+	1: "Permanently running",
+	2: "Running in intervals",
+}
+
+func (d DPT_20111) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20111) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20111) Unit() string {
+	return ""
+}
+
+func (d DPT_20111) String() string {
+	return dpt20111Names.format(uint8(d))
+}
+
+func (d DPT_20111) names() enumNames {
+	return dpt20111Names
+}
+
+func (d DPT_20111) MarshalText() ([]byte, error) {
+	return dpt20111Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20111) UnmarshalText(text []byte) error {
+	return dpt20111Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20111) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20111) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20111) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20111) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20112 represents DPT 20.112 / MasterSlaveMode.
+type DPT_20112 uint8
+
+var dpt20112Names = enumNames{
+	0: "Autonomous",
+	1: "Master",
+	2: "Slave",
+}
+
+func (d DPT_20112) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20112) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20112) Unit() string {
+	return ""
+}
+
+func (d DPT_20112) String() string {
+	return dpt20112Names.format(uint8(d))
+}
+
+func (d DPT_20112) names() enumNames {
+	return dpt20112Names
+}
+
+func (d DPT_20112) MarshalText() ([]byte, error) {
+	return dpt20112Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20112) UnmarshalText(text []byte) error {
+	return dpt20112Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20112) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20112) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20112) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20112) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20113 represents DPT 20.113 / StatusRoomSetp.
+type DPT_20113 uint8
+
+var dpt20113Names = enumNames{
+	0: "Normal setpoint",
+	1: "Alternative setpoint",
+	2: "Building protection setpoint",
+}
+
+func (d DPT_20113) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20113) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20113) Unit() string {
+	return ""
+}
+
+func (d DPT_20113) String() string {
+	return dpt20113Names.format(uint8(d))
+}
+
+func (d DPT_20113) names() enumNames {
+	return dpt20113Names
+}
+
+func (d DPT_20113) MarshalText() ([]byte, error) {
+	return dpt20113Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20113) UnmarshalText(text []byte) error {
+	return dpt20113Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20113) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20113) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20113) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20113) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20115 represents DPT 20.115 / HumDehumMode.
+type DPT_20115 uint8
+
+var dpt20115Names = enumNames{
+	0: "Inactive",
+	1: "Humidification",
+	2: "Dehumidification",
+}
+
+func (d DPT_20115) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20115) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20115) Unit() string {
+	return ""
+}
+
+func (d DPT_20115) String() string {
+	return dpt20115Names.format(uint8(d))
+}
+
+func (d DPT_20115) names() enumNames {
+	return dpt20115Names
+}
+
+func (d DPT_20115) MarshalText() ([]byte, error) {
+	return dpt20115Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20115) UnmarshalText(text []byte) error {
+	return dpt20115Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20115) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20115) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20115) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20115) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20116 represents DPT 20.116 / EnableHCStage.
+type DPT_20116 uint8
+
+var dpt20116Names = enumNames{
+	0: "Disabled",
+	1: "Enable stage A",
+	2: "Enable both stages",
+}
+
+func (d DPT_20116) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20116) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20116) Unit() string {
+	return ""
+}
+
+func (d DPT_20116) String() string {
+	return dpt20116Names.format(uint8(d))
+}
+
+func (d DPT_20116) names() enumNames {
+	return dpt20116Names
+}
+
+func (d DPT_20116) MarshalText() ([]byte, error) {
+	return dpt20116Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20116) UnmarshalText(text []byte) error {
+	return dpt20116Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20116) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20116) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20116) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20116) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20120 represents DPT 20.120 / ADAType.
+type DPT_20120 uint8
+
+var dpt20120Names = enumNames{
+	1: "Air damper",
+	2: "VAV",
+}
+
+func (d DPT_20120) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20120) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20120) Unit() string {
+	return ""
+}
+
+func (d DPT_20120) String() string {
+	return dpt20120Names.format(uint8(d))
+}
+
+func (d DPT_20120) names() enumNames {
+	return dpt20120Names
+}
+
+func (d DPT_20120) MarshalText() ([]byte, error) {
+	return dpt20120Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20120) UnmarshalText(text []byte) error {
+	return dpt20120Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20120) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20120) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20120) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20120) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20121 represents DPT 20.121 / BackupMode.
+type DPT_20121 uint8
+
+var dpt20121Names = enumNames{
+	0: "Backup value",
+	1: "Keep last state",
+}
+
+func (d DPT_20121) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20121) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20121) Unit() string {
+	return ""
+}
+
+func (d DPT_20121) String() string {
+	return dpt20121Names.format(uint8(d))
+}
+
+func (d DPT_20121) names() enumNames {
+	return dpt20121Names
+}
+
+func (d DPT_20121) MarshalText() ([]byte, error) {
+	return dpt20121Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20121) UnmarshalText(text []byte) error {
+	return dpt20121Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20121) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20121) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20121) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20121) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20122 represents DPT 20.122 / StartSynchronization.
+type DPT_20122 uint8
+
+var dpt20122Names = enumNames{
+	0: "Position unchanged",
+	1: "Single close",
+	2: "Single open",
+}
+
+func (d DPT_20122) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20122) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20122) Unit() string {
+	return ""
+}
+
+func (d DPT_20122) String() string {
+	return dpt20122Names.format(uint8(d))
+}
+
+func (d DPT_20122) names() enumNames {
+	return dpt20122Names
+}
+
+func (d DPT_20122) MarshalText() ([]byte, error) {
+	return dpt20122Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20122) UnmarshalText(text []byte) error {
+	return dpt20122Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20122) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20122) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20122) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20122) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20600 represents DPT 20.600 / Behaviour_Lock_Unlock.
+type DPT_20600 uint8
+
+var dpt20600Names = enumNames{
+	0: "Off",
+	1: "On",
+	2: "No change",
+	3: "Value according additional parameter",
+	4: "Memory function value",
+	5: "Updated value",
+	6: "Value before locking",
+}
+
+func (d DPT_20600) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20600) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20600) Unit() string {
+	return ""
+}
+
+func (d DPT_20600) String() string {
+	return dpt20600Names.format(uint8(d))
+}
+
+func (d DPT_20600) names() enumNames {
+	return dpt20600Names
+}
+
+func (d DPT_20600) MarshalText() ([]byte, error) {
+	return dpt20600Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20600) UnmarshalText(text []byte) error {
+	return dpt20600Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20600) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20600) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20600) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20600) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20601 represents DPT 20.601 / Behaviour_Bus_Power_Up_Down.
+type DPT_20601 uint8
+
+var dpt20601Names = enumNames{
+	0: "Off",
+	1: "On",
+	2: "No change",
+	3: "Value according additional parameter",
+	4: "Last value before bus power down",
+}
+
+func (d DPT_20601) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20601) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20601) Unit() string {
+	return ""
+}
+
+func (d DPT_20601) String() string {
+	return dpt20601Names.format(uint8(d))
+}
+
+func (d DPT_20601) names() enumNames {
+	return dpt20601Names
+}
+
+func (d DPT_20601) MarshalText() ([]byte, error) {
+	return dpt20601Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20601) UnmarshalText(text []byte) error {
+	return dpt20601Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20601) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20601) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20601) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20601) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20602 represents DPT 20.602 / DALI_Fade_Time.
+type DPT_20602 uint8
+
+var dpt20602Names = enumNames{
+	0:  "0 s",
+	1:  "0.7 s",
+	2:  "1.0 s",
+	3:  "1.4 s",
+	4:  "2.0 s",
+	5:  "2.8 s",
+	6:  "4.0 s",
+	7:  "5.7 s",
+	8:  "8.0 s",
+	9:  "11.3 s",
+	10: "16.0 s",
+	11: "22.6 s",
+	12: "32.0 s",
+	13: "45.3 s",
+	14: "64.0 s",
+	15: "90.5 s",
+}
+
+func (d DPT_20602) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20602) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20602) Unit() string {
+	return ""
+}
+
+func (d DPT_20602) String() string {
+	return dpt20602Names.format(uint8(d))
+}
+
+func (d DPT_20602) names() enumNames {
+	return dpt20602Names
+}
+
+func (d DPT_20602) MarshalText() ([]byte, error) {
+	return dpt20602Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20602) UnmarshalText(text []byte) error {
+	return dpt20602Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20602) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20602) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20602) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20602) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20603 represents DPT 20.603 / BlinkingMode.
+type DPT_20603 uint8
+
+var dpt20603Names = enumNames{
+	0: "Blinking disabled",
+	1: "Without acknowledge",
+	2: "Blinking with acknowledge",
+}
+
+func (d DPT_20603) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20603) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20603) Unit() string {
+	return ""
+}
+
+func (d DPT_20603) String() string {
+	return dpt20603Names.format(uint8(d))
+}
+
+func (d DPT_20603) names() enumNames {
+	return dpt20603Names
+}
+
+func (d DPT_20603) MarshalText() ([]byte, error) {
+	return dpt20603Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20603) UnmarshalText(text []byte) error {
+	return dpt20603Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20603) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20603) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20603) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20603) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20604 represents DPT 20.604 / LightControlMode.
+type DPT_20604 uint8
+
+var dpt20604Names = enumNames{
+	0: "Automatic light control",
+	1: "Manual light control",
+}
+
+func (d DPT_20604) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20604) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20604) Unit() string {
+	return ""
+}
+
+func (d DPT_20604) String() string {
+	return dpt20604Names.format(uint8(d))
+}
+
+func (d DPT_20604) names() enumNames {
+	return dpt20604Names
+}
+
+func (d DPT_20604) MarshalText() ([]byte, error) {
+	return dpt20604Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20604) UnmarshalText(text []byte) error {
+	return dpt20604Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20604) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20604) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20604) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20604) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20605 represents DPT 20.605 / SwitchPBModel.
+type DPT_20605 uint8
+
+var dpt20605Names = enumNames{
+	1: "One push button",
+	2: "Two push buttons",
+}
+
+func (d DPT_20605) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20605) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20605) Unit() string {
+	return ""
+}
+
+func (d DPT_20605) String() string {
+	return dpt20605Names.format(uint8(d))
+}
+
+func (d DPT_20605) names() enumNames {
+	return dpt20605Names
+}
+
+func (d DPT_20605) MarshalText() ([]byte, error) {
+	return dpt20605Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20605) UnmarshalText(text []byte) error {
+	return dpt20605Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20605) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20605) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20605) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20605) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20606 represents DPT 20.606 / PBAction.
+type DPT_20606 uint8
+
+var dpt20606Names = enumNames{
+	0: "Inactive",
+	1: "Switch off",
+	2: "Switch on",
+	3: "Inverse value",
+}
+
+func (d DPT_20606) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20606) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20606) Unit() string {
+	return ""
+}
+
+func (d DPT_20606) String() string {
+	return dpt20606Names.format(uint8(d))
+}
+
+func (d DPT_20606) names() enumNames {
+	return dpt20606Names
+}
+
+func (d DPT_20606) MarshalText() ([]byte, error) {
+	return dpt20606Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20606) UnmarshalText(text []byte) error {
+	return dpt20606Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20606) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20606) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20606) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20606) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20607 represents DPT 20.607 / DimmPBModel.
+type DPT_20607 uint8
+
+var dpt20607Names = enumNames{
+	1: "One push button toggle",
+	2: "One push button on/dim up",
+	3: "One push button off/dim down",
+	4: "Two push buttons",
+}
+
+func (d DPT_20607) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20607) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20607) Unit() string {
+	return ""
+}
+
+func (d DPT_20607) String() string {
+	return dpt20607Names.format(uint8(d))
+}
+
+func (d DPT_20607) names() enumNames {
+	return dpt20607Names
+}
+
+func (d DPT_20607) MarshalText() ([]byte, error) {
+	return dpt20607Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20607) UnmarshalText(text []byte) error {
+	return dpt20607Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20607) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20607) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20607) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20607) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20608 represents DPT 20.608 / SwitchOnMode.
+type DPT_20608 uint8
+
+var dpt20608Names = enumNames{
+	0: "Last actual value",
+	1: "Value according additional parameter",
+	2: "Last received absolute setvalue",
+}
+
+func (d DPT_20608) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20608) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20608) Unit() string {
+	return ""
+}
+
+func (d DPT_20608) String() string {
+	return dpt20608Names.format(uint8(d))
+}
+
+func (d DPT_20608) names() enumNames {
+	return dpt20608Names
+}
+
+func (d DPT_20608) MarshalText() ([]byte, error) {
+	return dpt20608Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20608) UnmarshalText(text []byte) error {
+	return dpt20608Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20608) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20608) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20608) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20608) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20609 represents DPT 20.609 / LoadTypeSet.
+type DPT_20609 uint8
+
+var dpt20609Names = enumNames{
+	0: "Automatic",
+	1: "Leading edge",
+	2: "Trailing edge",
+}
+
+func (d DPT_20609) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20609) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20609) Unit() string {
+	return ""
+}
+
+func (d DPT_20609) String() string {
+	return dpt20609Names.format(uint8(d))
+}
+
+func (d DPT_20609) names() enumNames {
+	return dpt20609Names
+}
+
+func (d DPT_20609) MarshalText() ([]byte, error) {
+	return dpt20609Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20609) UnmarshalText(text []byte) error {
+	return dpt20609Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20609) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20609) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20609) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20609) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20610 represents DPT 20.610 / LoadTypeDetected.
+type DPT_20610 uint8
+
+var dpt20610Names = enumNames{
+	0: "Undefined",
+	1: "Leading edge",
+	2: "Trailing edge",
+	3: "Detection not possible",
+}
+
+func (d DPT_20610) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20610) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20610) Unit() string {
+	return ""
+}
+
+func (d DPT_20610) String() string {
+	return dpt20610Names.format(uint8(d))
+}
+
+func (d DPT_20610) names() enumNames {
+	return dpt20610Names
+}
+
+func (d DPT_20610) MarshalText() ([]byte, error) {
+	return dpt20610Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20610) UnmarshalText(text []byte) error {
+	return dpt20610Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20610) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20610) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20610) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20610) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20611 represents DPT 20.611 / Converter_Test_Control.
+type DPT_20611 uint8
+
+var dpt20611Names = enumNames{
+	1: "Start function test",
+	2: "Start duration test",
+	3: "Start partial duration test",
+	4: "Stop test",
+	5: "Reset function test done flag",
+	6: "Reset duration test done flag",
+}
+
+func (d DPT_20611) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20611) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20611) Unit() string {
+	return ""
+}
+
+func (d DPT_20611) String() string {
+	return dpt20611Names.format(uint8(d))
+}
+
+func (d DPT_20611) names() enumNames {
+	return dpt20611Names
+}
+
+func (d DPT_20611) MarshalText() ([]byte, error) {
+	return dpt20611Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20611) UnmarshalText(text []byte) error {
+	return dpt20611Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20611) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20611) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20611) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20611) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20801 represents DPT 20.801 / SAB_Except_Behaviour.
+type DPT_20801 uint8
+
+var dpt20801Names = enumNames{
+	0: "Up",
+	1: "Down",
+	2: "No change",
+	3: "Value according additional parameter",
+	4: "Stop",
+}
+
+func (d DPT_20801) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20801) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20801) Unit() string {
+	return ""
+}
+
+func (d DPT_20801) String() string {
+	return dpt20801Names.format(uint8(d))
+}
+
+func (d DPT_20801) names() enumNames {
+	return dpt20801Names
+}
+
+func (d DPT_20801) MarshalText() ([]byte, error) {
+	return dpt20801Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20801) UnmarshalText(text []byte) error {
+	return dpt20801Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20801) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20801) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20801) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20801) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20802 represents DPT 20.802 / SAB_Behaviour_Lock_Unlock.
+type DPT_20802 uint8
+
+var dpt20802Names = enumNames{
+	0: "Up",
+	1: "Down",
+	2: "No change",
+	3: "Value according additional parameter",
+	4: "Stop",
+	5: "Updated value",
+	6: "Value before locking",
+}
+
+func (d DPT_20802) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20802) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20802) Unit() string {
+	return ""
+}
+
+func (d DPT_20802) String() string {
+	return dpt20802Names.format(uint8(d))
+}
+
+func (d DPT_20802) names() enumNames {
+	return dpt20802Names
+}
+
+func (d DPT_20802) MarshalText() ([]byte, error) {
+	return dpt20802Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20802) UnmarshalText(text []byte) error {
+	return dpt20802Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20802) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20802) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20802) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20802) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20803 represents DPT 20.803 / SSSBMode.
+type DPT_20803 uint8
+
+var dpt20803Names = enumNames{
+	1: "One push button toggle",
+	2: "One push button up/step up",
+	3: "One push button down/step down",
+	4: "Two push buttons",
+}
+
+func (d DPT_20803) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20803) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20803) Unit() string {
+	return ""
+}
+
+func (d DPT_20803) String() string {
+	return dpt20803Names.format(uint8(d))
+}
+
+func (d DPT_20803) names() enumNames {
+	return dpt20803Names
+}
+
+func (d DPT_20803) MarshalText() ([]byte, error) {
+	return dpt20803Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20803) UnmarshalText(text []byte) error {
+	return dpt20803Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20803) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20803) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20803) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20803) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20804 represents DPT 20.804 / BlindsControlMode.
+type DPT_20804 uint8
+
+var dpt20804Names = enumNames{
+	0: "Automatic control",
+	1: "Manual control",
+}
+
+func (d DPT_20804) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20804) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20804) Unit() string {
+	return ""
+}
+
+func (d DPT_20804) String() string {
+	return dpt20804Names.format(uint8(d))
+}
+
+func (d DPT_20804) names() enumNames {
+	return dpt20804Names
+}
+
+func (d DPT_20804) MarshalText() ([]byte, error) {
+	return dpt20804Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20804) UnmarshalText(text []byte) error {
+	return dpt20804Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20804) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20804) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20804) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20804) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20_1000 represents DPT 20.1000 / CommMode.
+type DPT_20_1000 uint8
+
+var dpt201000Names = enumNames{
+	0:   "Data link layer",
+	1:   "Data link layer busmonitor",
+	2:   "Data link layer raw frames",
+	6:   "cEMI transport layer",
+	255: "No layer",
+}
+
+func (d DPT_20_1000) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20_1000) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20_1000) Unit() string {
+	return ""
+}
+
+func (d DPT_20_1000) String() string {
+	return dpt201000Names.format(uint8(d))
+}
+
+func (d DPT_20_1000) names() enumNames {
+	return dpt201000Names
+}
+
+func (d DPT_20_1000) MarshalText() ([]byte, error) {
+	return dpt201000Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20_1000) UnmarshalText(text []byte) error {
+	return dpt201000Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20_1000) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20_1000) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20_1000) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20_1000) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20_1001 represents DPT 20.1001 / AddInfoTypes.
+type DPT_20_1001 uint8
+
+var dpt201001Names = enumNames{
+	1: "PL medium domain address",
+	2: "RF control octet and serial number or DoA",
+	3: "Busmonitor error flags",
+	4: "Relative timestamp",
+	5: "Time delay",
+	6: "Extended relative timestamp",
+	7: "BiBat information",
+}
+
+func (d DPT_20_1001) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20_1001) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20_1001) Unit() string {
+	return ""
+}
+
+func (d DPT_20_1001) String() string {
+	return dpt201001Names.format(uint8(d))
+}
+
+func (d DPT_20_1001) names() enumNames {
+	return dpt201001Names
+}
+
+func (d DPT_20_1001) MarshalText() ([]byte, error) {
+	return dpt201001Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20_1001) UnmarshalText(text []byte) error {
+	return dpt201001Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20_1001) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20_1001) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20_1001) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20_1001) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20_1002 represents DPT 20.1002 / RF_ModeSelect.
+type DPT_20_1002 uint8
+
+var dpt201002Names = enumNames{
+	0: "Asynchronous",
+	1: "Asynchronous + BiBat master",
+	2: "Asynchronous + BiBat slave",
+}
+
+func (d DPT_20_1002) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20_1002) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20_1002) Unit() string {
+	return ""
+}
+
+func (d DPT_20_1002) String() string {
+	return dpt201002Names.format(uint8(d))
+}
+
+func (d DPT_20_1002) names() enumNames {
+	return dpt201002Names
+}
+
+func (d DPT_20_1002) MarshalText() ([]byte, error) {
+	return dpt201002Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20_1002) UnmarshalText(text []byte) error {
+	return dpt201002Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20_1002) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20_1002) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20_1002) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20_1002) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
+// DPT_20_1003 represents DPT 20.1003 / RF_FilterSelect.
+type DPT_20_1003 uint8
+
+var dpt201003Names = enumNames{
+	0: "No filtering",
+	1: "Filtering by DoA",
+	2: "Filtering by KNX serial number table",
+	3: "Filtering by DoA and KNX serial number table",
+}
+
+func (d DPT_20_1003) Pack() []byte {
+	return packU8(uint8(d))
+}
+
+func (d *DPT_20_1003) Unpack(data []byte) error {
+	return unpackU8(data, (*uint8)(d))
+}
+
+func (d DPT_20_1003) Unit() string {
+	return ""
+}
+
+func (d DPT_20_1003) String() string {
+	return dpt201003Names.format(uint8(d))
+}
+
+func (d DPT_20_1003) names() enumNames {
+	return dpt201003Names
+}
+
+func (d DPT_20_1003) MarshalText() ([]byte, error) {
+	return dpt201003Names.text(uint8(d)), nil
+}
+
+// UnmarshalText parses the value from its name or its number.
+func (d *DPT_20_1003) UnmarshalText(text []byte) error {
+	return dpt201003Names.parse(string(text), (*uint8)(d))
+}
+
+func (d DPT_20_1003) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_20_1003) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_20_1003) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_20_1003) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
 }
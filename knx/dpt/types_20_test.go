@@ -1,6 +1,9 @@
 package dpt
 
 import (
+	"encoding"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -31,3 +34,63 @@ func TestDPT_20105(t *testing.T) {
 
 	assert.Equal(t, "Fan only", dptValue.String())
 }
+
+func TestDPT_20xxx(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    uint8
+		expected string
+	}{
+		{"20.001", 2, "Master"},
+		{"20.002", 1, "Building not used"},
+		{"20.011", 9, "CRC error"},
+		{"20.013", 11, "1.25 min"},
+		{"20.017", 4, "Temperature sensor input"},
+		{"20.100", 3, "Solid state fuel"},
+		{"20.103", 4, "Off/Frost protect"},
+		{"20.104", 2, "Absolute load priority"},
+		{"20.107", 1, "Cooling only"},
+		{"20.113", 2, "Building protection setpoint"},
+		{"20.600", 6, "Value before locking"},
+		{"20.609", 2, "Trailing edge"},
+		{"20.1000", 255, "No layer"},
+	}
+
+	for _, test := range tests {
+		dv, ok := Produce(test.name)
+		if !assert.True(t, ok, test.name) {
+			continue
+		}
+
+		assert.NoError(t, dv.Unpack([]byte{0, test.value}), test.name)
+		assert.Equal(t, test.expected, fmt.Sprint(dv), test.name)
+		assert.Equal(t, []byte{0, test.value}, dv.Pack(), test.name)
+
+		// Parse the name in various spellings and as number
+		for _, text := range []string{test.expected, strings.ToUpper(test.expected), fmt.Sprint(test.value)} {
+			parsed, _ := Produce(test.name)
+			if assert.NoError(t, parsed.(encoding.TextUnmarshaler).UnmarshalText([]byte(text)), test.name) {
+				assert.Equal(t, []byte{0, test.value}, parsed.Pack(), test.name)
+			}
+		}
+	}
+}
+
+func TestDPT_20xxx_Reserved(t *testing.T) {
+	var dpv DPT_20107
+	assert.NoError(t, dpv.Unpack([]byte{0, 3}))
+	assert.Equal(t, "reserved", dpv.String())
+
+	assert.Error(t, dpv.UnmarshalText([]byte("reserved")))
+	assert.Error(t, dpv.UnmarshalText([]byte("3")))
+	assert.Error(t, dpv.UnmarshalText([]byte("256")))
+
+	assert.NoError(t, dpv.UnmarshalText([]byte(" heating_only ")))
+	assert.Equal(t, DPT_20107(2), dpv)
+
+	var mode DPT_20102
+	assert.NoError(t, mode.UnmarshalText([]byte("building-protection")))
+	assert.Equal(t, HVACMode_BuildingProtection, mode)
+
+	assert.Error(t, mode.Unpack([]byte{4}))
+}
@@ -0,0 +1,217 @@
+package dpt
+
+import (
+	"fmt"
+	"strings"
+)
+
+// packFlags packs the flags into a bitset, the first flag being the least significant bit.
+func packFlags(flags []*bool) (bits uint32) {
+	for i, flag := range flags {
+		if *flag {
+			bits |= 1 << i
+		}
+	}
+
+	return
+}
+
+// unpackFlags sets the flags from a bitset. Set bits without a flag are reserved and result in an
+// error.
+func unpackFlags(bits uint32, flags []*bool) error {
+	if bits>>len(flags) != 0 {
+		return ErrBadReservedBits
+	}
+
+	for i, flag := range flags {
+		*flag = bits&(1<<i) != 0
+	}
+
+	return nil
+}
+
+// formatFlags lists the names of the flags which are set.
+func formatFlags(names []string, flags []*bool) string {
+	var active []string
+	for i, flag := range flags {
+		if *flag {
+			active = append(active, names[i])
+		}
+	}
+
+	if len(active) == 0 {
+		return "None"
+	}
+
+	return strings.Join(active, ", ")
+}
+
+// parseFlags sets the flags which are listed by name, separated by commas, pipes or white space.
+// All other flags are cleared. "None" clears all flags.
+func parseFlags(text string, names []string, flags []*bool) error {
+	fields := strings.FieldsFunc(text, func(r rune) bool {
+		return r == ',' || r == '|' || r == ' ' || r == '\t'
+	})
+
+	active := make([]bool, len(flags))
+
+	for _, field := range fields {
+		if strings.EqualFold(field, "none") {
+			continue
+		}
+
+		found := false
+		for i, name := range names {
+			if normalizeEnumName(name) == normalizeEnumName(field) {
+				active[i] = true
+				found = true
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("unknown flag %q", field)
+		}
+	}
+
+	for i, flag := range flags {
+		*flag = active[i]
+	}
+
+	return nil
+}
+
+// DPT_21001 represents DPT 21.001 / DPT_StatusGen.
+type DPT_21001 struct {
+	OutOfService bool
+	Fault        bool
+	Overridden   bool
+	InAlarm      bool
+	AlarmUnAck   bool
+}
+
+var dpt21001Names = []string{"OutOfService", "Fault", "Overridden", "InAlarm", "AlarmUnAck"}
+
+func (d *DPT_21001) flags() []*bool {
+	return []*bool{&d.OutOfService, &d.Fault, &d.Overridden, &d.InAlarm, &d.AlarmUnAck}
+}
+
+func (d DPT_21001) Pack() []byte {
+	return packU8(uint8(packFlags(d.flags())))
+}
+
+func (d *DPT_21001) Unpack(data []byte) error {
+	var bits uint8
+	if err := unpackU8(data, &bits); err != nil {
+		return err
+	}
+
+	var value DPT_21001
+	if err := unpackFlags(uint32(bits), value.flags()); err != nil {
+		return err
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d DPT_21001) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses the names of the flags which are set, e.g. "Fault, InAlarm".
+func (d *DPT_21001) UnmarshalText(text []byte) error {
+	return parseFlags(string(text), dpt21001Names, d.flags())
+}
+
+func (d DPT_21001) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_21001) UnmarshalJSON(data []byte) error {
+	type plain DPT_21001
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+func (d DPT_21001) Unit() string {
+	return ""
+}
+
+func (d DPT_21001) String() string {
+	return formatFlags(dpt21001Names, d.flags())
+}
+
+func (d *DPT_21001) flagNames() []string {
+	return dpt21001Names
+}
+
+// DPT_21601 represents DPT 21.601 / DPT_LightActuatorErrorInfo.
+type DPT_21601 struct {
+	LoadDetectionError bool
+	Undervoltage       bool
+	Overcurrent        bool
+	Underload          bool
+	DefectiveLoad      bool
+	LampFailure        bool
+	Overheat           bool
+}
+
+var dpt21601Names = []string{
+	"LoadDetectionError", "Undervoltage", "Overcurrent", "Underload", "DefectiveLoad", "LampFailure", "Overheat",
+}
+
+func (d *DPT_21601) flags() []*bool {
+	return []*bool{
+		&d.LoadDetectionError, &d.Undervoltage, &d.Overcurrent, &d.Underload, &d.DefectiveLoad, &d.LampFailure, &d.Overheat,
+	}
+}
+
+func (d DPT_21601) Pack() []byte {
+	return packU8(uint8(packFlags(d.flags())))
+}
+
+func (d *DPT_21601) Unpack(data []byte) error {
+	var bits uint8
+	if err := unpackU8(data, &bits); err != nil {
+		return err
+	}
+
+	var value DPT_21601
+	if err := unpackFlags(uint32(bits), value.flags()); err != nil {
+		return err
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d DPT_21601) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses the names of the flags which are set, e.g. "LampFailure, Overheat".
+func (d *DPT_21601) UnmarshalText(text []byte) error {
+	return parseFlags(string(text), dpt21601Names, d.flags())
+}
+
+func (d DPT_21601) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_21601) UnmarshalJSON(data []byte) error {
+	type plain DPT_21601
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+func (d DPT_21601) Unit() string {
+	return ""
+}
+
+func (d DPT_21601) String() string {
+	return formatFlags(dpt21601Names, d.flags())
+}
+
+func (d *DPT_21601) flagNames() []string {
+	return dpt21601Names
+}
@@ -0,0 +1,83 @@
+package dpt
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDPT_21001(t *testing.T) {
+	knxValue := []byte{0, 0x0a}
+	dptValue := DPT_21001{Fault: true, InAlarm: true}
+
+	var tmpDPT DPT_21001
+	assert.NoError(t, tmpDPT.Unpack(knxValue))
+	assert.Equal(t, dptValue, tmpDPT)
+
+	assert.Equal(t, knxValue, dptValue.Pack())
+
+	assert.Equal(t, "Fault, InAlarm", dptValue.String())
+	assert.Equal(t, "None", DPT_21001{}.String())
+
+	assert.ErrorIs(t, tmpDPT.Unpack([]byte{0, 0x20}), ErrBadReservedBits)
+	assert.ErrorIs(t, tmpDPT.Unpack([]byte{0x0a}), ErrInvalidLength)
+}
+
+func TestDPT_21001_Text(t *testing.T) {
+	var dpv DPT_21001
+	assert.NoError(t, dpv.UnmarshalText([]byte("fault|in_alarm")))
+	assert.Equal(t, DPT_21001{Fault: true, InAlarm: true}, dpv)
+
+	assert.NoError(t, dpv.UnmarshalText([]byte("AlarmUnAck")))
+	assert.Equal(t, DPT_21001{AlarmUnAck: true}, dpv)
+
+	assert.NoError(t, dpv.UnmarshalText([]byte("None")))
+	assert.Equal(t, DPT_21001{}, dpv)
+
+	assert.Error(t, dpv.UnmarshalText([]byte("Fault, Broken")))
+}
+
+func TestDPT_21001_JSON(t *testing.T) {
+	src := DPT_21001{OutOfService: true, AlarmUnAck: true}
+
+	data, err := json.Marshal(src)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"value": {"OutOfService":true,"Fault":false,"Overridden":false,"InAlarm":false,"AlarmUnAck":true},
+		"flags": ["OutOfService","AlarmUnAck"],
+		"text": "OutOfService, AlarmUnAck"
+	}`, string(data))
+
+	var dst DPT_21001
+	assert.NoError(t, json.Unmarshal(data, &dst))
+	assert.Equal(t, src, dst)
+
+	var partial DPT_21001
+	assert.NoError(t, json.Unmarshal([]byte(`{"Fault":true}`), &partial))
+	assert.Equal(t, DPT_21001{Fault: true}, partial)
+
+	assert.NoError(t, json.Unmarshal([]byte(`"Fault, InAlarm"`), &dst))
+	assert.Equal(t, DPT_21001{Fault: true, InAlarm: true}, dst)
+
+	assert.Error(t, json.Unmarshal([]byte(`"Broken"`), &dst))
+}
+
+func TestDPT_21601(t *testing.T) {
+	knxValue := []byte{0, 0x61}
+	dptValue := DPT_21601{LoadDetectionError: true, LampFailure: true, Overheat: true}
+
+	var tmpDPT DPT_21601
+	assert.NoError(t, tmpDPT.Unpack(knxValue))
+	assert.Equal(t, dptValue, tmpDPT)
+
+	assert.Equal(t, knxValue, dptValue.Pack())
+
+	assert.Equal(t, "LoadDetectionError, LampFailure, Overheat", dptValue.String())
+
+	var parsed DPT_21601
+	assert.NoError(t, parsed.UnmarshalText([]byte(dptValue.String())))
+	assert.Equal(t, dptValue, parsed)
+
+	assert.ErrorIs(t, tmpDPT.Unpack([]byte{0, 0x80}), ErrBadReservedBits)
+}
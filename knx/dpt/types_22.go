@@ -0,0 +1,165 @@
+package dpt
+
+// DPT_22101 represents DPT 22.101 / DPT_StatusRHCC.
+type DPT_22101 struct {
+	Fault               bool
+	StatusEcoH          bool
+	TempFlowLimit       bool
+	TempReturnLimit     bool
+	StatusMorningBoostH bool
+	StatusStartOptim    bool
+	StatusStopOptim     bool
+	HeatingDisabled     bool
+	// HeatMode indicates heating, otherwise the controller is cooling.
+	HeatMode        bool
+	StatusEcoC      bool
+	StatusPreCool   bool
+	CoolingDisabled bool
+	DewPointStatus  bool
+	FrostAlarm      bool
+	OverheatAlarm   bool
+}
+
+var dpt22101Names = []string{
+	"Fault", "StatusEcoH", "TempFlowLimit", "TempReturnLimit", "StatusMorningBoostH", "StatusStartOptim",
+	"StatusStopOptim", "HeatingDisabled", "HeatMode", "StatusEcoC", "StatusPreCool", "CoolingDisabled",
+	"DewPointStatus", "FrostAlarm", "OverheatAlarm",
+}
+
+func (d *DPT_22101) flags() []*bool {
+	return []*bool{
+		&d.Fault, &d.StatusEcoH, &d.TempFlowLimit, &d.TempReturnLimit, &d.StatusMorningBoostH, &d.StatusStartOptim,
+		&d.StatusStopOptim, &d.HeatingDisabled, &d.HeatMode, &d.StatusEcoC, &d.StatusPreCool, &d.CoolingDisabled,
+		&d.DewPointStatus, &d.FrostAlarm, &d.OverheatAlarm,
+	}
+}
+
+func (d DPT_22101) Pack() []byte {
+	return packU16(uint16(packFlags(d.flags())))
+}
+
+func (d *DPT_22101) Unpack(data []byte) error {
+	var bits uint16
+	if err := unpackU16(data, &bits); err != nil {
+		return err
+	}
+
+	var value DPT_22101
+	if err := unpackFlags(uint32(bits), value.flags()); err != nil {
+		return err
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d DPT_22101) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses the names of the flags which are set, e.g. "HeatMode, StatusEcoH".
+func (d *DPT_22101) UnmarshalText(text []byte) error {
+	return parseFlags(string(text), dpt22101Names, d.flags())
+}
+
+func (d DPT_22101) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_22101) UnmarshalJSON(data []byte) error {
+	type plain DPT_22101
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+func (d DPT_22101) Unit() string {
+	return ""
+}
+
+func (d DPT_22101) String() string {
+	return formatFlags(dpt22101Names, d.flags())
+}
+
+func (d *DPT_22101) flagNames() []string {
+	return dpt22101Names
+}
+
+// DPT_22_1000 represents DPT 22.1000 / DPT_Media.
+// It lists the supported media, the bits 0, 3 and 6 - 15 are reserved.
+type DPT_22_1000 struct {
+	TP1   bool
+	PL110 bool
+	RF    bool
+	KNXIP bool
+}
+
+var dpt22_1000Names = []string{"TP1", "PL110", "RF", "KNXIP"}
+
+func (d *DPT_22_1000) flags() []*bool {
+	return []*bool{&d.TP1, &d.PL110, &d.RF, &d.KNXIP}
+}
+
+// dpt22_1000Bits are the bit positions of the media.
+var dpt22_1000Bits = []uint{1, 2, 4, 5}
+
+func (d DPT_22_1000) Pack() []byte {
+	var bits uint16
+	for i, flag := range d.flags() {
+		if *flag {
+			bits |= 1 << dpt22_1000Bits[i]
+		}
+	}
+
+	return packU16(bits)
+}
+
+func (d *DPT_22_1000) Unpack(data []byte) error {
+	var bits uint16
+	if err := unpackU16(data, &bits); err != nil {
+		return err
+	}
+
+	var value DPT_22_1000
+	for i, flag := range value.flags() {
+		*flag = bits&(1<<dpt22_1000Bits[i]) != 0
+		bits &^= 1 << dpt22_1000Bits[i]
+	}
+
+	if bits != 0 {
+		return ErrBadReservedBits
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d DPT_22_1000) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses the names of the supported media, e.g. "TP1, KNXIP".
+func (d *DPT_22_1000) UnmarshalText(text []byte) error {
+	return parseFlags(string(text), dpt22_1000Names, d.flags())
+}
+
+func (d DPT_22_1000) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_22_1000) UnmarshalJSON(data []byte) error {
+	type plain DPT_22_1000
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+func (d DPT_22_1000) Unit() string {
+	return ""
+}
+
+func (d DPT_22_1000) String() string {
+	return formatFlags(dpt22_1000Names, d.flags())
+}
+
+func (d *DPT_22_1000) flagNames() []string {
+	return dpt22_1000Names
+}
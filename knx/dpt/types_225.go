@@ -0,0 +1,174 @@
+package dpt
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DPT_225001 represents DPT 225.001 / DPT_Scaling_Speed
+// Time period: 0-65535 ms Scaling: 0-255 (= 0 - 100%)
+// U16 U8
+type DPT_225001 struct {
+	TimePeriod uint16
+	Scaling    uint8
+}
+
+func (d DPT_225001) Pack() []byte {
+	period := packU16(d.TimePeriod)
+	return []byte{0, period[1], period[2], d.Scaling}
+}
+
+func (d *DPT_225001) Unpack(data []byte) error {
+	return unpackU16U8(data, &d.TimePeriod, &d.Scaling)
+}
+
+// Duration returns the time period in which the scaling value is reached.
+func (d DPT_225001) Duration() time.Duration {
+	return time.Duration(d.TimePeriod) * time.Millisecond
+}
+
+func (d DPT_225001) Unit() string {
+	return ""
+}
+
+func (d DPT_225001) String() string {
+	return fmt.Sprintf("%.1f%% in %s", percentU8(d.Scaling), d.Duration())
+}
+
+func (d DPT_225001) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses the form "<scaling> in <time period>", e.g. "50% in 1.5s".
+func (d *DPT_225001) UnmarshalText(text []byte) error {
+	scaling, period, found := strings.Cut(string(text), " in ")
+	if !found {
+		return fmt.Errorf("value %q is not in the form \"<scaling> in <time period>\"", text)
+	}
+
+	var value DPT_225001
+
+	if err := parseFieldPercentU8(strings.TrimSpace(scaling), &value.Scaling); err != nil {
+		return err
+	}
+
+	if err := parseMillisecondsU16(period, &value.TimePeriod); err != nil {
+		return err
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d DPT_225001) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_225001) UnmarshalJSON(data []byte) error {
+	type plain DPT_225001
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+// DPT_225002 represents DPT 225.002 / DPT_Scaling_Step_Time
+// Step time: 0-65535 ms Scaling: 0-255 (= 0 - 100%)
+// U16 U8
+type DPT_225002 struct {
+	StepTime uint16
+	Scaling  uint8
+}
+
+func (d DPT_225002) Pack() []byte {
+	step := packU16(d.StepTime)
+	return []byte{0, step[1], step[2], d.Scaling}
+}
+
+func (d *DPT_225002) Unpack(data []byte) error {
+	return unpackU16U8(data, &d.StepTime, &d.Scaling)
+}
+
+// Duration returns the time between two steps.
+func (d DPT_225002) Duration() time.Duration {
+	return time.Duration(d.StepTime) * time.Millisecond
+}
+
+func (d DPT_225002) Unit() string {
+	return ""
+}
+
+func (d DPT_225002) String() string {
+	return fmt.Sprintf("%.1f%% with %s per step", percentU8(d.Scaling), d.Duration())
+}
+
+func (d DPT_225002) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses the form "<scaling> with <step time> per step", e.g. "50% with 100ms per
+// step".
+func (d *DPT_225002) UnmarshalText(text []byte) error {
+	scaling, step, found := strings.Cut(strings.TrimSuffix(strings.TrimSpace(string(text)), " per step"), " with ")
+	if !found {
+		return fmt.Errorf("value %q is not in the form \"<scaling> with <step time> per step\"", text)
+	}
+
+	var value DPT_225002
+
+	if err := parseFieldPercentU8(strings.TrimSpace(scaling), &value.Scaling); err != nil {
+		return err
+	}
+
+	if err := parseMillisecondsU16(step, &value.StepTime); err != nil {
+		return err
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d DPT_225002) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_225002) UnmarshalJSON(data []byte) error {
+	type plain DPT_225002
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+// unpackU16U8 unpacks the U16 U8 format.
+func unpackU16U8(data []byte, u16 *uint16, u8 *uint8) error {
+	if len(data) != 4 {
+		return ErrInvalidLength
+	}
+
+	if err := unpackU16([]byte{0, data[1], data[2]}, u16); err != nil {
+		return err
+	}
+
+	*u8 = data[3]
+
+	return nil
+}
+
+// parseMillisecondsU16 parses a duration, e.g. "1.5s", into milliseconds in the range 0-65535.
+func parseMillisecondsU16(text string, value *uint16) error {
+	duration, err := time.ParseDuration(strings.TrimSpace(text))
+	if err != nil {
+		return fmt.Errorf("duration %q is invalid: %w", text, err)
+	}
+
+	if duration < 0 || duration > 65535*time.Millisecond || duration%time.Millisecond != 0 {
+		return fmt.Errorf("duration %q is not a multiple of 1ms between 0s and 1m5.535s", text)
+	}
+
+	*value = uint16(duration / time.Millisecond)
+
+	return nil
+}
+
+// percentU8 scales a U8 value in the range 0-255 to 0-100%.
+func percentU8(value uint8) float64 {
+	return float64(value) * 100 / 255
+}
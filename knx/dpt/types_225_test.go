@@ -0,0 +1,46 @@
+package dpt
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDPT_225001(t *testing.T) {
+	var dst DPT_225001
+	for _, src := range []DPT_225001{{TimePeriod: 1500, Scaling: 255}, {TimePeriod: 65535, Scaling: 0}} {
+		if err := dst.Unpack(src.Pack()); err != nil {
+			t.Error(err)
+		}
+
+		if !reflect.DeepEqual(src, dst) {
+			t.Errorf("Value \"%s\" after pack/unpack for DPT_225001 differs. Original value was \"%v\"!", dst, src)
+		}
+	}
+
+	src := DPT_225001{TimePeriod: 1500, Scaling: 255}
+	if src.Duration() != 1500*time.Millisecond || src.String() != "100.0% in 1.5s" {
+		t.Errorf("Unexpected value \"%s\"", src)
+	}
+
+	if err := dst.Unpack([]byte{0, 1, 2}); err == nil {
+		t.Errorf("Expected error for invalid length")
+	}
+}
+
+func TestDPT_225002(t *testing.T) {
+	var dst DPT_225002
+	src := DPT_225002{StepTime: 200, Scaling: 51}
+
+	if err := dst.Unpack(src.Pack()); err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(src, dst) {
+		t.Errorf("Value \"%s\" after pack/unpack for DPT_225002 differs. Original value was \"%v\"!", dst, src)
+	}
+
+	if src.String() != "20.0% with 200ms per step" {
+		t.Errorf("Unexpected value \"%s\"", src)
+	}
+}
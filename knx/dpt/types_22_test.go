@@ -0,0 +1,51 @@
+package dpt
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDPT_22101(t *testing.T) {
+	knxValue := []byte{0, 0x11, 0x03}
+	dptValue := DPT_22101{Fault: true, StatusEcoH: true, HeatMode: true, DewPointStatus: true}
+
+	var tmpDPT DPT_22101
+	assert.NoError(t, tmpDPT.Unpack(knxValue))
+	assert.Equal(t, dptValue, tmpDPT)
+
+	assert.Equal(t, knxValue, dptValue.Pack())
+
+	assert.Equal(t, "Fault, StatusEcoH, HeatMode, DewPointStatus", dptValue.String())
+
+	var parsed DPT_22101
+	assert.NoError(t, parsed.UnmarshalText([]byte("heatmode dewpointstatus,fault StatusEcoH")))
+	assert.Equal(t, dptValue, parsed)
+
+	assert.ErrorIs(t, tmpDPT.Unpack([]byte{0, 0x80, 0x00}), ErrBadReservedBits)
+}
+
+func TestDPT_22_1000(t *testing.T) {
+	knxValue := []byte{0, 0x00, 0x32}
+	dptValue := DPT_22_1000{TP1: true, RF: true, KNXIP: true}
+
+	var tmpDPT DPT_22_1000
+	assert.NoError(t, tmpDPT.Unpack(knxValue))
+	assert.Equal(t, dptValue, tmpDPT)
+
+	assert.Equal(t, knxValue, dptValue.Pack())
+
+	assert.Equal(t, "TP1, RF, KNXIP", dptValue.String())
+
+	for _, reserved := range [][]byte{{0, 0x00, 0x01}, {0, 0x00, 0x08}, {0, 0x01, 0x00}} {
+		assert.ErrorIs(t, tmpDPT.Unpack(reserved), ErrBadReservedBits)
+	}
+
+	data, err := json.Marshal(dptValue)
+	assert.NoError(t, err)
+
+	var dst DPT_22_1000
+	assert.NoError(t, json.Unmarshal(data, &dst))
+	assert.Equal(t, dptValue, dst)
+}
@@ -0,0 +1,85 @@
+package dpt
+
+import (
+	"fmt"
+)
+
+// DPT_232600 represents DPT 232.600 / DPT_Colour_RGB - RGB value 3x(0..255) / U8 U8 U8
+type DPT_232600 struct {
+	Red   uint8
+	Green uint8
+	Blue  uint8
+}
+
+func (d DPT_232600) Pack() []byte {
+	return []byte{0, d.Red, d.Green, d.Blue}
+}
+
+func (d *DPT_232600) Unpack(data []byte) error {
+	if len(data) != 4 {
+		return ErrInvalidLength
+	}
+
+	*d = DPT_232600{
+		Red:   data[1],
+		Green: data[2],
+		Blue:  data[3],
+	}
+
+	return nil
+}
+
+func (d DPT_232600) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses a colour in the form #RRGGBB.
+func (d *DPT_232600) UnmarshalText(text []byte) error {
+	components, err := parseHexColour(string(text), false)
+	if err != nil {
+		return err
+	}
+
+	*d = DPT_232600{Red: components[0], Green: components[1], Blue: components[2]}
+
+	return nil
+}
+
+func (d DPT_232600) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_232600) UnmarshalJSON(data []byte) error {
+	type plain DPT_232600
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+// HSV returns hue (in degrees), saturation and value (both in the range [0, 1]) of the colour.
+func (d DPT_232600) HSV() (h, s, v float64) {
+	return RGBToHSV(d.Red, d.Green, d.Blue)
+}
+
+// SetHSV sets the colour from hue (in degrees), saturation and value (both in the range [0, 1]).
+func (d *DPT_232600) SetHSV(h, s, v float64) {
+	d.Red, d.Green, d.Blue = HSVToRGB(h, s, v)
+}
+
+// XyY converts the colour to DPT 242.600.
+func (d DPT_232600) XyY() DPT_242600 {
+	var xyY DPT_242600
+	xyY.SetRGB(d.Red, d.Green, d.Blue)
+	return xyY
+}
+
+// SetKelvin sets the colour of a black body with the given colour temperature.
+func (d *DPT_232600) SetKelvin(kelvin float64) {
+	d.Red, d.Green, d.Blue = KelvinToRGB(kelvin)
+}
+
+func (d DPT_232600) Unit() string {
+	return ""
+}
+
+func (d DPT_232600) String() string {
+	return fmt.Sprintf("#%02x%02x%02x", d.Red, d.Green, d.Blue)
+}
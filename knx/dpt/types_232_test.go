@@ -0,0 +1,72 @@
+package dpt
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDPT_232600(t *testing.T) {
+	var dst DPT_232600
+	sources := []DPT_232600{
+		{Red: 0, Green: 0, Blue: 0},
+		{Red: 255, Green: 96, Blue: 0},
+		{Red: 18, Green: 52, Blue: 86},
+	}
+
+	for _, src := range sources {
+		buf := src.Pack()
+		if err := dst.Unpack(buf); err != nil {
+			t.Error(err)
+		}
+
+		if !reflect.DeepEqual(src, dst) {
+			t.Errorf("Value \"%s\" after pack/unpack for DPT_232600 differs. Original value was \"%v\"!", dst, src)
+		}
+	}
+
+	if err := dst.Unpack([]byte{0, 1, 2}); err == nil {
+		t.Errorf("Unpacking 3 bytes should fail")
+	}
+}
+
+func TestDPT_232600_Text(t *testing.T) {
+	var dst DPT_232600
+	if err := dst.UnmarshalText([]byte("#FF6000")); err != nil {
+		t.Fatal(err)
+	}
+
+	if dst != (DPT_232600{Red: 255, Green: 96}) || dst.String() != "#ff6000" {
+		t.Errorf("Unexpected value \"%s\"", dst)
+	}
+
+	if err := dst.UnmarshalText([]byte("orange")); err == nil {
+		t.Errorf("Expected error for a colour name")
+	}
+}
+
+func TestDPT_232600_Conversions(t *testing.T) {
+	var src DPT_232600
+	src.SetHSV(120, 1, 1)
+	if src != (DPT_232600{Green: 255}) {
+		t.Errorf("Unexpected value \"%s\" for HSV 120, 1, 1", src)
+	}
+
+	if h, s, v := src.HSV(); h != 120 || s != 1 || v != 1 {
+		t.Errorf("Unexpected HSV %v, %v, %v for \"%s\"", h, s, v, src)
+	}
+
+	src = DPT_232600{Red: 255, Green: 96, Blue: 0}
+	xyY := src.XyY()
+	if !xyY.ColorValid || !xyY.BrightnessValid {
+		t.Errorf("Converted colour %+v should be valid", xyY)
+	}
+
+	if rgb := xyY.RGB(); rgb != src {
+		t.Errorf("Value \"%s\" after conversion to xyY differs. Original value was \"%s\"!", rgb, src)
+	}
+
+	src.SetKelvin(6500)
+	if src.Red < 240 || src.Green < 240 || src.Blue < 240 {
+		t.Errorf("Unexpected value \"%s\" for 6500 K", src)
+	}
+}
@@ -0,0 +1,113 @@
+package dpt
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DPT_235001 represents DPT 235.001 / DPT_Tariff_ActiveEnergy
+// Active energy: Wh Tariff: 0-254
+// V32 U8 r6B2
+type DPT_235001 struct {
+	ActiveEnergy int32
+	Tariff       uint8
+
+	// EnergyInvalid and TariffInvalid mark the respective fields as invalid.
+	EnergyInvalid bool
+	TariffInvalid bool
+}
+
+func (d DPT_235001) Pack() []byte {
+	energy := packV32(d.ActiveEnergy)
+	flags := packB2([2]bool{d.TariffInvalid, d.EnergyInvalid})
+
+	return []byte{0, energy[1], energy[2], energy[3], energy[4], d.Tariff, flags}
+}
+
+func (d *DPT_235001) Unpack(data []byte) error {
+	if len(data) != 7 {
+		return ErrInvalidLength
+	}
+
+	if data[6] > 3 {
+		return ErrBadReservedBits
+	}
+
+	var value DPT_235001
+
+	if err := unpackV32(data[0:5], &value.ActiveEnergy); err != nil {
+		return err
+	}
+
+	value.Tariff = data[5]
+
+	if err := unpackB2(data[6], &value.TariffInvalid, &value.EnergyInvalid); err != nil {
+		return err
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d DPT_235001) Unit() string {
+	return "Wh"
+}
+
+func (d DPT_235001) String() string {
+	energy := "invalid energy"
+	if !d.EnergyInvalid {
+		energy = fmt.Sprintf("%d Wh", d.ActiveEnergy)
+	}
+
+	if d.TariffInvalid {
+		return energy + " (no tariff)"
+	}
+
+	return fmt.Sprintf("%s (tariff %d)", energy, d.Tariff)
+}
+
+func (d DPT_235001) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses the form which String produces, e.g. "1234 Wh (tariff 2)". The energy may be
+// "invalid energy", the tariff "(no tariff)" or left out, which marks it as invalid.
+func (d *DPT_235001) UnmarshalText(text []byte) error {
+	energy, tariff, found := strings.Cut(strings.TrimSpace(string(text)), "(")
+
+	value := DPT_235001{TariffInvalid: true}
+
+	if found {
+		tariff = strings.TrimSpace(strings.TrimSuffix(tariff, ")"))
+
+		if number, ok := strings.CutPrefix(tariff, "tariff "); ok {
+			if err := parseUintText([]byte(number), "", &value.Tariff); err != nil {
+				return fmt.Errorf("tariff: %w", err)
+			}
+
+			value.TariffInvalid = false
+		} else if tariff != "no tariff" {
+			return fmt.Errorf("tariff %q is neither \"tariff <number>\" nor \"no tariff\"", tariff)
+		}
+	}
+
+	if strings.TrimSpace(energy) == "invalid energy" {
+		value.EnergyInvalid = true
+	} else if err := parseIntText([]byte(energy), d.Unit(), &value.ActiveEnergy); err != nil {
+		return err
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d DPT_235001) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_235001) UnmarshalJSON(data []byte) error {
+	type plain DPT_235001
+	return unmarshalJSON(data, d, (*plain)(d))
+}
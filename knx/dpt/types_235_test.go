@@ -0,0 +1,28 @@
+package dpt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDPT_235001(t *testing.T) {
+	src := DPT_235001{ActiveEnergy: -123456, Tariff: 3}
+
+	buf := src.Pack()
+	assert.Equal(t, []byte{0, 0xFF, 0xFE, 0x1D, 0xC0, 3, 0}, buf)
+
+	var dst DPT_235001
+	assert.NoError(t, dst.Unpack(buf))
+	assert.Equal(t, src, dst)
+	assert.Equal(t, "-123456 Wh (tariff 3)", dst.String())
+
+	assert.NoError(t, dst.Unpack([]byte{0, 0, 0, 0, 0, 0, 3}))
+	assert.True(t, dst.EnergyInvalid)
+	assert.True(t, dst.TariffInvalid)
+	assert.Equal(t, "invalid energy (no tariff)", dst.String())
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 3}, dst.Pack())
+
+	assert.Equal(t, ErrBadReservedBits, dst.Unpack([]byte{0, 0, 0, 0, 0, 0, 4}))
+	assert.Equal(t, ErrInvalidLength, dst.Unpack([]byte{0, 0, 0, 0, 0, 0}))
+}
@@ -0,0 +1,75 @@
+package dpt
+
+import (
+	"fmt"
+	"unicode"
+)
+
+// DPT_24001 represents DPT 24.001 / Var String 8859-1.
+// The string is NUL-terminated and has no length limit. It ends at the first NUL character, and
+// characters which are not part of ISO-8859-1 are replaced with a space = 0x20.
+type DPT_24001 string
+
+func (d DPT_24001) Pack() []byte {
+	encoded := encodeLatin1(string(d))
+
+	buf := make([]byte, 1, len(encoded)+2)
+	buf = append(buf, encoded...)
+	buf = append(buf, 0x00)
+
+	return buf
+}
+
+// Unpack decodes the string up to the first NUL character. A missing terminator is tolerated.
+func (d *DPT_24001) Unpack(data []byte) error {
+	if len(data) < 2 {
+		return ErrInvalidLength
+	}
+
+	*d = DPT_24001(decodeLatin1(data[1:]))
+
+	return nil
+}
+
+func (d DPT_24001) Unit() string {
+	return ""
+}
+
+// IsValid checks whether the string can be transmitted without loss.
+func (d DPT_24001) IsValid() bool {
+	for _, c := range d {
+		if c > unicode.MaxLatin1 || c == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (d DPT_24001) String() string {
+	return string(d)
+}
+
+func (d DPT_24001) MarshalText() ([]byte, error) {
+	return []byte(d), nil
+}
+
+// UnmarshalText takes the text as it is. It fails unless the text is an ISO-8859-1 string without NUL characters.
+func (d *DPT_24001) UnmarshalText(text []byte) error {
+	value := DPT_24001(text)
+	if !value.IsValid() {
+		return fmt.Errorf("text %q is not an ISO-8859-1 string without NUL characters", text)
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d DPT_24001) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_24001) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*string)(d))
+}
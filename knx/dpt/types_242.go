@@ -1,8 +1,10 @@
 package dpt
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"math"
 )
 
 // DPT_242600 represents DPT 242.600 (DPT_Colour_xyY)
@@ -77,3 +79,110 @@ func (d DPT_242600) Unit() string {
 func (d DPT_242600) String() string {
 	return fmt.Sprintf("x: %d y: %d Y: %d ColorValid: %t, BrightnessValid: %t", d.X, d.Y, d.YBrightness, d.ColorValid, d.BrightnessValid)
 }
+
+func (d DPT_242600) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses either a colour in the form #RRGGBB, which is converted to xyY, or the form
+// which String produces. Fields which are left out are zero.
+func (d *DPT_242600) UnmarshalText(text []byte) error {
+	if !bytes.Contains(text, []byte(":")) {
+		components, err := parseHexColour(string(text), false)
+		if err != nil {
+			return err
+		}
+
+		d.SetRGB(components[0], components[1], components[2])
+
+		return nil
+	}
+
+	fields, err := parseFieldList(string(text))
+	if err != nil {
+		return err
+	}
+
+	var value DPT_242600
+	for name, field := range fields {
+		var err error
+
+		switch name {
+		case "x":
+			err = parseUintText([]byte(field), "", &value.X)
+		case "y":
+			err = parseUintText([]byte(field), "", &value.Y)
+		case "Y":
+			err = parseUintText([]byte(field), "", &value.YBrightness)
+		case "ColorValid":
+			err = parseFieldBool(field, &value.ColorValid)
+		case "BrightnessValid":
+			err = parseFieldBool(field, &value.BrightnessValid)
+		default:
+			return unknownFieldError(name)
+		}
+
+		if err != nil {
+			return fieldError(name, err)
+		}
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d DPT_242600) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_242600) UnmarshalJSON(data []byte) error {
+	type plain DPT_242600
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+// Chromaticity returns the coordinates x and y in the range [0, 1].
+func (d DPT_242600) Chromaticity() (x, y float64) {
+	return float64(d.X) / 65535, float64(d.Y) / 65535
+}
+
+// SetChromaticity sets the coordinates x and y, which are limited to the range [0, 1]. The colour
+// is marked as valid.
+func (d *DPT_242600) SetChromaticity(x, y float64) {
+	d.X = uint16(math.Round(math.Max(0, math.Min(1, x)) * 65535))
+	d.Y = uint16(math.Round(math.Max(0, math.Min(1, y)) * 65535))
+	d.ColorValid = true
+}
+
+// Kelvin approximates the correlated colour temperature of the colour.
+func (d DPT_242600) Kelvin() float64 {
+	return XYToKelvin(d.Chromaticity())
+}
+
+// SetKelvin sets the chromaticity of a black body with the given colour temperature.
+func (d *DPT_242600) SetKelvin(kelvin float64) {
+	d.SetChromaticity(KelvinToXY(kelvin))
+}
+
+// RGB converts the colour to sRGB. Invalid brightness is treated as full brightness.
+func (d DPT_242600) RGB() DPT_232600 {
+	x, y := d.Chromaticity()
+
+	brightness := 1.0
+	if d.BrightnessValid {
+		brightness = float64(d.YBrightness) / 255
+	}
+
+	var rgb DPT_232600
+	rgb.Red, rgb.Green, rgb.Blue = XYYToRGB(x, y, brightness)
+	return rgb
+}
+
+// SetRGB sets chromaticity and brightness from a sRGB colour. Both are marked as valid.
+func (d *DPT_242600) SetRGB(r, g, b uint8) {
+	x, y, brightness := RGBToXYY(r, g, b)
+
+	d.SetChromaticity(x, y)
+	d.YBrightness = uint8(math.Round(brightness * 255))
+	d.BrightnessValid = true
+}
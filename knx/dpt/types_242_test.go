@@ -2,6 +2,7 @@ package dpt
 
 import (
 	"fmt"
+	"math"
 	"reflect"
 	"testing"
 )
@@ -32,3 +33,27 @@ func TestDPT_242600(t *testing.T) {
 		}
 	}
 }
+
+func TestDPT_242600_Conversions(t *testing.T) {
+	var dst DPT_242600
+	if err := dst.UnmarshalText([]byte("#FFFFFF")); err != nil {
+		t.Fatal(err)
+	}
+
+	if x, y := dst.Chromaticity(); math.Abs(x-0.3127) > epsilon || math.Abs(y-0.3290) > epsilon {
+		t.Errorf("Unexpected chromaticity %v, %v for white", x, y)
+	}
+
+	if dst.YBrightness != 255 || !dst.ColorValid || !dst.BrightnessValid {
+		t.Errorf("Unexpected value \"%s\" for white", dst)
+	}
+
+	dst.SetKelvin(3000)
+	if kelvin := dst.Kelvin(); math.Abs(kelvin-3000) > 30 {
+		t.Errorf("Unexpected colour temperature %v K", kelvin)
+	}
+
+	if err := dst.UnmarshalText([]byte("#FFF")); err == nil {
+		t.Errorf("Expected error for short colour")
+	}
+}
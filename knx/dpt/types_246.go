@@ -0,0 +1,96 @@
+package dpt
+
+import (
+	"fmt"
+)
+
+// DPT_246600 represents DPT 246.600 / DPT_Battery_Info
+// Charge level: 0-255 (= 0 - 100%)
+// r5B3 U8
+type DPT_246600 struct {
+	BatteryFailure         bool
+	BatteryDurationFailure bool
+	BatteryFullyCharged    bool
+	ChargeLevel            uint8
+}
+
+func (d DPT_246600) Pack() []byte {
+	validBits := packB4([4]bool{d.BatteryFullyCharged, d.BatteryDurationFailure, d.BatteryFailure, false})
+
+	return []byte{0, validBits, d.ChargeLevel}
+}
+
+func (d *DPT_246600) Unpack(data []byte) error {
+	if len(data) != 3 {
+		return ErrInvalidLength
+	}
+
+	if data[1] > 7 {
+		return ErrBadReservedBits
+	}
+
+	*d = DPT_246600{
+		BatteryFailure:         data[1]&0x4 != 0,
+		BatteryDurationFailure: data[1]&0x2 != 0,
+		BatteryFullyCharged:    data[1]&0x1 != 0,
+		ChargeLevel:            data[2],
+	}
+
+	return nil
+}
+
+func (d DPT_246600) Unit() string {
+	return "%"
+}
+
+func (d DPT_246600) String() string {
+	return fmt.Sprintf("ChargeLevel: %.1f%% BatteryFailure: %t, BatteryDurationFailure: %t, BatteryFullyCharged: %t",
+		percentU8(d.ChargeLevel), d.BatteryFailure, d.BatteryDurationFailure, d.BatteryFullyCharged)
+}
+
+func (d DPT_246600) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses the form which String produces. Fields which are left out are zero.
+func (d *DPT_246600) UnmarshalText(text []byte) error {
+	fields, err := parseFieldList(string(text))
+	if err != nil {
+		return err
+	}
+
+	var value DPT_246600
+	for name, field := range fields {
+		var err error
+
+		switch name {
+		case "ChargeLevel":
+			err = parseFieldPercentU8(field, &value.ChargeLevel)
+		case "BatteryFailure":
+			err = parseFieldBool(field, &value.BatteryFailure)
+		case "BatteryDurationFailure":
+			err = parseFieldBool(field, &value.BatteryDurationFailure)
+		case "BatteryFullyCharged":
+			err = parseFieldBool(field, &value.BatteryFullyCharged)
+		default:
+			return unknownFieldError(name)
+		}
+
+		if err != nil {
+			return fieldError(name, err)
+		}
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d DPT_246600) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_246600) UnmarshalJSON(data []byte) error {
+	type plain DPT_246600
+	return unmarshalJSON(data, d, (*plain)(d))
+}
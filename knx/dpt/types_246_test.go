@@ -0,0 +1,33 @@
+package dpt
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDPT_246600(t *testing.T) {
+	var dst DPT_246600
+	sources := []DPT_246600{
+		{ChargeLevel: 255, BatteryFullyCharged: true},
+		{ChargeLevel: 10, BatteryFailure: true, BatteryDurationFailure: true},
+		{},
+	}
+
+	for _, src := range sources {
+		if err := dst.Unpack(src.Pack()); err != nil {
+			t.Error(err)
+		}
+
+		if !reflect.DeepEqual(src, dst) {
+			t.Errorf("Value \"%s\" after pack/unpack for DPT_246600 differs. Original value was \"%v\"!", dst, src)
+		}
+	}
+
+	if buf := sources[1].Pack(); !reflect.DeepEqual(buf, []byte{0, 0x06, 10}) {
+		t.Errorf("Unexpected data % x", buf)
+	}
+
+	if err := dst.Unpack([]byte{0, 0x08, 0}); err == nil {
+		t.Errorf("Expected error for reserved bits")
+	}
+}
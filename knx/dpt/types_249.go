@@ -0,0 +1,147 @@
+package dpt
+
+import (
+	"fmt"
+	"time"
+)
+
+// DPT_249600 represents DPT 249.600 / DPT_Brightness_Colour_Temperature_Transition
+// Time period: 0-65535 (x 100 ms) Colour temperature: 0-65535 K Brightness: 0-255 (= 0 - 100%)
+// U16 U16 U8 r5B3
+type DPT_249600 struct {
+	TimePeriod             uint16
+	ColourTemperature      uint16
+	Brightness             uint8
+	TimePeriodValid        bool
+	ColourTemperatureValid bool
+	BrightnessValid        bool
+}
+
+func (d DPT_249600) Pack() []byte {
+	validBits := packB4([4]bool{d.BrightnessValid, d.ColourTemperatureValid, d.TimePeriodValid, false})
+
+	period := packU16(d.TimePeriod)
+	temperature := packU16(d.ColourTemperature)
+
+	return []byte{0, period[1], period[2], temperature[1], temperature[2], d.Brightness, validBits}
+}
+
+func (d *DPT_249600) Unpack(data []byte) error {
+	if len(data) != 7 {
+		return ErrInvalidLength
+	}
+
+	if data[6] > 7 {
+		return ErrBadReservedBits
+	}
+
+	var period, temperature uint16
+
+	if err := unpackU16([]byte{0, data[1], data[2]}, &period); err != nil {
+		return err
+	}
+
+	if err := unpackU16([]byte{0, data[3], data[4]}, &temperature); err != nil {
+		return err
+	}
+
+	*d = DPT_249600{
+		TimePeriod:             period,
+		ColourTemperature:      temperature,
+		Brightness:             data[5],
+		TimePeriodValid:        data[6]&0x4 != 0,
+		ColourTemperatureValid: data[6]&0x2 != 0,
+		BrightnessValid:        data[6]&0x1 != 0,
+	}
+
+	return nil
+}
+
+// Duration returns the time period of the transition.
+func (d DPT_249600) Duration() time.Duration {
+	return time.Duration(d.TimePeriod) * 100 * time.Millisecond
+}
+
+// SetDuration sets the time period of the transition, which is rounded to multiples of 100 ms and
+// limited to 6553.5 s. The time period is marked as valid.
+func (d *DPT_249600) SetDuration(duration time.Duration) {
+	periods := (duration + 50*time.Millisecond) / (100 * time.Millisecond)
+
+	switch {
+	case periods < 0:
+		periods = 0
+	case periods > 65535:
+		periods = 65535
+	}
+
+	d.TimePeriod = uint16(periods)
+	d.TimePeriodValid = true
+}
+
+func (d DPT_249600) Unit() string {
+	return ""
+}
+
+func (d DPT_249600) String() string {
+	return fmt.Sprintf("Brightness: %d ColourTemperature: %d K TimePeriod: %s BrightnessValid: %t, ColourTemperatureValid: %t, TimePeriodValid: %t",
+		d.Brightness, d.ColourTemperature, d.Duration(), d.BrightnessValid, d.ColourTemperatureValid, d.TimePeriodValid)
+}
+
+func (d DPT_249600) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses the form which String produces. The time period is a duration like "1.5s",
+// which is rounded to multiples of 100 ms. Fields which are left out are zero.
+func (d *DPT_249600) UnmarshalText(text []byte) error {
+	fields, err := parseFieldList(string(text))
+	if err != nil {
+		return err
+	}
+
+	var value DPT_249600
+	for name, field := range fields {
+		var err error
+
+		switch name {
+		case "Brightness":
+			err = parseUintText([]byte(field), "", &value.Brightness)
+		case "ColourTemperature":
+			err = parseUintText([]byte(field), "K", &value.ColourTemperature)
+		case "TimePeriod":
+			var duration time.Duration
+			if duration, err = time.ParseDuration(field); err == nil {
+				if duration < 0 || duration > 65535*100*time.Millisecond {
+					err = fmt.Errorf("duration %q is not between 0s and 1h49m13.5s", field)
+				}
+
+				value.TimePeriod = uint16((duration + 50*time.Millisecond) / (100 * time.Millisecond))
+			}
+		case "BrightnessValid":
+			err = parseFieldBool(field, &value.BrightnessValid)
+		case "ColourTemperatureValid":
+			err = parseFieldBool(field, &value.ColourTemperatureValid)
+		case "TimePeriodValid":
+			err = parseFieldBool(field, &value.TimePeriodValid)
+		default:
+			return unknownFieldError(name)
+		}
+
+		if err != nil {
+			return fieldError(name, err)
+		}
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d DPT_249600) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_249600) UnmarshalJSON(data []byte) error {
+	type plain DPT_249600
+	return unmarshalJSON(data, d, (*plain)(d))
+}
@@ -0,0 +1,53 @@
+package dpt
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDPT_249600(t *testing.T) {
+	var dst DPT_249600
+	sources := []DPT_249600{
+		{TimePeriod: 50, ColourTemperature: 2700, Brightness: 255, TimePeriodValid: true, ColourTemperatureValid: true, BrightnessValid: true},
+		{TimePeriod: 65535, ColourTemperature: 6500, Brightness: 0, ColourTemperatureValid: true},
+		{TimePeriod: 0, ColourTemperature: 0, Brightness: 128, TimePeriodValid: true, BrightnessValid: true},
+	}
+
+	for _, src := range sources {
+		buf := src.Pack()
+		if err := dst.Unpack(buf); err != nil {
+			t.Error(err)
+		}
+
+		if !reflect.DeepEqual(src, dst) {
+			t.Errorf("Value \"%s\" after pack/unpack for DPT_249600 differs. Original value was \"%v\"!", dst, src)
+		}
+	}
+
+	if buf := sources[0].Pack(); buf[6] != 0x07 || buf[1] != 0 || buf[2] != 50 || buf[3] != 0x0a || buf[4] != 0x8c {
+		t.Errorf("Unexpected data % x", buf)
+	}
+
+	if err := dst.Unpack([]byte{0, 0, 0, 0, 0, 0, 0x08}); err == nil {
+		t.Errorf("Expected error for reserved bits")
+	}
+
+	if err := dst.Unpack([]byte{0, 0, 0, 0, 0, 0}); err == nil {
+		t.Errorf("Expected error for invalid length")
+	}
+}
+
+func TestDPT_249600_Duration(t *testing.T) {
+	var dst DPT_249600
+	dst.SetDuration(2540 * time.Millisecond)
+
+	if dst.TimePeriod != 25 || !dst.TimePeriodValid || dst.Duration() != 2500*time.Millisecond {
+		t.Errorf("Unexpected value \"%s\"", dst)
+	}
+
+	dst.SetDuration(time.Hour * 2)
+	if dst.TimePeriod != 65535 {
+		t.Errorf("Unexpected value \"%s\"", dst)
+	}
+}
@@ -0,0 +1,63 @@
+package dpt
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// Test DPT 24.001 with special inputs
+func TestDPT_24001(t *testing.T) {
+	var dst DPT_24001
+
+	tests := []struct {
+		src      DPT_24001
+		data     []byte
+		expected DPT_24001
+	}{
+		{"KNX is OK", []byte{0, 'K', 'N', 'X', ' ', 'i', 's', ' ', 'O', 'K', 0}, "KNX is OK"},
+		{"", []byte{0, 0}, ""},
+		{"Grüße, Señor", []byte{0, 'G', 'r', 0xfc, 0xdf, 'e', ',', ' ', 'S', 'e', 0xf1, 'o', 'r', 0}, "Grüße, Señor"},
+		{"½°C €", []byte{0, 0xbd, 0xb0, 'C', ' ', ' ', 0}, "½°C  "},
+		{"hello你好", []byte{0, 'h', 'e', 'l', 'l', 'o', ' ', ' ', 0}, "hello  "},
+		{"cut\x00off", []byte{0, 'c', 'u', 't', 0}, "cut"},
+	}
+
+	for _, test := range tests {
+		buf := test.src.Pack()
+		if !reflect.DeepEqual(buf, test.data) {
+			t.Errorf("Error packing [%s] => [% x], want [% x]", test.src, buf, test.data)
+		}
+
+		if err := dst.Unpack(buf); err != nil {
+			t.Errorf("Error unpacking [% x]: %v", buf, err)
+		}
+
+		if dst != test.expected {
+			t.Errorf("Unexpected value [%s] => [%s], want [%s]", test.src, dst, test.expected)
+		}
+
+		if test.src.IsValid() != (test.src == test.expected) {
+			t.Errorf("Unexpected validity of [%s]", test.src)
+		}
+	}
+
+	// Test with a very long string
+	src := DPT_24001(strings.Repeat("|äöü0123456789", 50))
+	if err := dst.Unpack(src.Pack()); err != nil || dst != src {
+		t.Errorf("Error comparing long string: %v", err)
+	}
+
+	// Data after the terminator is ignored and a missing terminator is tolerated.
+	if err := dst.Unpack([]byte{0, 'a', 'b', 0, 'c'}); err != nil || dst != "ab" {
+		t.Errorf("Unexpected value [%s]: %v", dst, err)
+	}
+
+	if err := dst.Unpack([]byte{0, 'a', 0xe9}); err != nil || dst != "aé" {
+		t.Errorf("Unexpected value [%s]: %v", dst, err)
+	}
+
+	if err := dst.Unpack([]byte{0}); err == nil {
+		t.Errorf("Expected error for missing string")
+	}
+}
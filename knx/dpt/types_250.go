@@ -0,0 +1,117 @@
+package dpt
+
+import (
+	"fmt"
+)
+
+// DPT_250600 represents DPT 250.600 / DPT_Brightness_Colour_Temperature_Control
+// Relative control of colour temperature and brightness, each like DPT 3.007.
+// r4B1U3 r4B1U3 r6B2
+type DPT_250600 struct {
+	// ColourTemperature increases or decreases the colour temperature.
+	ColourTemperature      DPT_3007
+	Brightness             DPT_3007
+	ColourTemperatureValid bool
+	BrightnessValid        bool
+}
+
+func (d DPT_250600) Pack() []byte {
+	validBits := packB2([2]bool{d.BrightnessValid, d.ColourTemperatureValid})
+
+	return []byte{
+		0,
+		packB1U3(d.ColourTemperature.Increase, d.ColourTemperature.StepCode),
+		packB1U3(d.Brightness.Increase, d.Brightness.StepCode),
+		validBits,
+	}
+}
+
+func (d *DPT_250600) Unpack(data []byte) error {
+	if len(data) != 4 {
+		return ErrInvalidLength
+	}
+
+	if data[1] > 15 || data[2] > 15 || data[3] > 3 {
+		return ErrBadReservedBits
+	}
+
+	var value DPT_250600
+
+	if err := value.ColourTemperature.Unpack(data[1:2]); err != nil {
+		return err
+	}
+
+	if err := value.Brightness.Unpack(data[2:3]); err != nil {
+		return err
+	}
+
+	if err := unpackB2(data[3], &value.BrightnessValid, &value.ColourTemperatureValid); err != nil {
+		return err
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d DPT_250600) Unit() string {
+	return ""
+}
+
+// IsValid checks the step codes of both controls.
+func (d DPT_250600) IsValid() bool {
+	return d.ColourTemperature.IsValid() && d.Brightness.IsValid()
+}
+
+func (d DPT_250600) String() string {
+	return fmt.Sprintf("ColourTemperature: %s Brightness: %s ColourTemperatureValid: %t, BrightnessValid: %t",
+		d.ColourTemperature, d.Brightness, d.ColourTemperatureValid, d.BrightnessValid)
+}
+
+func (d DPT_250600) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses the form which String produces, the controls like DPT 3.007, e.g.
+// "ColourTemperature: Increase 2 Brightness: Decrease break". Fields which are left out are zero.
+func (d *DPT_250600) UnmarshalText(text []byte) error {
+	fields, err := parseFieldList(string(text))
+	if err != nil {
+		return err
+	}
+
+	var value DPT_250600
+	for name, field := range fields {
+		var err error
+
+		switch name {
+		case "ColourTemperature":
+			err = value.ColourTemperature.UnmarshalText([]byte(field))
+		case "Brightness":
+			err = value.Brightness.UnmarshalText([]byte(field))
+		case "ColourTemperatureValid":
+			err = parseFieldBool(field, &value.ColourTemperatureValid)
+		case "BrightnessValid":
+			err = parseFieldBool(field, &value.BrightnessValid)
+		default:
+			return unknownFieldError(name)
+		}
+
+		if err != nil {
+			return fieldError(name, err)
+		}
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d DPT_250600) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_250600) UnmarshalJSON(data []byte) error {
+	type plain DPT_250600
+	return unmarshalJSON(data, d, (*plain)(d))
+}
@@ -0,0 +1,34 @@
+package dpt
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDPT_250600(t *testing.T) {
+	var dst DPT_250600
+	sources := []DPT_250600{
+		{ColourTemperature: DPT_3007{Increase: true, StepCode: 3}, Brightness: DPT_3007{StepCode: 1}, ColourTemperatureValid: true, BrightnessValid: true},
+		{ColourTemperature: DPT_3007{StepCode: 7}, ColourTemperatureValid: true},
+		{Brightness: DPT_3007{Increase: true, StepCode: 0}, BrightnessValid: true},
+	}
+
+	for _, src := range sources {
+		buf := src.Pack()
+		if err := dst.Unpack(buf); err != nil {
+			t.Error(err)
+		}
+
+		if !reflect.DeepEqual(src, dst) {
+			t.Errorf("Value \"%s\" after pack/unpack for DPT_250600 differs. Original value was \"%v\"!", dst, src)
+		}
+	}
+
+	if buf := sources[0].Pack(); !reflect.DeepEqual(buf, []byte{0, 0x0b, 0x01, 0x03}) {
+		t.Errorf("Unexpected data % x", buf)
+	}
+
+	if err := dst.Unpack([]byte{0, 0x10, 0, 0}); err == nil {
+		t.Errorf("Expected error for reserved bits")
+	}
+}
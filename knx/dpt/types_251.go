@@ -1,6 +1,7 @@
 package dpt
 
 import (
+	"bytes"
 	"fmt"
 )
 
@@ -57,3 +58,88 @@ func (d DPT_251600) Unit() string {
 func (d DPT_251600) String() string {
 	return fmt.Sprintf("Red: %d Green: %d Blue: %d White: %d RedValid: %t, GreenValid: %t, BlueValid: %t, WhiteValid: %t", d.Red, d.Green, d.Blue, d.White, d.RedValid, d.GreenValid, d.BlueValid, d.WhiteValid)
 }
+
+func (d DPT_251600) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses a colour in the form #RRGGBB or #RRGGBBWW, only the given components are
+// marked as valid then. The form which String produces is accepted as well, fields which are left
+// out are zero.
+func (d *DPT_251600) UnmarshalText(text []byte) error {
+	if bytes.Contains(text, []byte(":")) {
+		return d.unmarshalFields(string(text))
+	}
+
+	components, err := parseHexColour(string(text), true)
+	if err != nil {
+		return err
+	}
+
+	*d = DPT_251600{
+		Red:        components[0],
+		Green:      components[1],
+		Blue:       components[2],
+		RedValid:   true,
+		GreenValid: true,
+		BlueValid:  true,
+	}
+
+	if len(components) == 4 {
+		d.White = components[3]
+		d.WhiteValid = true
+	}
+
+	return nil
+}
+
+func (d DPT_251600) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_251600) UnmarshalJSON(data []byte) error {
+	type plain DPT_251600
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+// unmarshalFields parses the form which String produces.
+func (d *DPT_251600) unmarshalFields(text string) error {
+	fields, err := parseFieldList(text)
+	if err != nil {
+		return err
+	}
+
+	var value DPT_251600
+	for name, field := range fields {
+		var err error
+
+		switch name {
+		case "Red":
+			err = parseUintText([]byte(field), "", &value.Red)
+		case "Green":
+			err = parseUintText([]byte(field), "", &value.Green)
+		case "Blue":
+			err = parseUintText([]byte(field), "", &value.Blue)
+		case "White":
+			err = parseUintText([]byte(field), "", &value.White)
+		case "RedValid":
+			err = parseFieldBool(field, &value.RedValid)
+		case "GreenValid":
+			err = parseFieldBool(field, &value.GreenValid)
+		case "BlueValid":
+			err = parseFieldBool(field, &value.BlueValid)
+		case "WhiteValid":
+			err = parseFieldBool(field, &value.WhiteValid)
+		default:
+			return unknownFieldError(name)
+		}
+
+		if err != nil {
+			return fieldError(name, err)
+		}
+	}
+
+	*d = value
+
+	return nil
+}
@@ -30,3 +30,24 @@ func TestDPT_251600(t *testing.T) {
 		}
 	}
 }
+
+func TestDPT_251600_Text(t *testing.T) {
+	var dst DPT_251600
+	if err := dst.UnmarshalText([]byte("#FF6000")); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := DPT_251600{Red: 255, Green: 96, RedValid: true, GreenValid: true, BlueValid: true}
+	if dst != expected {
+		t.Errorf("Unexpected value \"%s\", want \"%s\"", dst, expected)
+	}
+
+	if err := dst.UnmarshalText([]byte("#FF600012")); err != nil {
+		t.Fatal(err)
+	}
+
+	expected.White, expected.WhiteValid = 0x12, true
+	if dst != expected {
+		t.Errorf("Unexpected value \"%s\", want \"%s\"", dst, expected)
+	}
+}
@@ -0,0 +1,102 @@
+package dpt
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DPT_26001 represents DPT 26.001 / DPT_SceneInfo.
+// r1B1U6
+type DPT_26001 struct {
+	// Scene number, 0 - 63.
+	Scene uint8
+	// Inactive indicates that the scene is not active.
+	Inactive bool
+}
+
+func (d DPT_26001) Pack() []byte {
+	return packU8(packB1(d.Inactive)<<6 | d.Scene&0x3F)
+}
+
+func (d *DPT_26001) Unpack(data []byte) error {
+	var value uint8
+	if err := unpackU8(data, &value); err != nil {
+		return err
+	}
+
+	if value&0x80 != 0 {
+		return ErrBadReservedBits
+	}
+
+	*d = DPT_26001{
+		Scene:    value & 0x3F,
+		Inactive: value&0x40 != 0,
+	}
+
+	return nil
+}
+
+func (d DPT_26001) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses the scene number, optionally followed by "active" or "inactive", e.g.
+// "5 inactive".
+func (d *DPT_26001) UnmarshalText(text []byte) error {
+	fields := strings.Fields(strings.ToLower(string(text)))
+	if len(fields) > 0 && fields[0] == "scene" {
+		fields = fields[1:]
+	}
+
+	if len(fields) < 1 || len(fields) > 2 {
+		return fmt.Errorf("scene info %q is not in the form \"<scene> [active|inactive]\"", text)
+	}
+
+	scene, err := strconv.ParseUint(fields[0], 10, 8)
+	if err != nil || scene > 63 {
+		return fmt.Errorf("scene %q is not between 0 and 63", fields[0])
+	}
+
+	value := DPT_26001{Scene: uint8(scene)}
+
+	if len(fields) == 2 {
+		switch fields[1] {
+		case "active":
+		case "inactive":
+			value.Inactive = true
+		default:
+			return fmt.Errorf("scene state %q is neither \"active\" nor \"inactive\"", fields[1])
+		}
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d DPT_26001) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_26001) UnmarshalJSON(data []byte) error {
+	type plain DPT_26001
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+func (d DPT_26001) Unit() string {
+	return ""
+}
+
+// IsValid checks that the scene number fits into its six bits.
+func (d DPT_26001) IsValid() bool {
+	return d.Scene <= 63
+}
+
+func (d DPT_26001) String() string {
+	if d.Inactive {
+		return fmt.Sprintf("Scene %d inactive", d.Scene)
+	}
+
+	return fmt.Sprintf("Scene %d active", d.Scene)
+}
@@ -0,0 +1,39 @@
+package dpt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDPT_26001(t *testing.T) {
+	knxValue := []byte{0, 0x45}
+	dptValue := DPT_26001{Scene: 5, Inactive: true}
+
+	var tmpDPT DPT_26001
+	assert.NoError(t, tmpDPT.Unpack(knxValue))
+	assert.Equal(t, dptValue, tmpDPT)
+
+	assert.Equal(t, knxValue, dptValue.Pack())
+
+	assert.Equal(t, "Scene 5 inactive", dptValue.String())
+	assert.Equal(t, "Scene 63 active", DPT_26001{Scene: 63}.String())
+
+	assert.ErrorIs(t, tmpDPT.Unpack([]byte{0, 0x80}), ErrBadReservedBits)
+}
+
+func TestDPT_26001_Text(t *testing.T) {
+	var dpv DPT_26001
+	for text, expected := range map[string]DPT_26001{
+		"5 inactive":      {Scene: 5, Inactive: true},
+		"Scene 12 active": {Scene: 12},
+		"63":              {Scene: 63},
+	} {
+		assert.NoError(t, dpv.UnmarshalText([]byte(text)), text)
+		assert.Equal(t, expected, dpv, text)
+	}
+
+	for _, text := range []string{"", "64", "5 paused", "5 active now"} {
+		assert.Error(t, dpv.UnmarshalText([]byte(text)), text)
+	}
+}
@@ -0,0 +1,118 @@
+package dpt
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DPT_27001 represents DPT 27.001 / DPT_CombinedInfoOnOff.
+// The state of output n is stored at index n-1. It is only meaningful if the output is marked as
+// valid.
+// B16 B16
+type DPT_27001 struct {
+	On    [16]bool
+	Valid [16]bool
+}
+
+func (d DPT_27001) Pack() []byte {
+	var mask, states uint32
+	for i := range d.On {
+		if d.Valid[i] {
+			mask |= 1 << i
+		}
+
+		if d.On[i] {
+			states |= 1 << i
+		}
+	}
+
+	return packU32(mask<<16 | states)
+}
+
+func (d *DPT_27001) Unpack(data []byte) error {
+	var bits uint32
+	if err := unpackU32(data, &bits); err != nil {
+		return err
+	}
+
+	var value DPT_27001
+	for i := range value.On {
+		value.On[i] = bits&(1<<i) != 0
+		value.Valid[i] = bits&(1<<(i+16)) != 0
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d DPT_27001) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses the states of the valid outputs in the form "1:on 2:off", separated by
+// commas or white space. All other outputs are marked as invalid, "None" invalidates all outputs.
+func (d *DPT_27001) UnmarshalText(text []byte) error {
+	fields := strings.FieldsFunc(string(text), func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	})
+
+	var value DPT_27001
+	for _, field := range fields {
+		if strings.EqualFold(field, "none") {
+			continue
+		}
+
+		output, state, found := strings.Cut(field, ":")
+		if !found {
+			return fmt.Errorf("output state %q is not in the form \"<output>:<on|off>\"", field)
+		}
+
+		n, err := strconv.ParseUint(output, 10, 8)
+		if err != nil || n < 1 || n > 16 {
+			return fmt.Errorf("output %q is not between 1 and 16", output)
+		}
+
+		on, err := parseBinary(state, DPT_1001(false), DPT_1001(true))
+		if err != nil {
+			return err
+		}
+
+		value.On[n-1] = on
+		value.Valid[n-1] = true
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d DPT_27001) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_27001) UnmarshalJSON(data []byte) error {
+	type plain DPT_27001
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+func (d DPT_27001) Unit() string {
+	return ""
+}
+
+// String lists the states of the valid outputs, e.g. "1:On 2:Off".
+func (d DPT_27001) String() string {
+	var states []string
+	for i := range d.On {
+		if d.Valid[i] {
+			states = append(states, fmt.Sprintf("%d:%s", i+1, DPT_1001(d.On[i])))
+		}
+	}
+
+	if len(states) == 0 {
+		return "None"
+	}
+
+	return strings.Join(states, " ")
+}
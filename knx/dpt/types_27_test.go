@@ -0,0 +1,43 @@
+package dpt
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDPT_27001(t *testing.T) {
+	knxValue := []byte{0, 0x80, 0x03, 0x80, 0x01}
+
+	var dptValue DPT_27001
+	dptValue.Valid[0], dptValue.On[0] = true, true
+	dptValue.Valid[1] = true
+	dptValue.Valid[15], dptValue.On[15] = true, true
+
+	var tmpDPT DPT_27001
+	assert.NoError(t, tmpDPT.Unpack(knxValue))
+	assert.Equal(t, dptValue, tmpDPT)
+
+	assert.Equal(t, knxValue, dptValue.Pack())
+
+	assert.Equal(t, "1:On 2:Off 16:On", dptValue.String())
+	assert.Equal(t, "None", DPT_27001{}.String())
+
+	var parsed DPT_27001
+	assert.NoError(t, parsed.UnmarshalText([]byte("1:on, 2:off 16:1")))
+	assert.Equal(t, dptValue, parsed)
+
+	for _, text := range []string{"0:on", "17:off", "1", "1:maybe"} {
+		assert.Error(t, parsed.UnmarshalText([]byte(text)), text)
+	}
+
+	data, err := json.Marshal(dptValue)
+	assert.NoError(t, err)
+
+	var dst DPT_27001
+	assert.NoError(t, json.Unmarshal(data, &dst))
+	assert.Equal(t, dptValue, dst)
+
+	assert.ErrorIs(t, tmpDPT.Unpack([]byte{0, 0x80, 0x03}), ErrInvalidLength)
+}
@@ -3,27 +3,53 @@
 
 package dpt
 
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
 // DPT_28001 represents DPT 28.001 / Var String UTF-8.
+// The string is NUL-terminated and has no length limit. It ends at the first NUL character.
 type DPT_28001 string
 
 func (d DPT_28001) Pack() []byte {
-	// len(d) is gives us the number of bytes in d
-	var buf = make([]byte, 1, len(d)+2)
+	s := string(d)
+	if i := strings.IndexByte(s, 0); i >= 0 {
+		s = s[:i]
+	}
+
+	// len(s) is gives us the number of bytes in s
+	var buf = make([]byte, 1, len(s)+2)
 
-	buf = append(buf, d...)
+	buf = append(buf, s...)
 	buf = append(buf, 0x00)
 
 	return buf
 }
 
+// Unpack decodes the string up to the first NUL character. A missing terminator is tolerated.
 func (d *DPT_28001) Unpack(data []byte) error {
-	var buf = data[1 : len(data)-1]
+	if len(data) < 2 {
+		return ErrInvalidLength
+	}
+
+	var buf = data[1:]
+	if i := bytes.IndexByte(buf, 0); i >= 0 {
+		buf = buf[:i]
+	}
 
 	*d = DPT_28001(buf)
 
 	return nil
 }
 
+// IsValid checks whether the string is valid UTF-8 and can be transmitted without loss.
+func (d DPT_28001) IsValid() bool {
+	return utf8.ValidString(string(d)) && !strings.ContainsRune(string(d), 0)
+}
+
 func (d DPT_28001) Unit() string {
 	return ""
 }
@@ -31,3 +57,27 @@ func (d DPT_28001) Unit() string {
 func (d DPT_28001) String() string {
 	return string(d)
 }
+
+func (d DPT_28001) MarshalText() ([]byte, error) {
+	return []byte(d), nil
+}
+
+// UnmarshalText takes the text as it is. It fails unless the text is a UTF-8 string without NUL characters.
+func (d *DPT_28001) UnmarshalText(text []byte) error {
+	value := DPT_28001(text)
+	if !value.IsValid() {
+		return fmt.Errorf("text %q is not a UTF-8 string without NUL characters", text)
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d DPT_28001) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_28001) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*string)(d))
+}
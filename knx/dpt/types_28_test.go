@@ -83,3 +83,37 @@ func TestDPT_28001(t *testing.T) {
 		t.Errorf("Lost or gained something [%s] => [%s]", src, dst)
 	}
 }
+
+// Test the NUL termination of DPT 28.001
+func TestDPT_28001_Termination(t *testing.T) {
+	var dst DPT_28001
+
+	src := DPT_28001("cut\x00off")
+	if src.IsValid() {
+		t.Errorf("Embedded NUL should be invalid")
+	}
+
+	buf := src.Pack()
+	if fmt.Sprintf("%x", buf) != "0063757400" {
+		t.Errorf("Error packing [%s] => [%x]", src, buf)
+	}
+
+	// Data after the terminator is ignored and a missing terminator is tolerated.
+	if err := dst.Unpack([]byte{0, 'a', 0xc3, 0xa4, 0, 'b'}); err != nil || dst != "aä" {
+		t.Errorf("Unexpected value [%s]: %v", dst, err)
+	}
+
+	if err := dst.Unpack([]byte{0, 'a', 'b'}); err != nil || dst != "ab" {
+		t.Errorf("Unexpected value [%s]: %v", dst, err)
+	}
+
+	for _, data := range [][]byte{nil, {0}} {
+		if err := dst.Unpack(data); err == nil {
+			t.Errorf("Expected error for [%x]", data)
+		}
+	}
+
+	if DPT_28001("\xff").IsValid() {
+		t.Errorf("Invalid UTF-8 should be invalid")
+	}
+}
@@ -0,0 +1,134 @@
+package dpt
+
+import (
+	"fmt"
+)
+
+// DPT_29010 represents DPT 29.010 / active energy (Wh).
+type DPT_29010 int64
+
+func (d DPT_29010) Pack() []byte {
+	return packV64(int64(d))
+}
+
+func (d *DPT_29010) Unpack(data []byte) error {
+	return unpackV64(data, (*int64)(d))
+}
+
+func (d DPT_29010) Unit() string {
+	return "Wh"
+}
+
+func (d DPT_29010) String() string {
+	return fmt.Sprintf("%d Wh", int64(d))
+}
+
+func (d DPT_29010) MarshalText() ([]byte, error) {
+	return formatIntText(int64(d), d.Unit()), nil
+}
+
+func (d *DPT_29010) UnmarshalText(text []byte) error {
+	return parseIntText(text, d.Unit(), (*int64)(d))
+}
+
+func (d DPT_29010) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_29010) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*int64)(d))
+}
+
+func (d DPT_29010) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_29010) SetFloat64(value float64) error {
+	return setInteger(d, value, (*int64)(d))
+}
+
+// DPT_29011 represents DPT 29.011 / apparent energy (VAh).
+type DPT_29011 int64
+
+func (d DPT_29011) Pack() []byte {
+	return packV64(int64(d))
+}
+
+func (d *DPT_29011) Unpack(data []byte) error {
+	return unpackV64(data, (*int64)(d))
+}
+
+func (d DPT_29011) Unit() string {
+	return "VAh"
+}
+
+func (d DPT_29011) String() string {
+	return fmt.Sprintf("%d VAh", int64(d))
+}
+
+func (d DPT_29011) MarshalText() ([]byte, error) {
+	return formatIntText(int64(d), d.Unit()), nil
+}
+
+func (d *DPT_29011) UnmarshalText(text []byte) error {
+	return parseIntText(text, d.Unit(), (*int64)(d))
+}
+
+func (d DPT_29011) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_29011) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*int64)(d))
+}
+
+func (d DPT_29011) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_29011) SetFloat64(value float64) error {
+	return setInteger(d, value, (*int64)(d))
+}
+
+// DPT_29012 represents DPT 29.012 / reactive energy (VARh).
+type DPT_29012 int64
+
+func (d DPT_29012) Pack() []byte {
+	return packV64(int64(d))
+}
+
+func (d *DPT_29012) Unpack(data []byte) error {
+	return unpackV64(data, (*int64)(d))
+}
+
+func (d DPT_29012) Unit() string {
+	return "VARh"
+}
+
+func (d DPT_29012) String() string {
+	return fmt.Sprintf("%d VARh", int64(d))
+}
+
+func (d DPT_29012) MarshalText() ([]byte, error) {
+	return formatIntText(int64(d), d.Unit()), nil
+}
+
+func (d *DPT_29012) UnmarshalText(text []byte) error {
+	return parseIntText(text, d.Unit(), (*int64)(d))
+}
+
+func (d DPT_29012) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_29012) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*int64)(d))
+}
+
+func (d DPT_29012) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_29012) SetFloat64(value float64) error {
+	return setInteger(d, value, (*int64)(d))
+}
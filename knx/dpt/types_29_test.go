@@ -0,0 +1,75 @@
+package dpt
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// Test DPT 29.010 (active energy)
+func TestDPT_29010(t *testing.T) {
+	var buf []byte
+	var src, dst DPT_29010
+
+	values := []int64{math.MinInt64, -1, 0, 1<<53 + 1, math.MaxInt64}
+	for i := 1; i <= 10; i++ {
+		values = append(values, rand.Int63(), -rand.Int63())
+	}
+
+	for _, value := range values {
+		src = DPT_29010(value)
+		buf = src.Pack()
+		if len(buf) != 9 {
+			t.Errorf("Value \"%s\" packed to wrong length %d", src, len(buf))
+		}
+		dst.Unpack(buf)
+		if int64(dst) != value {
+			t.Errorf("Wrong value \"%s\" after pack/unpack! Original value was \"%v\".", dst, value)
+		}
+	}
+
+	if err := dst.Unpack(make([]byte, 5)); err == nil {
+		t.Errorf("Unpacking 5 bytes should fail")
+	}
+}
+
+// Test that DPT 29.xxx values survive JSON and their string form without rounding
+func TestDPT_29xxx_JSON(t *testing.T) {
+	units := map[string]string{"29.010": "Wh", "29.011": "VAh", "29.012": "VARh"}
+
+	for _, name := range []string{"29.010", "29.011", "29.012"} {
+		for _, value := range []int64{math.MinInt64, 1<<53 + 1, math.MaxInt64} {
+			literal := fmt.Sprint(value)
+			description := fmt.Sprintf(`{"value":%s,"unit":"%s","text":"%s %s"}`, literal, units[name], literal, units[name])
+
+			for _, input := range []string{literal, `"` + literal + `"`, description} {
+				dv, _ := Produce(name)
+				if err := json.Unmarshal([]byte(input), dv); err != nil {
+					t.Fatalf("Unmarshalling %s into %s failed: %v", input, name, err)
+				}
+
+				data, err := json.Marshal(dv)
+				if err != nil {
+					t.Fatalf("Marshalling \"%s\" failed: %v", dv, err)
+				}
+
+				if string(data) != description {
+					t.Errorf("Value \"%s\" marshalled to %s, want %s", dv, data, description)
+				}
+
+				if fmt.Sprint(dv) != literal+" "+dv.(DatapointMeta).Unit() {
+					t.Errorf("Wrong string \"%s\" for %s", dv, literal)
+				}
+			}
+		}
+	}
+
+	var dst DPT_29011
+	for _, literal := range []string{`1.5`, `1e3`, `"9223372036854775808"`, `true`} {
+		if err := json.Unmarshal([]byte(literal), &dst); err == nil {
+			t.Errorf("Unmarshalling %s should fail, got \"%s\"", literal, dst)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package dpt
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Test DPT 2.xxx (B₂)
+func TestDPT_2(t *testing.T) {
+	type DPT2 struct {
+		Dpv     DatapointValue
+		OnFalse string
+		OnTrue  string
+	}
+
+	types_2 := []DPT2{
+		{new(DPT_2001), "Control Off", "Control On"},
+		{new(DPT_2002), "Control False", "Control True"},
+		{new(DPT_2003), "Control Disable", "Control Enable"},
+		{new(DPT_2004), "Control No ramp", "Control Ramp"},
+		{new(DPT_2005), "Control No alarm", "Control Alarm"},
+		{new(DPT_2006), "Control Low", "Control High"},
+		{new(DPT_2007), "Control Decrease", "Control Increase"},
+		{new(DPT_2008), "Control Up", "Control Down"},
+		{new(DPT_2009), "Control Open", "Control Close"},
+		{new(DPT_2010), "Control Stop", "Control Start"},
+		{new(DPT_2011), "Control Inactive", "Control Active"},
+		{new(DPT_2012), "Control Not inverted", "Control Inverted"},
+	}
+
+	for _, e := range types_2 {
+		if fmt.Sprint(e.Dpv) != "No control" {
+			t.Errorf("%#v has wrong default value [%v]. Should be [No control].", e.Dpv, e.Dpv)
+		}
+
+		for data, expected := range map[byte]string{
+			0x0: "No control", 0x1: "No control", 0x2: e.OnFalse, 0x3: e.OnTrue,
+		} {
+			if err := e.Dpv.Unpack([]byte{data}); err != nil {
+				t.Errorf("%#v could not unpack %#x: %v", e.Dpv, data, err)
+			}
+
+			if fmt.Sprint(e.Dpv) != expected {
+				t.Errorf("%#v has wrong value [%v] for %#x. Should be [%s].", e.Dpv, e.Dpv, data, expected)
+			}
+
+			if packed := e.Dpv.Pack(); len(packed) != 1 || packed[0] != data {
+				t.Errorf("%#v packed to %v, want %#x.", e.Dpv, packed, data)
+			}
+		}
+	}
+}
+
+func TestDPT_2008_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		in   string
+		want DPT_2008
+	}{
+		{"down", DPT_2008{Control: true, Value: true}},
+		{"Control Up", DPT_2008{Control: true, Value: false}},
+		{"control 1", DPT_2008{Control: true, Value: true}},
+		{"no control", DPT_2008{}},
+		{"No control down", DPT_2008{Control: false, Value: true}},
+	}
+
+	for _, tc := range tests {
+		var d DPT_2008
+		if err := d.UnmarshalText([]byte(tc.in)); err != nil {
+			t.Errorf("Unexpected error for %q: %v", tc.in, err)
+		} else if d != tc.want {
+			t.Errorf("Wrong value %+v for %q, want %+v", d, tc.in, tc.want)
+		}
+	}
+
+	for _, in := range []string{"", "control", "sideways", "no control sideways"} {
+		var d DPT_2008
+		if err := d.UnmarshalText([]byte(in)); err == nil {
+			t.Errorf("Expected error for %q, got %+v", in, d)
+		}
+	}
+}
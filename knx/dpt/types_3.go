@@ -0,0 +1,171 @@
+package dpt
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// stepIntervals returns the number of intervals which the step code divides the range into. A step
+// code of 0 stops the movement, the result is 0 then.
+func stepIntervals(stepCode uint8) uint8 {
+	stepCode &= 0x7
+	if stepCode == 0 {
+		return 0
+	}
+
+	return 1 << (stepCode - 1)
+}
+
+// stepString formats a B1U3 value, e.g. "Up 4" or "Down break".
+func stepString(direction string, stepCode uint8) string {
+	if stepCode&0x7 == 0 {
+		return direction + " break"
+	}
+
+	return fmt.Sprintf("%s %d", direction, stepCode&0x7)
+}
+
+// parseStep parses a B1U3 value in the form "<direction> <step code>", where the step code is
+// either a number from 0 to 7 or "break".
+func parseStep(text string, b *bool, stepCode *uint8, off, on string) error {
+	fields := strings.Fields(text)
+	if len(fields) != 2 {
+		return fmt.Errorf("value %q must consist of a direction (%s or %s) and a step code", text, off, on)
+	}
+
+	switch {
+	case strings.EqualFold(fields[0], on):
+		*b = true
+
+	case strings.EqualFold(fields[0], off):
+		*b = false
+
+	default:
+		return fmt.Errorf("direction %q is neither %q nor %q", fields[0], off, on)
+	}
+
+	if strings.EqualFold(fields[1], "break") {
+		*stepCode = 0
+		return nil
+	}
+
+	code, err := strconv.ParseUint(fields[1], 10, 8)
+	if err != nil || code > 7 {
+		return fmt.Errorf("step code %q must be break or a number from 0 to 7", fields[1])
+	}
+
+	*stepCode = uint8(code)
+
+	return nil
+}
+
+// DPT_3007 represents DPT 3.007 (FB) / DPT_Control_Dimming.
+type DPT_3007 struct {
+	Increase bool
+	// StepCode divides the brightness range into 2^(StepCode-1) intervals. 0 stops dimming.
+	StepCode uint8
+}
+
+func (d DPT_3007) Pack() []byte {
+	return []byte{packB1U3(d.Increase, d.StepCode)}
+}
+
+func (d *DPT_3007) Unpack(data []byte) error {
+	return unpackB1U3(data, &d.Increase, &d.StepCode)
+}
+
+func (d DPT_3007) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_3007) UnmarshalText(text []byte) error {
+	return parseStep(string(text), &d.Increase, &d.StepCode, "decrease", "increase")
+}
+
+func (d DPT_3007) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_3007) UnmarshalJSON(data []byte) error {
+	type plain DPT_3007
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+// Intervals returns the number of intervals which the step code divides the range into, or 0 if
+// dimming shall stop.
+func (d DPT_3007) Intervals() uint8 {
+	return stepIntervals(d.StepCode)
+}
+
+func (d DPT_3007) Unit() string {
+	return ""
+}
+
+// IsValid checks that the step code fits into its three bits.
+func (d DPT_3007) IsValid() bool {
+	return d.StepCode <= 7
+}
+
+func (d DPT_3007) String() string {
+	if d.Increase {
+		return stepString("Increase", d.StepCode)
+	}
+
+	return stepString("Decrease", d.StepCode)
+}
+
+// DPT_3008 represents DPT 3.008 (FB) / DPT_Control_Blinds.
+type DPT_3008 struct {
+	Down bool
+	// StepCode divides the travel range into 2^(StepCode-1) intervals. 0 stops the movement.
+	StepCode uint8
+}
+
+func (d DPT_3008) Pack() []byte {
+	return []byte{packB1U3(d.Down, d.StepCode)}
+}
+
+func (d *DPT_3008) Unpack(data []byte) error {
+	return unpackB1U3(data, &d.Down, &d.StepCode)
+}
+
+func (d DPT_3008) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT_3008) UnmarshalText(text []byte) error {
+	return parseStep(string(text), &d.Down, &d.StepCode, "up", "down")
+}
+
+func (d DPT_3008) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_3008) UnmarshalJSON(data []byte) error {
+	type plain DPT_3008
+	return unmarshalJSON(data, d, (*plain)(d))
+}
+
+// Intervals returns the number of intervals which the step code divides the range into, or 0 if
+// the movement shall stop.
+func (d DPT_3008) Intervals() uint8 {
+	return stepIntervals(d.StepCode)
+}
+
+func (d DPT_3008) Unit() string {
+	return ""
+}
+
+// IsValid checks that the step code fits into its three bits.
+func (d DPT_3008) IsValid() bool {
+	return d.StepCode <= 7
+}
+
+func (d DPT_3008) String() string {
+	if d.Down {
+		return stepString("Down", d.StepCode)
+	}
+
+	return stepString("Up", d.StepCode)
+}
@@ -0,0 +1,87 @@
+package dpt
+
+import (
+	"testing"
+)
+
+// Test DPT 3.007 (dimming control)
+func TestDPT_3007(t *testing.T) {
+	for i := 0; i <= 15; i++ {
+		var d DPT_3007
+		if err := d.Unpack([]byte{byte(i)}); err != nil {
+			t.Fatalf("Unexpected error for %#x: %v", i, err)
+		}
+
+		if d.Increase != (i >= 8) || d.StepCode != uint8(i&7) {
+			t.Errorf("Wrong value %+v after unpacking %#x", d, i)
+		}
+
+		if buf := d.Pack(); len(buf) != 1 || buf[0] != byte(i) {
+			t.Errorf("Wrong data %v after packing %+v", buf, d)
+		}
+	}
+
+	if err := new(DPT_3007).Unpack([]byte{0, 0}); err != ErrInvalidLength {
+		t.Errorf("Expected ErrInvalidLength, got %v", err)
+	}
+
+	names := map[DPT_3007]string{
+		{Increase: true, StepCode: 1}:  "Increase 1",
+		{Increase: false, StepCode: 0}: "Decrease break",
+		{Increase: false, StepCode: 7}: "Decrease 7",
+	}
+	for d, expected := range names {
+		if d.String() != expected {
+			t.Errorf("Wrong string %q for %+v, want %q", d.String(), d, expected)
+		}
+	}
+
+	intervals := map[uint8]uint8{0: 0, 1: 1, 2: 2, 4: 8, 7: 64}
+	for code, expected := range intervals {
+		if n := (DPT_3007{StepCode: code}).Intervals(); n != expected {
+			t.Errorf("Wrong number of intervals %d for step code %d, want %d", n, code, expected)
+		}
+	}
+}
+
+// Test DPT 3.008 (blind control)
+func TestDPT_3008(t *testing.T) {
+	tests := []struct {
+		in   string
+		want DPT_3008
+		data byte
+	}{
+		{"up 4", DPT_3008{Down: false, StepCode: 4}, 0x04},
+		{"down break", DPT_3008{Down: true, StepCode: 0}, 0x08},
+		{"Down 1", DPT_3008{Down: true, StepCode: 1}, 0x09},
+		{"  UP   BREAK ", DPT_3008{}, 0x00},
+	}
+
+	for _, tc := range tests {
+		var d DPT_3008
+		if err := d.UnmarshalText([]byte(tc.in)); err != nil {
+			t.Errorf("Unexpected error for %q: %v", tc.in, err)
+			continue
+		}
+
+		if d != tc.want {
+			t.Errorf("Wrong value %+v for %q, want %+v", d, tc.in, tc.want)
+		}
+
+		if buf := d.Pack(); buf[0] != tc.data {
+			t.Errorf("Wrong data %v for %q, want %#x", buf, tc.in, tc.data)
+		}
+
+		var dst DPT_3008
+		if err := dst.UnmarshalText([]byte(d.String())); err != nil || dst != d {
+			t.Errorf("String %q does not parse back to %+v", d.String(), d)
+		}
+	}
+
+	for _, in := range []string{"", "up", "up 8", "left 2", "down 4 5", "increase 1"} {
+		var d DPT_3008
+		if err := d.UnmarshalText([]byte(in)); err == nil {
+			t.Errorf("Expected error for %q, got %+v", in, d)
+		}
+	}
+}
@@ -34,6 +34,30 @@ func (d DPT_5001) String() string {
 	return fmt.Sprintf("%.2f%%", float32(d))
 }
 
+func (d DPT_5001) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_5001) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_5001) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_5001) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_5001) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_5001) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_5003 represents DPT 5.003 / Angle.
 type DPT_5003 float32
 
@@ -66,6 +90,30 @@ func (d DPT_5003) String() string {
 	return fmt.Sprintf("%.2f°", float32(d))
 }
 
+func (d DPT_5003) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_5003) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_5003) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_5003) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_5003) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_5003) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_5004 represents DPT 5.004 / Percent_U8.
 type DPT_5004 uint8
 
@@ -85,6 +133,30 @@ func (d DPT_5004) String() string {
 	return fmt.Sprintf("%.2f%%", float32(d))
 }
 
+func (d DPT_5004) MarshalText() ([]byte, error) {
+	return formatUintText(uint8(d), d.Unit()), nil
+}
+
+func (d *DPT_5004) UnmarshalText(text []byte) error {
+	return parseUintText(text, d.Unit(), (*uint8)(d))
+}
+
+func (d DPT_5004) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_5004) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_5004) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_5004) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
+
 // DPT_5005 represents DPT 5.005 / Ratio (0..255).
 type DPT_5005 uint8
 
@@ -103,3 +175,27 @@ func (d DPT_5005) Unit() string {
 func (d DPT_5005) String() string {
 	return fmt.Sprintf("%d", uint8(d))
 }
+
+func (d DPT_5005) MarshalText() ([]byte, error) {
+	return formatUintText(uint8(d), d.Unit()), nil
+}
+
+func (d *DPT_5005) UnmarshalText(text []byte) error {
+	return parseUintText(text, d.Unit(), (*uint8)(d))
+}
+
+func (d DPT_5005) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_5005) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint8)(d))
+}
+
+func (d DPT_5005) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_5005) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint8)(d))
+}
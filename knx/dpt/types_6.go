@@ -20,3 +20,27 @@ func (d DPT_6010) Unit() string {
 func (d DPT_6010) String() string {
 	return fmt.Sprintf("%d counter pulses", d)
 }
+
+func (d DPT_6010) MarshalText() ([]byte, error) {
+	return formatIntText(int8(d), d.Unit()), nil
+}
+
+func (d *DPT_6010) UnmarshalText(text []byte) error {
+	return parseIntText(text, d.Unit(), (*int8)(d))
+}
+
+func (d DPT_6010) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_6010) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*int8)(d))
+}
+
+func (d DPT_6010) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_6010) SetFloat64(value float64) error {
+	return setInteger(d, value, (*int8)(d))
+}
@@ -26,6 +26,30 @@ func (d DPT_7001) String() string {
 	return fmt.Sprintf("%d pulses", uint16(d))
 }
 
+func (d DPT_7001) MarshalText() ([]byte, error) {
+	return formatUintText(uint16(d), d.Unit()), nil
+}
+
+func (d *DPT_7001) UnmarshalText(text []byte) error {
+	return parseUintText(text, d.Unit(), (*uint16)(d))
+}
+
+func (d DPT_7001) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_7001) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint16)(d))
+}
+
+func (d DPT_7001) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_7001) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint16)(d))
+}
+
 // DPT_7002 represents DPT 7.002 / Time Period MSec.
 type DPT_7002 uint16
 
@@ -45,6 +69,30 @@ func (d DPT_7002) String() string {
 	return fmt.Sprintf("%d ms", uint16(d))
 }
 
+func (d DPT_7002) MarshalText() ([]byte, error) {
+	return formatUintText(uint16(d), d.Unit()), nil
+}
+
+func (d *DPT_7002) UnmarshalText(text []byte) error {
+	return parseUintText(text, d.Unit(), (*uint16)(d))
+}
+
+func (d DPT_7002) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_7002) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint16)(d))
+}
+
+func (d DPT_7002) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_7002) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint16)(d))
+}
+
 // DPT_7003 represents DPT 7.003 / Time Period 10 MSec.
 type DPT_7003 uint16
 
@@ -64,6 +112,30 @@ func (d DPT_7003) String() string {
 	return fmt.Sprintf("%d s", uint16(d))
 }
 
+func (d DPT_7003) MarshalText() ([]byte, error) {
+	return formatUintText(uint16(d), d.Unit()), nil
+}
+
+func (d *DPT_7003) UnmarshalText(text []byte) error {
+	return parseUintText(text, d.Unit(), (*uint16)(d))
+}
+
+func (d DPT_7003) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_7003) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint16)(d))
+}
+
+func (d DPT_7003) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_7003) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint16)(d))
+}
+
 // DPT_7004 represents DPT 7.004 / Time Period 100 MSec.
 type DPT_7004 uint16
 
@@ -83,6 +155,30 @@ func (d DPT_7004) String() string {
 	return fmt.Sprintf("%d s", uint16(d))
 }
 
+func (d DPT_7004) MarshalText() ([]byte, error) {
+	return formatUintText(uint16(d), d.Unit()), nil
+}
+
+func (d *DPT_7004) UnmarshalText(text []byte) error {
+	return parseUintText(text, d.Unit(), (*uint16)(d))
+}
+
+func (d DPT_7004) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_7004) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint16)(d))
+}
+
+func (d DPT_7004) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_7004) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint16)(d))
+}
+
 // DPT_7005 represents DPT 7.005 / Time Period Sec.
 type DPT_7005 uint16
 
@@ -102,6 +198,30 @@ func (d DPT_7005) String() string {
 	return fmt.Sprintf("%d s", uint16(d))
 }
 
+func (d DPT_7005) MarshalText() ([]byte, error) {
+	return formatUintText(uint16(d), d.Unit()), nil
+}
+
+func (d *DPT_7005) UnmarshalText(text []byte) error {
+	return parseUintText(text, d.Unit(), (*uint16)(d))
+}
+
+func (d DPT_7005) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_7005) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint16)(d))
+}
+
+func (d DPT_7005) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_7005) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint16)(d))
+}
+
 // DPT_7006 represents DPT 7.006 / Time Period Min.
 type DPT_7006 uint16
 
@@ -121,6 +241,30 @@ func (d DPT_7006) String() string {
 	return fmt.Sprintf("%d m", uint16(d))
 }
 
+func (d DPT_7006) MarshalText() ([]byte, error) {
+	return formatUintText(uint16(d), d.Unit()), nil
+}
+
+func (d *DPT_7006) UnmarshalText(text []byte) error {
+	return parseUintText(text, d.Unit(), (*uint16)(d))
+}
+
+func (d DPT_7006) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_7006) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint16)(d))
+}
+
+func (d DPT_7006) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_7006) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint16)(d))
+}
+
 // DPT_7007 represents DPT 7.007 / Time Period Hrs.
 type DPT_7007 uint16
 
@@ -140,6 +284,30 @@ func (d DPT_7007) String() string {
 	return fmt.Sprintf("%d h", uint16(d))
 }
 
+func (d DPT_7007) MarshalText() ([]byte, error) {
+	return formatUintText(uint16(d), d.Unit()), nil
+}
+
+func (d *DPT_7007) UnmarshalText(text []byte) error {
+	return parseUintText(text, d.Unit(), (*uint16)(d))
+}
+
+func (d DPT_7007) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_7007) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint16)(d))
+}
+
+func (d DPT_7007) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_7007) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint16)(d))
+}
+
 // DPT_7010 represents DPT 7.010 / Property DataType.
 type DPT_7010 uint16
 
@@ -159,6 +327,30 @@ func (d DPT_7010) String() string {
 	return fmt.Sprintf("%d", uint16(d))
 }
 
+func (d DPT_7010) MarshalText() ([]byte, error) {
+	return formatUintText(uint16(d), d.Unit()), nil
+}
+
+func (d *DPT_7010) UnmarshalText(text []byte) error {
+	return parseUintText(text, d.Unit(), (*uint16)(d))
+}
+
+func (d DPT_7010) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_7010) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint16)(d))
+}
+
+func (d DPT_7010) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_7010) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint16)(d))
+}
+
 // DPT_7011 represents DPT 7.011 / Length mm.
 type DPT_7011 uint16
 
@@ -178,6 +370,30 @@ func (d DPT_7011) String() string {
 	return fmt.Sprintf("%d mm", uint16(d))
 }
 
+func (d DPT_7011) MarshalText() ([]byte, error) {
+	return formatUintText(uint16(d), d.Unit()), nil
+}
+
+func (d *DPT_7011) UnmarshalText(text []byte) error {
+	return parseUintText(text, d.Unit(), (*uint16)(d))
+}
+
+func (d DPT_7011) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_7011) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint16)(d))
+}
+
+func (d DPT_7011) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_7011) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint16)(d))
+}
+
 // DPT_7012 represents DPT 7.012 / Current mA.
 type DPT_7012 uint16
 
@@ -197,6 +413,30 @@ func (d DPT_7012) String() string {
 	return fmt.Sprintf("%d mA", uint16(d))
 }
 
+func (d DPT_7012) MarshalText() ([]byte, error) {
+	return formatUintText(uint16(d), d.Unit()), nil
+}
+
+func (d *DPT_7012) UnmarshalText(text []byte) error {
+	return parseUintText(text, d.Unit(), (*uint16)(d))
+}
+
+func (d DPT_7012) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_7012) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint16)(d))
+}
+
+func (d DPT_7012) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_7012) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint16)(d))
+}
+
 // DPT_7013 represents DPT 7.013 / Brightness lux.
 type DPT_7013 uint16
 
@@ -215,3 +455,27 @@ func (d DPT_7013) Unit() string {
 func (d DPT_7013) String() string {
 	return fmt.Sprintf("%d lux", uint16(d))
 }
+
+func (d DPT_7013) MarshalText() ([]byte, error) {
+	return formatUintText(uint16(d), d.Unit()), nil
+}
+
+func (d *DPT_7013) UnmarshalText(text []byte) error {
+	return parseUintText(text, d.Unit(), (*uint16)(d))
+}
+
+func (d DPT_7013) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_7013) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*uint16)(d))
+}
+
+func (d DPT_7013) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_7013) SetFloat64(value float64) error {
+	return setInteger(d, value, (*uint16)(d))
+}
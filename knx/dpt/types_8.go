@@ -0,0 +1,363 @@
+package dpt
+
+import (
+	"fmt"
+	"math"
+)
+
+// DPT_8001 represents DPT 8.001 / Value 2 Count.
+type DPT_8001 int16
+
+func (d DPT_8001) Pack() []byte {
+	return packV16(int16(d))
+}
+
+func (d *DPT_8001) Unpack(data []byte) error {
+	return unpackV16(data, (*int16)(d))
+}
+
+func (d DPT_8001) Unit() string {
+	return "pulses"
+}
+
+func (d DPT_8001) String() string {
+	return fmt.Sprintf("%d pulses", int16(d))
+}
+
+func (d DPT_8001) MarshalText() ([]byte, error) {
+	return formatIntText(int16(d), d.Unit()), nil
+}
+
+func (d *DPT_8001) UnmarshalText(text []byte) error {
+	return parseIntText(text, d.Unit(), (*int16)(d))
+}
+
+func (d DPT_8001) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_8001) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*int16)(d))
+}
+
+func (d DPT_8001) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_8001) SetFloat64(value float64) error {
+	return setInteger(d, value, (*int16)(d))
+}
+
+// DPT_8002 represents DPT 8.002 / Delta Time MSec.
+type DPT_8002 int16
+
+func (d DPT_8002) Pack() []byte {
+	return packV16(int16(d))
+}
+
+func (d *DPT_8002) Unpack(data []byte) error {
+	return unpackV16(data, (*int16)(d))
+}
+
+func (d DPT_8002) Unit() string {
+	return "ms"
+}
+
+func (d DPT_8002) String() string {
+	return fmt.Sprintf("%d ms", int16(d))
+}
+
+func (d DPT_8002) MarshalText() ([]byte, error) {
+	return formatIntText(int16(d), d.Unit()), nil
+}
+
+func (d *DPT_8002) UnmarshalText(text []byte) error {
+	return parseIntText(text, d.Unit(), (*int16)(d))
+}
+
+func (d DPT_8002) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_8002) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*int16)(d))
+}
+
+func (d DPT_8002) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_8002) SetFloat64(value float64) error {
+	return setInteger(d, value, (*int16)(d))
+}
+
+// DPT_8005 represents DPT 8.005 / Delta Time Sec.
+type DPT_8005 int16
+
+func (d DPT_8005) Pack() []byte {
+	return packV16(int16(d))
+}
+
+func (d *DPT_8005) Unpack(data []byte) error {
+	return unpackV16(data, (*int16)(d))
+}
+
+func (d DPT_8005) Unit() string {
+	return "s"
+}
+
+func (d DPT_8005) String() string {
+	return fmt.Sprintf("%d s", int16(d))
+}
+
+func (d DPT_8005) MarshalText() ([]byte, error) {
+	return formatIntText(int16(d), d.Unit()), nil
+}
+
+func (d *DPT_8005) UnmarshalText(text []byte) error {
+	return parseIntText(text, d.Unit(), (*int16)(d))
+}
+
+func (d DPT_8005) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_8005) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*int16)(d))
+}
+
+func (d DPT_8005) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_8005) SetFloat64(value float64) error {
+	return setInteger(d, value, (*int16)(d))
+}
+
+// DPT_8006 represents DPT 8.006 / Delta Time Min.
+type DPT_8006 int16
+
+func (d DPT_8006) Pack() []byte {
+	return packV16(int16(d))
+}
+
+func (d *DPT_8006) Unpack(data []byte) error {
+	return unpackV16(data, (*int16)(d))
+}
+
+func (d DPT_8006) Unit() string {
+	return "min"
+}
+
+func (d DPT_8006) String() string {
+	return fmt.Sprintf("%d min", int16(d))
+}
+
+func (d DPT_8006) MarshalText() ([]byte, error) {
+	return formatIntText(int16(d), d.Unit()), nil
+}
+
+func (d *DPT_8006) UnmarshalText(text []byte) error {
+	return parseIntText(text, d.Unit(), (*int16)(d))
+}
+
+func (d DPT_8006) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_8006) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*int16)(d))
+}
+
+func (d DPT_8006) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_8006) SetFloat64(value float64) error {
+	return setInteger(d, value, (*int16)(d))
+}
+
+// DPT_8007 represents DPT 8.007 / Delta Time Hrs.
+type DPT_8007 int16
+
+func (d DPT_8007) Pack() []byte {
+	return packV16(int16(d))
+}
+
+func (d *DPT_8007) Unpack(data []byte) error {
+	return unpackV16(data, (*int16)(d))
+}
+
+func (d DPT_8007) Unit() string {
+	return "h"
+}
+
+func (d DPT_8007) String() string {
+	return fmt.Sprintf("%d h", int16(d))
+}
+
+func (d DPT_8007) MarshalText() ([]byte, error) {
+	return formatIntText(int16(d), d.Unit()), nil
+}
+
+func (d *DPT_8007) UnmarshalText(text []byte) error {
+	return parseIntText(text, d.Unit(), (*int16)(d))
+}
+
+func (d DPT_8007) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_8007) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*int16)(d))
+}
+
+func (d DPT_8007) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_8007) SetFloat64(value float64) error {
+	return setInteger(d, value, (*int16)(d))
+}
+
+// DPT_8010 represents DPT 8.010 / Percent V16 (-327.68..327.67 %).
+type DPT_8010 float32
+
+func (d DPT_8010) Pack() []byte {
+	if d <= -327.68 {
+		return packV16(math.MinInt16)
+	} else if d >= 327.67 {
+		return packV16(math.MaxInt16)
+	} else {
+		return packV16(int16(math.Round(float64(d) * 100)))
+	}
+}
+
+func (d *DPT_8010) Unpack(data []byte) error {
+	var value int16
+	if err := unpackV16(data, &value); err != nil {
+		return err
+	}
+
+	*d = DPT_8010(value) / 100
+
+	return nil
+}
+
+func (d DPT_8010) Unit() string {
+	return "%"
+}
+
+func (d DPT_8010) String() string {
+	return fmt.Sprintf("%.2f%%", float32(d))
+}
+
+func (d DPT_8010) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_8010) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_8010) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_8010) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_8010) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_8010) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
+// DPT_8011 represents DPT 8.011 / Rotation Angle.
+type DPT_8011 int16
+
+func (d DPT_8011) Pack() []byte {
+	return packV16(int16(d))
+}
+
+func (d *DPT_8011) Unpack(data []byte) error {
+	return unpackV16(data, (*int16)(d))
+}
+
+func (d DPT_8011) Unit() string {
+	return "°"
+}
+
+func (d DPT_8011) String() string {
+	return fmt.Sprintf("%d °", int16(d))
+}
+
+func (d DPT_8011) MarshalText() ([]byte, error) {
+	return formatIntText(int16(d), d.Unit()), nil
+}
+
+func (d *DPT_8011) UnmarshalText(text []byte) error {
+	return parseIntText(text, d.Unit(), (*int16)(d))
+}
+
+func (d DPT_8011) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_8011) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*int16)(d))
+}
+
+func (d DPT_8011) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_8011) SetFloat64(value float64) error {
+	return setInteger(d, value, (*int16)(d))
+}
+
+// DPT_8012 represents DPT 8.012 / Length m.
+type DPT_8012 int16
+
+func (d DPT_8012) Pack() []byte {
+	return packV16(int16(d))
+}
+
+func (d *DPT_8012) Unpack(data []byte) error {
+	return unpackV16(data, (*int16)(d))
+}
+
+func (d DPT_8012) Unit() string {
+	return "m"
+}
+
+func (d DPT_8012) String() string {
+	return fmt.Sprintf("%d m", int16(d))
+}
+
+func (d DPT_8012) MarshalText() ([]byte, error) {
+	return formatIntText(int16(d), d.Unit()), nil
+}
+
+func (d *DPT_8012) UnmarshalText(text []byte) error {
+	return parseIntText(text, d.Unit(), (*int16)(d))
+}
+
+func (d DPT_8012) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_8012) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*int16)(d))
+}
+
+func (d DPT_8012) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_8012) SetFloat64(value float64) error {
+	return setInteger(d, value, (*int16)(d))
+}
@@ -0,0 +1,226 @@
+package dpt
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// Test DPT 8.001 (pulses) with values within range
+func TestDPT_8001(t *testing.T) {
+	var buf []byte
+	var src, dst DPT_8001
+
+	for i := 1; i <= 10; i++ {
+		value := int16(rand.Uint32())
+
+		// Pack and unpack to test value
+		src = DPT_8001(value)
+		if int16(src) != value {
+			t.Errorf("Assignment of value \"%v\" failed for source of type DPT_8001! Has value \"%s\".", value, src)
+		}
+		buf = src.Pack()
+		dst.Unpack(buf)
+		if int16(dst) != value {
+			t.Errorf("Value \"%s\" after pack/unpack different from Original value. Was \"%v\"", dst, value)
+		}
+	}
+}
+
+// Test DPT 8.002 (ms) with values within range
+func TestDPT_8002(t *testing.T) {
+	var buf []byte
+	var src, dst DPT_8002
+
+	for i := 1; i <= 10; i++ {
+		value := int16(rand.Uint32())
+
+		// Pack and unpack to test value
+		src = DPT_8002(value)
+		if int16(src) != value {
+			t.Errorf("Assignment of value \"%v\" failed for source of type DPT_8002! Has value \"%s\".", value, src)
+		}
+		buf = src.Pack()
+		dst.Unpack(buf)
+		if int16(dst) != value {
+			t.Errorf("Value \"%s\" after pack/unpack different from Original value. Was \"%v\"", dst, value)
+		}
+	}
+}
+
+// Test DPT 8.005 (s) with values within range
+func TestDPT_8005(t *testing.T) {
+	var buf []byte
+	var src, dst DPT_8005
+
+	for i := 1; i <= 10; i++ {
+		value := int16(rand.Uint32())
+
+		// Pack and unpack to test value
+		src = DPT_8005(value)
+		if int16(src) != value {
+			t.Errorf("Assignment of value \"%v\" failed for source of type DPT_8005! Has value \"%s\".", value, src)
+		}
+		buf = src.Pack()
+		dst.Unpack(buf)
+		if int16(dst) != value {
+			t.Errorf("Value \"%s\" after pack/unpack different from Original value. Was \"%v\"", dst, value)
+		}
+	}
+}
+
+// Test DPT 8.006 (min) with values within range
+func TestDPT_8006(t *testing.T) {
+	var buf []byte
+	var src, dst DPT_8006
+
+	for i := 1; i <= 10; i++ {
+		value := int16(rand.Uint32())
+
+		// Pack and unpack to test value
+		src = DPT_8006(value)
+		if int16(src) != value {
+			t.Errorf("Assignment of value \"%v\" failed for source of type DPT_8006! Has value \"%s\".", value, src)
+		}
+		buf = src.Pack()
+		dst.Unpack(buf)
+		if int16(dst) != value {
+			t.Errorf("Value \"%s\" after pack/unpack different from Original value. Was \"%v\"", dst, value)
+		}
+	}
+}
+
+// Test DPT 8.007 (h) with values within range
+func TestDPT_8007(t *testing.T) {
+	var buf []byte
+	var src, dst DPT_8007
+
+	for i := 1; i <= 10; i++ {
+		value := int16(rand.Uint32())
+
+		// Pack and unpack to test value
+		src = DPT_8007(value)
+		if int16(src) != value {
+			t.Errorf("Assignment of value \"%v\" failed for source of type DPT_8007! Has value \"%s\".", value, src)
+		}
+		buf = src.Pack()
+		dst.Unpack(buf)
+		if int16(dst) != value {
+			t.Errorf("Value \"%s\" after pack/unpack different from Original value. Was \"%v\"", dst, value)
+		}
+	}
+}
+
+// Test DPT 8.010 (%) with values within range
+func TestDPT_8010(t *testing.T) {
+	var buf []byte
+	var src, dst DPT_8010
+
+	// Calculate the quantization error we expect
+	const Q = float32(0.01)
+
+	for i := 1; i <= 10; i++ {
+		value := rand.Float32()
+
+		// Scale the random number to the given range
+		value = value*655.35 - 327.68
+
+		// Pack and unpack to test value
+		src = DPT_8010(value)
+		if abs(float32(src)-value) > epsilon {
+			t.Errorf("Assignment of value \"%v\" failed for source of type DPT_8010! Has value \"%s\".", value, src)
+		}
+		buf = src.Pack()
+		dst.Unpack(buf)
+		if math.IsNaN(float64(dst)) {
+			t.Errorf("Value \"%s\" is not a valid number! Original value was \"%v\".", dst, value)
+		}
+		if abs(float32(dst)-value) > (Q + epsilon) {
+			t.Errorf("Value \"%s\" after pack/unpack above quantization noise! Original value was \"%v\", noise is \"%f\"", dst, value, Q)
+		}
+	}
+}
+
+// Test DPT 8.010 (%) with values exceeding range
+func TestDPT_8010_Limits(t *testing.T) {
+	var dst DPT_8010
+
+	if err := dst.Unpack(DPT_8010(-1000).Pack()); err != nil || dst != -327.68 {
+		t.Errorf("Value \"%s\" is not the lower limit, error %v", dst, err)
+	}
+
+	if err := dst.Unpack(DPT_8010(1000).Pack()); err != nil || dst != 327.67 {
+		t.Errorf("Value \"%s\" is not the upper limit, error %v", dst, err)
+	}
+}
+
+// Test DPT 8.011 (°) with values within range
+func TestDPT_8011(t *testing.T) {
+	var buf []byte
+	var src, dst DPT_8011
+
+	for i := 1; i <= 10; i++ {
+		value := int16(rand.Uint32())
+
+		// Pack and unpack to test value
+		src = DPT_8011(value)
+		if int16(src) != value {
+			t.Errorf("Assignment of value \"%v\" failed for source of type DPT_8011! Has value \"%s\".", value, src)
+		}
+		buf = src.Pack()
+		dst.Unpack(buf)
+		if int16(dst) != value {
+			t.Errorf("Value \"%s\" after pack/unpack different from Original value. Was \"%v\"", dst, value)
+		}
+	}
+}
+
+// Test DPT 8.012 (m) with values within range
+func TestDPT_8012(t *testing.T) {
+	var buf []byte
+	var src, dst DPT_8012
+
+	for i := 1; i <= 10; i++ {
+		value := int16(rand.Uint32())
+
+		// Pack and unpack to test value
+		src = DPT_8012(value)
+		if int16(src) != value {
+			t.Errorf("Assignment of value \"%v\" failed for source of type DPT_8012! Has value \"%s\".", value, src)
+		}
+		buf = src.Pack()
+		dst.Unpack(buf)
+		if int16(dst) != value {
+			t.Errorf("Value \"%s\" after pack/unpack different from Original value. Was \"%v\"", dst, value)
+		}
+	}
+}
+
+// Test DPT 8.xxx with data of invalid length
+func TestDPT_8_InvalidLength(t *testing.T) {
+	values := []DatapointValue{
+		new(DPT_8001), new(DPT_8002), new(DPT_8005), new(DPT_8006),
+		new(DPT_8007), new(DPT_8010), new(DPT_8011), new(DPT_8012),
+	}
+
+	for _, value := range values {
+		for _, data := range [][]byte{{}, {0, 1}, {0, 1, 2, 3}} {
+			if err := value.Unpack(data); err != ErrInvalidLength {
+				t.Errorf("%T accepted %v, error %v", value, data, err)
+			}
+		}
+	}
+}
+
+// Test the sign of DPT 8.001
+func TestDPT_8001_Negative(t *testing.T) {
+	var dst DPT_8001
+
+	if err := dst.Unpack([]byte{0, 0xff, 0xfe}); err != nil || dst != -2 {
+		t.Errorf("Value \"%s\" is not -2 pulses, error %v", dst, err)
+	}
+
+	if buf := DPT_8001(-32768).Pack(); buf[1] != 0x80 || buf[2] != 0x00 {
+		t.Errorf("Wrong data %v for -32768 pulses", buf)
+	}
+}
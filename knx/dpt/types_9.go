@@ -43,6 +43,30 @@ func (d DPT_9001) String() string {
 	return fmt.Sprintf("%.2f °C", float32(d))
 }
 
+func (d DPT_9001) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_9001) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_9001) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_9001) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_9001) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_9001) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_9002 represents DPT 9.002 / Temperature K.
 type DPT_9002 float32
 
@@ -79,6 +103,30 @@ func (d DPT_9002) String() string {
 	return fmt.Sprintf("%.2f K", float32(d))
 }
 
+func (d DPT_9002) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_9002) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_9002) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_9002) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_9002) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_9002) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_9003 represents DPT 9.003 / Temperature K/h.
 type DPT_9003 float32
 
@@ -115,6 +163,30 @@ func (d DPT_9003) String() string {
 	return fmt.Sprintf("%.2f K/h", float32(d))
 }
 
+func (d DPT_9003) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_9003) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_9003) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_9003) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_9003) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_9003) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_9004 represents DPT 9.004 / Illumination lux.
 type DPT_9004 float32
 
@@ -151,6 +223,30 @@ func (d DPT_9004) String() string {
 	return fmt.Sprintf("%.2f lux", float32(d))
 }
 
+func (d DPT_9004) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_9004) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_9004) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_9004) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_9004) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_9004) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_9005 represents DPT 9.005 / Wind Speed m/s.
 type DPT_9005 float32
 
@@ -188,6 +284,30 @@ func (d DPT_9005) String() string {
 	return fmt.Sprintf("%.2f m/s", float32(d))
 }
 
+func (d DPT_9005) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_9005) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_9005) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_9005) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_9005) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_9005) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_9006 represents DPT 9.006 / Pressure Pa.
 type DPT_9006 float32
 
@@ -225,6 +345,30 @@ func (d DPT_9006) String() string {
 	return fmt.Sprintf("%.2f Pa", float32(d))
 }
 
+func (d DPT_9006) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_9006) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_9006) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_9006) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_9006) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_9006) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_9007 represents DPT 9.007 / Humidity %
 type DPT_9007 float32
 
@@ -262,6 +406,30 @@ func (d DPT_9007) String() string {
 	return fmt.Sprintf("%.2f %%", float32(d))
 }
 
+func (d DPT_9007) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_9007) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_9007) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_9007) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_9007) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_9007) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_9008 represents DPT 9.008 / Air quality ppm
 type DPT_9008 float32
 
@@ -300,6 +468,30 @@ func (d DPT_9008) String() string {
 	return fmt.Sprintf("%.2f ppm", float32(d))
 }
 
+func (d DPT_9008) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_9008) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_9008) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_9008) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_9008) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_9008) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_9010 represents DPT 9.010 / Time s.
 type DPT_9010 float32
 
@@ -336,6 +528,30 @@ func (d DPT_9010) String() string {
 	return fmt.Sprintf("%.2f s", float32(d))
 }
 
+func (d DPT_9010) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_9010) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_9010) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_9010) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_9010) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_9010) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_9011 represents DPT 9.011 / Time ms.
 type DPT_9011 float32
 
@@ -372,6 +588,30 @@ func (d DPT_9011) String() string {
 	return fmt.Sprintf("%.2f ms", float32(d))
 }
 
+func (d DPT_9011) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_9011) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_9011) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_9011) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_9011) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_9011) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_9020 represents DPT 9.020 / Volt mV.
 type DPT_9020 float32
 
@@ -408,6 +648,30 @@ func (d DPT_9020) String() string {
 	return fmt.Sprintf("%.2f mV", float32(d))
 }
 
+func (d DPT_9020) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_9020) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_9020) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_9020) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_9020) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_9020) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_9021 represents DPT 9.021 / Current mA.
 type DPT_9021 float32
 
@@ -444,6 +708,30 @@ func (d DPT_9021) String() string {
 	return fmt.Sprintf("%.2f mA", float32(d))
 }
 
+func (d DPT_9021) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_9021) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_9021) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_9021) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_9021) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_9021) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_9022 represents DPT 9.022 / Power Density W/m2.
 type DPT_9022 float32
 
@@ -480,6 +768,30 @@ func (d DPT_9022) String() string {
 	return fmt.Sprintf("%.2f W/m2", float32(d))
 }
 
+func (d DPT_9022) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_9022) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_9022) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_9022) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_9022) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_9022) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_9023 represents DPT 9.023 / Kelvin per Percent K/%.
 type DPT_9023 float32
 
@@ -516,6 +828,30 @@ func (d DPT_9023) String() string {
 	return fmt.Sprintf("%.2f K/%%", float32(d))
 }
 
+func (d DPT_9023) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_9023) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_9023) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_9023) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_9023) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_9023) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_9024 represents DPT 9.024 / Power kW.
 type DPT_9024 float32
 
@@ -552,6 +888,30 @@ func (d DPT_9024) String() string {
 	return fmt.Sprintf("%.2f kW", float32(d))
 }
 
+func (d DPT_9024) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_9024) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_9024) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_9024) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_9024) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_9024) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_9025 represents DPT 9.025 / Volume Flow l/h.
 type DPT_9025 float32
 
@@ -588,6 +948,30 @@ func (d DPT_9025) String() string {
 	return fmt.Sprintf("%.2f l/h", float32(d))
 }
 
+func (d DPT_9025) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_9025) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_9025) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_9025) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_9025) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_9025) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_9026 represents DPT 9.026 / Rain amount l/m^2.
 type DPT_9026 float32
 
@@ -624,6 +1008,30 @@ func (d DPT_9026) String() string {
 	return fmt.Sprintf("%.2f l/m^2", float32(d))
 }
 
+func (d DPT_9026) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_9026) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_9026) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_9026) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_9026) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_9026) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_9027 represents DPT 9.027 / Temperature °F.
 type DPT_9027 float32
 
@@ -660,6 +1068,30 @@ func (d DPT_9027) String() string {
 	return fmt.Sprintf("%.2f °F", float32(d))
 }
 
+func (d DPT_9027) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_9027) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_9027) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_9027) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_9027) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_9027) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
+
 // DPT_9028 represents DPT 9.028 / Wind Speed km/h.
 type DPT_9028 float32
 
@@ -695,3 +1127,27 @@ func (d DPT_9028) Unit() string {
 func (d DPT_9028) String() string {
 	return fmt.Sprintf("%.2f km/h", float32(d))
 }
+
+func (d DPT_9028) MarshalText() ([]byte, error) {
+	return formatFloatText(float32(d), d.Unit()), nil
+}
+
+func (d *DPT_9028) UnmarshalText(text []byte) error {
+	return parseFloatText(text, d.Unit(), (*float32)(d))
+}
+
+func (d DPT_9028) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&d)
+}
+
+func (d *DPT_9028) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, d, (*float32)(d))
+}
+
+func (d DPT_9028) Float64() float64 {
+	return float64(d)
+}
+
+func (d *DPT_9028) SetFloat64(value float64) error {
+	return setFloat32(d, value, (*float32)(d))
+}
@@ -5,6 +5,7 @@ package dpt
 
 import (
 	"reflect"
+	"strings"
 	"sync"
 )
 
@@ -36,6 +37,24 @@ var (
 		new(DPT_1024),
 		new(DPT_1100),
 
+		// 2.xxx
+		new(DPT_2001),
+		new(DPT_2002),
+		new(DPT_2003),
+		new(DPT_2004),
+		new(DPT_2005),
+		new(DPT_2006),
+		new(DPT_2007),
+		new(DPT_2008),
+		new(DPT_2009),
+		new(DPT_2010),
+		new(DPT_2011),
+		new(DPT_2012),
+
+		// 3.xxx
+		new(DPT_3007),
+		new(DPT_3008),
+
 		// 5.xxx
 		new(DPT_5001),
 		new(DPT_5003),
@@ -58,6 +77,16 @@ var (
 		new(DPT_7012),
 		new(DPT_7013),
 
+		// 8.xxx
+		new(DPT_8001),
+		new(DPT_8002),
+		new(DPT_8005),
+		new(DPT_8006),
+		new(DPT_8007),
+		new(DPT_8010),
+		new(DPT_8011),
+		new(DPT_8012),
+
 		// 9.xxx
 		new(DPT_9001),
 		new(DPT_9002),
@@ -87,6 +116,11 @@ var (
 
 		// 12.xxx
 		new(DPT_12001),
+		new(DPT_12100),
+		new(DPT_12101),
+		new(DPT_12102),
+		new(DPT_12_1200),
+		new(DPT_12_1201),
 
 		// 13.xxx
 		new(DPT_13001),
@@ -99,6 +133,8 @@ var (
 		new(DPT_13015),
 		new(DPT_13016),
 		new(DPT_13100),
+		new(DPT_13_1200),
+		new(DPT_13_1201),
 
 		// 14.xxx
 		new(DPT_14000),
@@ -182,6 +218,9 @@ var (
 		new(DPT_14078),
 		new(DPT_14079),
 
+		// 15.xxx
+		new(DPT_15000),
+
 		// 16.xxx
 		new(DPT_16000),
 		new(DPT_16001),
@@ -192,21 +231,117 @@ var (
 		// 18.xxx
 		new(DPT_18001),
 
+		// 19.xxx
+		new(DPT_19001),
+
 		// 20.xxx
+		new(DPT_20001),
+		new(DPT_20002),
+		new(DPT_20003),
+		new(DPT_20004),
+		new(DPT_20005),
+		new(DPT_20006),
+		new(DPT_20007),
+		new(DPT_20008),
+		new(DPT_20011),
+		new(DPT_20012),
+		new(DPT_20013),
+		new(DPT_20014),
+		new(DPT_20017),
+		new(DPT_20020),
+		new(DPT_20022),
+		new(DPT_20100),
+		new(DPT_20101),
 		new(DPT_20102),
+		new(DPT_20103),
+		new(DPT_20104),
 		new(DPT_20105),
+		new(DPT_20106),
+		new(DPT_20107),
+		new(DPT_20108),
+		new(DPT_20110),
+		new(DPT_20111),
+		new(DPT_20112),
+		new(DPT_20113),
+		new(DPT_20115),
+		new(DPT_20116),
+		new(DPT_20120),
+		new(DPT_20121),
+		new(DPT_20122),
+		new(DPT_20600),
+		new(DPT_20601),
+		new(DPT_20602),
+		new(DPT_20603),
+		new(DPT_20604),
+		new(DPT_20605),
+		new(DPT_20606),
+		new(DPT_20607),
+		new(DPT_20608),
+		new(DPT_20609),
+		new(DPT_20610),
+		new(DPT_20611),
+		new(DPT_20801),
+		new(DPT_20802),
+		new(DPT_20803),
+		new(DPT_20804),
+		new(DPT_20_1000),
+		new(DPT_20_1001),
+		new(DPT_20_1002),
+		new(DPT_20_1003),
+
+		// 21.xxx
+		new(DPT_21001),
+		new(DPT_21601),
+
+		// 22.xxx
+		new(DPT_22101),
+		new(DPT_22_1000),
+
+		// 24.xxx
+		new(DPT_24001),
+
+		// 26.xxx
+		new(DPT_26001),
+
+		// 27.xxx
+		new(DPT_27001),
 
 		// 28.xxx
 		new(DPT_28001),
 
+		// 29.xxx
+		new(DPT_29010),
+		new(DPT_29011),
+		new(DPT_29012),
+
+		// 225.xxx
+		new(DPT_225001),
+		new(DPT_225002),
+
+		// 232.xxx
+		new(DPT_232600),
+
+		// 235.xxx
+		new(DPT_235001),
+
 		// 242.xxx
 		new(DPT_242600),
 
+		// 246.xxx
+		new(DPT_246600),
+
+		// 249.xxx
+		new(DPT_249600),
+
+		// 250.xxx
+		new(DPT_250600),
+
 		// 251.xxx
 		new(DPT_251600),
 	}
 	once     sync.Once
 	registry map[string]reflect.Type
+	ids      map[reflect.Type]string
 )
 
 // Init function used to add all types
@@ -215,16 +350,23 @@ func setup() {
 	once.Do(func() {
 		// Register the types
 		registry = make(map[string]reflect.Type)
+		ids = make(map[reflect.Type]string)
 		for _, d := range types {
 			// Determine the name of the datatype
 			d_type := reflect.TypeOf(d).Elem()
 			name := d_type.Name()
 
-			// Convert the name into KNX yy.xxx (e.g. DPT_1001 --> 1.001) format
-			name = name[4:len(name)-3] + "." + name[len(name)-3:]
+			// Convert the name into KNX yy.xxx (e.g. DPT_1001 --> 1.001) format. Subtypes with more
+			// than three digits are separated by an underscore (e.g. DPT_20_1000 --> 20.1000).
+			if main, sub, found := strings.Cut(name[4:], "_"); found {
+				name = main + "." + sub
+			} else {
+				name = name[4:len(name)-3] + "." + name[len(name)-3:]
+			}
 
 			// Register the type
 			registry[name] = d_type
+			ids[d_type] = name
 		}
 	})
 }
@@ -259,3 +401,12 @@ func Produce(name string) (d DatapointValue, ok bool) {
 	}
 	return d, ok
 }
+
+// typeID returns the identifier of the datapoint type of the value, e.g. "1.001".
+func typeID(value DatapointValue) (string, bool) {
+	// Setup the registry
+	setup()
+
+	id, ok := ids[reflect.Indirect(reflect.ValueOf(value)).Type()]
+	return id, ok
+}
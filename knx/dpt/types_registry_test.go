@@ -10,7 +10,7 @@ func TestListSupportedTypesContainsKnownDatapoints(t *testing.T) {
 		t.Fatal("expected supported types list to be non-empty")
 	}
 
-	want := map[string]bool{"1.001": false, "5.001": false, "28.001": false}
+	want := map[string]bool{"1.001": false, "5.001": false, "20.1000": false, "28.001": false}
 	for _, name := range types {
 		if _, ok := want[name]; ok {
 			want[name] = true
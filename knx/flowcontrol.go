@@ -0,0 +1,121 @@
+package knx
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// These are the timing parameters of the KNXnet/IP routing flow control.
+const (
+	// busyRandomUnit is multiplied with the busy counter to determine the upper bound of the random
+	// time that is added to the wait time of a ROUTING_BUSY.
+	busyRandomUnit = 50 * time.Millisecond
+
+	// busyIncrementGap is the minimum time between two ROUTING_BUSY frames that both increment
+	// the busy counter.
+	busyIncrementGap = 10 * time.Millisecond
+
+	// busySlowDurationUnit is multiplied with the busy counter to determine how long after the
+	// last ROUTING_BUSY the busy counter starts to decrease.
+	busySlowDurationUnit = 100 * time.Millisecond
+
+	// busyDecrementInterval is the interval with which the busy counter decreases.
+	busyDecrementInterval = 5 * time.Millisecond
+)
+
+// flowControl decides when the next routing indication may be sent. It limits the rate of
+// transmissions and pauses them as requested by ROUTING_BUSY frames.
+type flowControl struct {
+	clock       clock
+	random      func() float64
+	minInterval time.Duration
+
+	mu          sync.Mutex
+	lastSend    time.Time
+	pausedUntil time.Time
+	busyCounter uint
+	lastBusy    time.Time
+}
+
+// newFlowControl creates a flow control which keeps at least minInterval between transmissions.
+func newFlowControl(clock clock, minInterval time.Duration) *flowControl {
+	return &flowControl{
+		clock:       clock,
+		random:      rand.Float64,
+		minInterval: minInterval,
+	}
+}
+
+// counter computes the busy counter at the given time. The counter decreases by one every
+// busyDecrementInterval once the slow duration since the last ROUTING_BUSY has elapsed.
+func (fc *flowControl) counter(now time.Time) uint {
+	slowDuration := time.Duration(fc.busyCounter) * busySlowDurationUnit
+	elapsed := now.Sub(fc.lastBusy)
+
+	if elapsed <= slowDuration {
+		return fc.busyCounter
+	}
+
+	decrements := uint((elapsed - slowDuration) / busyDecrementInterval)
+	if decrements >= fc.busyCounter {
+		return 0
+	}
+
+	return fc.busyCounter - decrements
+}
+
+// handleBusy processes a ROUTING_BUSY with the given wait time and control field. If the control
+// field is 0, a random time which grows with the number of recent ROUTING_BUSY frames is added to
+// the wait time, so that not all devices resume sending at once.
+func (fc *flowControl) handleBusy(waitTime time.Duration, control uint16) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	now := fc.clock.Now()
+
+	counter := fc.counter(now)
+	if fc.lastBusy.IsZero() || now.Sub(fc.lastBusy) >= busyIncrementGap {
+		counter++
+	}
+
+	fc.busyCounter = counter
+	fc.lastBusy = now
+
+	if control == 0 {
+		waitTime += time.Duration(fc.random() * float64(time.Duration(counter)*busyRandomUnit))
+	}
+
+	if until := now.Add(waitTime); until.After(fc.pausedUntil) {
+		fc.pausedUntil = until
+	}
+}
+
+// delay determines how long to wait until the next transmission is permitted.
+func (fc *flowControl) delay() time.Duration {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	now := fc.clock.Now()
+	next := fc.pausedUntil
+
+	if !fc.lastSend.IsZero() {
+		if rateLimit := fc.lastSend.Add(fc.minInterval); rateLimit.After(next) {
+			next = rateLimit
+		}
+	}
+
+	if delay := next.Sub(now); delay > 0 {
+		return delay
+	}
+
+	return 0
+}
+
+// sent records a transmission.
+func (fc *flowControl) sent() {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	fc.lastSend = fc.clock.Now()
+}
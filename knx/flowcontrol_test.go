@@ -0,0 +1,200 @@
+package knx
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a clock whose time only advances when requested.
+type fakeClock struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	now    time.Time
+	timers []*fakeTimer
+}
+
+func newFakeClock() *fakeClock {
+	clock := &fakeClock{now: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+	clock.cond = sync.NewCond(&clock.mu)
+
+	return clock
+}
+
+func (clock *fakeClock) Now() time.Time {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+
+	return clock.now
+}
+
+func (clock *fakeClock) NewTimer(d time.Duration) clockTimer {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+
+	timer := &fakeTimer{clock: clock, deadline: clock.now.Add(d), c: make(chan time.Time, 1)}
+	clock.timers = append(clock.timers, timer)
+	clock.cond.Broadcast()
+
+	return timer
+}
+
+// Advance moves the time forward and fires all timers that are due.
+func (clock *fakeClock) Advance(d time.Duration) {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+
+	clock.now = clock.now.Add(d)
+
+	pending := clock.timers[:0]
+	for _, timer := range clock.timers {
+		if timer.deadline.After(clock.now) {
+			pending = append(pending, timer)
+		} else {
+			timer.c <- clock.now
+		}
+	}
+
+	clock.timers = pending
+}
+
+// waitTimer blocks until a timer is pending and returns its remaining duration.
+func (clock *fakeClock) waitTimer() time.Duration {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+
+	for len(clock.timers) == 0 {
+		clock.cond.Wait()
+	}
+
+	return clock.timers[0].deadline.Sub(clock.now)
+}
+
+type fakeTimer struct {
+	clock    *fakeClock
+	deadline time.Time
+	c        chan time.Time
+}
+
+func (timer *fakeTimer) C() <-chan time.Time {
+	return timer.c
+}
+
+func (timer *fakeTimer) Stop() bool {
+	timer.clock.mu.Lock()
+	defer timer.clock.mu.Unlock()
+
+	for i, other := range timer.clock.timers {
+		if other == timer {
+			timer.clock.timers = append(timer.clock.timers[:i], timer.clock.timers[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestFlowControl_RateLimit(t *testing.T) {
+	clock := newFakeClock()
+	fc := newFlowControl(clock, 20*time.Millisecond)
+
+	if delay := fc.delay(); delay != 0 {
+		t.Fatalf("Unexpected delay before first transmission: %v", delay)
+	}
+
+	fc.sent()
+
+	if delay := fc.delay(); delay != 20*time.Millisecond {
+		t.Errorf("Unexpected delay after transmission: %v", delay)
+	}
+
+	clock.Advance(15 * time.Millisecond)
+
+	if delay := fc.delay(); delay != 5*time.Millisecond {
+		t.Errorf("Unexpected delay: %v", delay)
+	}
+
+	clock.Advance(5 * time.Millisecond)
+
+	if delay := fc.delay(); delay != 0 {
+		t.Errorf("Unexpected delay: %v", delay)
+	}
+}
+
+func TestFlowControl_Busy(t *testing.T) {
+	clock := newFakeClock()
+	fc := newFlowControl(clock, 0)
+	fc.random = func() float64 { return 0.5 }
+
+	// The first busy frame sets the counter to 1, hence up to 50 ms are added.
+	fc.handleBusy(100*time.Millisecond, 0)
+
+	if fc.busyCounter != 1 {
+		t.Errorf("Unexpected busy counter: %d", fc.busyCounter)
+	}
+
+	if delay := fc.delay(); delay != 125*time.Millisecond {
+		t.Errorf("Unexpected delay: %v", delay)
+	}
+
+	// Busy frames within 10 ms do not increment the counter.
+	clock.Advance(5 * time.Millisecond)
+	fc.handleBusy(100*time.Millisecond, 0)
+
+	if fc.busyCounter != 1 {
+		t.Errorf("Unexpected busy counter: %d", fc.busyCounter)
+	}
+
+	clock.Advance(10 * time.Millisecond)
+	fc.handleBusy(100*time.Millisecond, 0)
+
+	if fc.busyCounter != 2 {
+		t.Errorf("Unexpected busy counter: %d", fc.busyCounter)
+	}
+
+	if delay := fc.delay(); delay != 150*time.Millisecond {
+		t.Errorf("Unexpected delay: %v", delay)
+	}
+
+	// A control field other than 0 means that no random time is added. The pause is never
+	// shortened, though.
+	fc.handleBusy(20*time.Millisecond, 1)
+
+	if delay := fc.delay(); delay != 150*time.Millisecond {
+		t.Errorf("Unexpected delay: %v", delay)
+	}
+}
+
+func TestFlowControl_CounterDecay(t *testing.T) {
+	clock := newFakeClock()
+	fc := newFlowControl(clock, 0)
+	fc.random = func() float64 { return 0 }
+
+	fc.handleBusy(0, 0)
+	clock.Advance(busyIncrementGap)
+	fc.handleBusy(0, 0)
+
+	if counter := fc.counter(clock.Now()); counter != 2 {
+		t.Fatalf("Unexpected busy counter: %d", counter)
+	}
+
+	// The counter stays until 2 * 100 ms have elapsed.
+	clock.Advance(200 * time.Millisecond)
+
+	if counter := fc.counter(clock.Now()); counter != 2 {
+		t.Errorf("Unexpected busy counter: %d", counter)
+	}
+
+	// Then it decreases every 5 ms.
+	clock.Advance(busyDecrementInterval)
+
+	if counter := fc.counter(clock.Now()); counter != 1 {
+		t.Errorf("Unexpected busy counter: %d", counter)
+	}
+
+	clock.Advance(busyDecrementInterval)
+
+	if counter := fc.counter(clock.Now()); counter != 0 {
+		t.Errorf("Unexpected busy counter: %d", counter)
+	}
+}
@@ -0,0 +1,234 @@
+// Package ft12 provides the means to parse and generate frames of the FT1.2 protocol, which is
+// used by serial KNX interfaces.
+package ft12
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// These are the delimiting characters of FT1.2 frames.
+const (
+	startFixed    = 0x10
+	startVariable = 0x68
+	endChar       = 0x16
+	ackChar       = 0xE5
+)
+
+// MaxDataLength is the maximum amount of user data in a frame with variable length.
+const MaxDataLength = 254
+
+// Control is the control field of a frame.
+type Control uint8
+
+const (
+	// ControlReset is the control field of a reset request sent by the host.
+	ControlReset Control = 0x40
+
+	// ControlData is the control field of a user data frame sent by the host, excluding the frame
+	// count bit.
+	ControlData Control = 0x53
+
+	// ControlFCB is the frame count bit. It alternates between successive user data frames in
+	// order to detect repetitions.
+	ControlFCB Control = 0x20
+
+	// ControlFCV indicates that the frame count bit is valid.
+	ControlFCV Control = 0x10
+
+	// ControlDir is set on frames that have been sent by the bus interface.
+	ControlDir Control = 0x80
+)
+
+// Function retrieves the function code.
+func (ctrl Control) Function() uint8 {
+	return uint8(ctrl & 0x0F)
+}
+
+// String generates a string representation of the control field.
+func (ctrl Control) String() string {
+	return fmt.Sprintf("%#02x", uint8(ctrl))
+}
+
+// Kind identifies the kind of a frame.
+type Kind uint8
+
+const (
+	// KindAck is a single character acknowledgement.
+	KindAck Kind = iota
+
+	// KindFixed is a frame with fixed length which carries no user data.
+	KindFixed
+
+	// KindVariable is a frame with variable length which carries user data.
+	KindVariable
+)
+
+// A Frame is an FT1.2 frame.
+type Frame struct {
+	Kind    Kind
+	Control Control
+	Data    []byte
+}
+
+// NewAck creates an acknowledgement.
+func NewAck() Frame {
+	return Frame{Kind: KindAck}
+}
+
+// NewFixed creates a frame with fixed length.
+func NewFixed(control Control) Frame {
+	return Frame{Kind: KindFixed, Control: control}
+}
+
+// NewVariable creates a frame with variable length.
+func NewVariable(control Control, data []byte) Frame {
+	return Frame{Kind: KindVariable, Control: control, Data: data}
+}
+
+// dataLength determines the amount of user data which will be packed.
+func (frame *Frame) dataLength() int {
+	if len(frame.Data) > MaxDataLength {
+		return MaxDataLength
+	}
+
+	return len(frame.Data)
+}
+
+// Size returns the packed size.
+func (frame *Frame) Size() uint {
+	switch frame.Kind {
+	case KindAck:
+		return 1
+
+	case KindFixed:
+		return 4
+
+	default:
+		return 7 + uint(frame.dataLength())
+	}
+}
+
+// Pack the frame into the buffer.
+func (frame *Frame) Pack(buffer []byte) {
+	switch frame.Kind {
+	case KindAck:
+		buffer[0] = ackChar
+
+	case KindFixed:
+		buffer[0] = startFixed
+		buffer[1] = byte(frame.Control)
+		buffer[2] = byte(frame.Control)
+		buffer[3] = endChar
+
+	default:
+		dataLength := frame.dataLength()
+
+		buffer[0] = startVariable
+		buffer[1] = byte(dataLength + 1)
+		buffer[2] = byte(dataLength + 1)
+		buffer[3] = startVariable
+		buffer[4] = byte(frame.Control)
+		copy(buffer[5:], frame.Data[:dataLength])
+		buffer[5+dataLength] = checksum(buffer[4 : 5+dataLength])
+		buffer[6+dataLength] = endChar
+	}
+}
+
+// checksum computes the arithmetic sum of the given bytes.
+func checksum(data []byte) (sum byte) {
+	for _, b := range data {
+		sum += b
+	}
+
+	return
+}
+
+var (
+	// ErrChecksum indicates that a frame has been discarded due to a checksum mismatch.
+	ErrChecksum = errors.New("ft12: checksum mismatch")
+
+	// ErrMalformed indicates that a frame has been discarded because it is not well-formed.
+	ErrMalformed = errors.New("ft12: malformed frame")
+)
+
+// A Reader reads frames from a byte stream.
+type Reader struct {
+	r *bufio.Reader
+}
+
+// NewReader creates a Reader which reads from the given stream.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+// ReadFrame reads the next frame. Characters preceding a start character are skipped. If a frame
+// is corrupted, ErrChecksum or ErrMalformed is returned and the caller may continue reading. Any
+// other error originates from the underlying stream.
+func (reader *Reader) ReadFrame() (Frame, error) {
+	for {
+		start, err := reader.r.ReadByte()
+		if err != nil {
+			return Frame{}, err
+		}
+
+		switch start {
+		case ackChar:
+			return NewAck(), nil
+
+		case startFixed:
+			return reader.readFixed()
+
+		case startVariable:
+			return reader.readVariable()
+		}
+	}
+}
+
+// readFixed reads the remainder of a frame with fixed length.
+func (reader *Reader) readFixed() (Frame, error) {
+	var buffer [3]byte
+	if _, err := io.ReadFull(reader.r, buffer[:]); err != nil {
+		return Frame{}, err
+	}
+
+	if buffer[2] != endChar {
+		return Frame{}, ErrMalformed
+	}
+
+	if buffer[1] != buffer[0] {
+		return Frame{}, ErrChecksum
+	}
+
+	return NewFixed(Control(buffer[0])), nil
+}
+
+// readVariable reads the remainder of a frame with variable length.
+func (reader *Reader) readVariable() (Frame, error) {
+	var header [3]byte
+	if _, err := io.ReadFull(reader.r, header[:]); err != nil {
+		return Frame{}, err
+	}
+
+	if header[0] != header[1] || header[2] != startVariable || header[0] < 1 {
+		return Frame{}, ErrMalformed
+	}
+
+	// Control field, user data, checksum and end character.
+	buffer := make([]byte, int(header[0])+2)
+	if _, err := io.ReadFull(reader.r, buffer); err != nil {
+		return Frame{}, err
+	}
+
+	if buffer[len(buffer)-1] != endChar {
+		return Frame{}, ErrMalformed
+	}
+
+	if checksum(buffer[:len(buffer)-2]) != buffer[len(buffer)-2] {
+		return Frame{}, ErrChecksum
+	}
+
+	return NewVariable(Control(buffer[0]), buffer[1:len(buffer)-2]), nil
+}
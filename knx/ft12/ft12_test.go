@@ -0,0 +1,87 @@
+package ft12
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/knx-go/knx-go/knx/util"
+)
+
+func TestFrame_Pack(t *testing.T) {
+	cases := []struct {
+		frame    Frame
+		expected []byte
+	}{
+		{NewAck(), []byte{0xE5}},
+		{NewFixed(ControlReset), []byte{0x10, 0x40, 0x40, 0x16}},
+		{
+			NewVariable(ControlData|ControlFCB, []byte{0x11, 0x00, 0xBC}),
+			[]byte{0x68, 0x04, 0x04, 0x68, 0x73, 0x11, 0x00, 0xBC, 0x40, 0x16},
+		},
+	}
+
+	for _, c := range cases {
+		data := util.AllocAndPack(&c.frame)
+		if !bytes.Equal(data, c.expected) {
+			t.Errorf("Unexpected packed frame: % X, expected % X", data, c.expected)
+		}
+	}
+}
+
+func TestReader_ReadFrame(t *testing.T) {
+	stream := []byte{
+		0x00, 0xFF, // Garbage before the first frame
+		0xE5,
+		0x10, 0xC0, 0xC0, 0x16,
+		0x68, 0x04, 0x04, 0x68, 0xF3, 0x29, 0x00, 0xBC, 0xD8, 0x16,
+	}
+
+	reader := NewReader(bytes.NewReader(stream))
+
+	frame, err := reader.ReadFrame()
+	if err != nil || frame.Kind != KindAck {
+		t.Fatalf("Expected acknowledgement, got %+v %v", frame, err)
+	}
+
+	frame, err = reader.ReadFrame()
+	if err != nil || frame.Kind != KindFixed || frame.Control != 0xC0 {
+		t.Fatalf("Expected fixed frame, got %+v %v", frame, err)
+	}
+
+	frame, err = reader.ReadFrame()
+	if err != nil || frame.Kind != KindVariable || frame.Control != 0xF3 {
+		t.Fatalf("Expected variable frame, got %+v %v", frame, err)
+	}
+
+	if !bytes.Equal(frame.Data, []byte{0x29, 0x00, 0xBC}) {
+		t.Errorf("Unexpected user data: % X", frame.Data)
+	}
+
+	if _, err := reader.ReadFrame(); err != io.EOF {
+		t.Errorf("Expected EOF, got %v", err)
+	}
+}
+
+func TestReader_ReadFrameCorrupted(t *testing.T) {
+	cases := []struct {
+		stream   []byte
+		expected error
+	}{
+		{[]byte{0x10, 0x40, 0x41, 0x16}, ErrChecksum},
+		{[]byte{0x10, 0x40, 0x40, 0x17}, ErrMalformed},
+		{[]byte{0x68, 0x04, 0x05, 0x68}, ErrMalformed},
+		{[]byte{0x68, 0x00, 0x00, 0x68}, ErrMalformed},
+		{[]byte{0x68, 0x02, 0x02, 0x68, 0xF3, 0x29, 0x00, 0x16}, ErrChecksum},
+		{[]byte{0x68, 0x02, 0x02, 0x68, 0xF3, 0x29, 0x1C, 0x00}, ErrMalformed},
+		{[]byte{0x68, 0x02, 0x02, 0x68, 0xF3}, io.ErrUnexpectedEOF},
+	}
+
+	for _, c := range cases {
+		_, err := NewReader(bytes.NewReader(c.stream)).ReadFrame()
+		if !errors.Is(err, c.expected) {
+			t.Errorf("Expected %v for % X, got %v", c.expected, c.stream, err)
+		}
+	}
+}
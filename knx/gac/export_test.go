@@ -39,6 +39,15 @@ const freeExchange = `<?xml version="1.0" encoding="utf-8" standalone="yes"?>
   </GroupRange>
 </GroupAddress-Export>`
 
+const meteringExchange = `<?xml version="1.0" encoding="utf-8" standalone="yes"?>
+<GroupAddress-Export xmlns="http://knx.org/xml/ga-export/01">
+  <GroupRange Name="Root" RangeStart="1" RangeEnd="512">
+    <GroupAddress Name="water" Address="1" DPTs="DPST-12-1200" />
+    <GroupAddress Name="gas" Address="2" DPTs="DPST-13-1201" />
+    <GroupAddress Name="energy" Address="3" DPTs="DPST-235-1" />
+  </GroupRange>
+</GroupAddress-Export>`
+
 func TestImportCatalog(t *testing.T) {
 	catalog, err := Import(strings.NewReader(sampleExchange))
 	if err != nil {
@@ -102,6 +111,24 @@ func TestImportCatalogInvalidDPT(t *testing.T) {
 	}
 }
 
+func TestImportCatalogMeteringDPTs(t *testing.T) {
+	catalog, err := Import(strings.NewReader(meteringExchange))
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	expected := map[string]string{"water": "12.1200", "gas": "13.1201", "energy": "235.001"}
+	for name, want := range expected {
+		group, ok := catalog.Lookup(name)
+		if !ok {
+			t.Fatalf("Lookup(%s) returned false", name)
+		}
+		if len(group.DPTs) != 1 || string(group.DPTs[0]) != want {
+			t.Errorf("%s DPTs = %v, want [%s]", name, group.DPTs, want)
+		}
+	}
+}
+
 func TestImportCatalogTwoLevelStyle(t *testing.T) {
 	catalog, err := Import(strings.NewReader(twoLevelExchange))
 	if err != nil {
@@ -149,6 +176,7 @@ func TestExportRoundTrip(t *testing.T) {
 		{name: "ThreeLevel", input: sampleExchange, style: cemi.GroupAddrFormatThreeLevels},
 		{name: "TwoLevel", input: twoLevelExchange, style: cemi.GroupAddrFormatTwoLevels},
 		{name: "Free", input: freeExchange, style: cemi.GroupAddrFormatFree},
+		{name: "Metering", input: meteringExchange, style: cemi.GroupAddrFormatThreeLevels},
 	}
 
 	for _, tc := range testCases {
@@ -4,8 +4,9 @@
 package knx
 
 import (
+	"log/slog"
+
 	"github.com/knx-go/knx-go/knx/cemi"
-	"github.com/knx-go/knx-go/knx/util"
 )
 
 // GroupCommand determines the meaning of a group event.
@@ -27,7 +28,8 @@ func (cmd GroupCommand) String() string {
 	return "Unknown"
 }
 
-// These are known group commands.
+// These are known group commands. Their values correspond to the upper 4 bits of the respective
+// APCI.
 const (
 	GroupRead     GroupCommand = 0
 	GroupResponse GroupCommand = 1
@@ -49,30 +51,30 @@ type GroupClient interface {
 }
 
 // serveGroupInbound serves a group communication.
-func serveGroupInbound(inbound <-chan cemi.Message, outbound chan<- GroupEvent) {
-	util.Log(inbound, "Started worker")
-	defer util.Log(inbound, "Worker exited")
+func serveGroupInbound(inbound <-chan cemi.Message, outbound chan<- GroupEvent, log *slog.Logger) {
+	log.Debug("Started worker")
+	defer log.Debug("Worker exited")
 
 	for msg := range inbound {
 		if ind, ok := msg.(*cemi.LDataInd); ok {
 			// Filter indications that do not target group addresses.
 			if !ind.Control2.IsGroupAddr() {
-				util.Log(inbound, "Received L_Data.ind does not target a group address")
+				log.Debug("Received L_Data.ind does not target a group address", "destination", ind.Destination)
 				continue
 			}
 
 			if app, ok := ind.Data.(*cemi.AppData); ok && app.Command.IsGroupCommand() {
 				outbound <- GroupEvent{
-					Command:     GroupCommand(app.Command),
+					Command:     GroupCommand(app.Command >> 6),
 					Source:      ind.Source,
 					Destination: cemi.GroupAddr(ind.Destination),
 					Data:        app.Data,
 				}
 			} else {
-				util.Log(inbound, "Received L_Data.ind frame does not contain application data")
+				log.Debug("Received L_Data.ind frame does not contain application data", "source", ind.Source)
 			}
 		} else {
-			util.Log(inbound, "Received frame is not a L_Data.ind frame")
+			log.Debug("Received frame is not a L_Data.ind frame", "code", msg.MessageCode())
 		}
 	}
 
@@ -88,7 +90,7 @@ var defaultGroupLData = cemi.LData{
 func buildGroupOutbound(event GroupEvent) cemi.LData {
 	ldata := defaultGroupLData
 	ldata.Data = &cemi.AppData{
-		Command: cemi.APCI(event.Command),
+		Command: cemi.APCI(event.Command) << 6,
 		Data:    event.Data,
 	}
 	ldata.Source = event.Source
@@ -0,0 +1,183 @@
+package knx
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/knx-go/knx-go/knx/cemi"
+)
+
+// OverflowPolicy determines what happens to incoming messages when the inbound buffer of a client
+// is full, because the messages are not received fast enough.
+type OverflowPolicy uint8
+
+const (
+	// OverflowDropOldest discards the oldest buffered message to make room for the new one. This is
+	// the default.
+	OverflowDropOldest OverflowPolicy = iota
+
+	// OverflowDropNewest discards the new message.
+	OverflowDropNewest
+
+	// OverflowBlock waits until there is room for the new message. Note that this stalls the
+	// client's worker, which might miss heartbeats or acknowledgements in the meantime.
+	OverflowBlock
+)
+
+// String generates a string representation of the policy.
+func (policy OverflowPolicy) String() string {
+	switch policy {
+	case OverflowDropOldest:
+		return "drop oldest"
+
+	case OverflowDropNewest:
+		return "drop newest"
+
+	case OverflowBlock:
+		return "block"
+
+	default:
+		return fmt.Sprintf("unknown overflow policy %d", uint8(policy))
+	}
+}
+
+// inboundBuffer is a bounded ring buffer which decouples a client's worker from the user. The
+// messages are delivered in order through an unbuffered channel.
+type inboundBuffer struct {
+	// Accessed atomically, hence it comes first to guarantee its alignment.
+	dropped uint64
+
+	policy  OverflowPolicy
+	out     chan cemi.Message
+	abortCh chan struct{}
+
+	mu      sync.Mutex
+	cond    *sync.Cond
+	ring    []cemi.Message
+	head    int
+	count   int
+	closed  bool
+	aborted bool
+}
+
+// newInboundBuffer creates a buffer for up to size messages.
+func newInboundBuffer(size uint, policy OverflowPolicy) *inboundBuffer {
+	buf := &inboundBuffer{
+		policy:  policy,
+		out:     make(chan cemi.Message),
+		abortCh: make(chan struct{}),
+		ring:    make([]cemi.Message, size),
+	}
+	buf.cond = sync.NewCond(&buf.mu)
+
+	go buf.serve()
+
+	return buf
+}
+
+// push adds a message to the buffer. Depending on the policy, it discards a message or blocks if
+// the buffer is full.
+func (buf *inboundBuffer) push(msg cemi.Message) {
+	buf.mu.Lock()
+	defer buf.mu.Unlock()
+
+	for buf.count == len(buf.ring) && !buf.closed {
+		switch buf.policy {
+		case OverflowBlock:
+			buf.cond.Wait()
+
+		case OverflowDropNewest:
+			atomic.AddUint64(&buf.dropped, 1)
+			return
+
+		default:
+			buf.ring[buf.head] = nil
+			buf.head = (buf.head + 1) % len(buf.ring)
+			buf.count--
+			atomic.AddUint64(&buf.dropped, 1)
+		}
+	}
+
+	if buf.closed {
+		return
+	}
+
+	buf.ring[(buf.head+buf.count)%len(buf.ring)] = msg
+	buf.count++
+	buf.cond.Broadcast()
+}
+
+// pop removes the oldest message from the buffer. It blocks until a message is available and
+// returns false once the buffer has been closed and drained, or aborted.
+func (buf *inboundBuffer) pop() (cemi.Message, bool) {
+	buf.mu.Lock()
+	defer buf.mu.Unlock()
+
+	for buf.count == 0 && !buf.closed && !buf.aborted {
+		buf.cond.Wait()
+	}
+
+	if buf.count == 0 || buf.aborted {
+		return nil, false
+	}
+
+	msg := buf.ring[buf.head]
+	buf.ring[buf.head] = nil
+	buf.head = (buf.head + 1) % len(buf.ring)
+	buf.count--
+	buf.cond.Broadcast()
+
+	return msg, true
+}
+
+// serve delivers the buffered messages to the user.
+func (buf *inboundBuffer) serve() {
+	defer close(buf.out)
+
+	for {
+		msg, ok := buf.pop()
+		if !ok {
+			return
+		}
+
+		select {
+		case buf.out <- msg:
+		case <-buf.abortCh:
+			return
+		}
+	}
+}
+
+// close stops accepting messages. The remaining messages are still delivered, afterwards the
+// channel is closed.
+func (buf *inboundBuffer) close() {
+	buf.mu.Lock()
+	defer buf.mu.Unlock()
+
+	buf.closed = true
+	buf.cond.Broadcast()
+}
+
+// abort stops accepting messages and discards those which have not been delivered yet.
+func (buf *inboundBuffer) abort() {
+	buf.mu.Lock()
+	defer buf.mu.Unlock()
+
+	if !buf.aborted {
+		buf.closed = true
+		buf.aborted = true
+		close(buf.abortCh)
+		buf.cond.Broadcast()
+	}
+}
+
+// channel returns the channel through which the messages are delivered.
+func (buf *inboundBuffer) channel() <-chan cemi.Message {
+	return buf.out
+}
+
+// droppedCount returns the number of messages which have been discarded due to an overflow.
+func (buf *inboundBuffer) droppedCount() uint64 {
+	return atomic.LoadUint64(&buf.dropped)
+}
@@ -0,0 +1,187 @@
+package knx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/knx-go/knx-go/knx/cemi"
+)
+
+// receiveIDs receives count messages and returns their ids.
+func receiveIDs(t *testing.T, inbound <-chan cemi.Message, count int) []int {
+	t.Helper()
+
+	ids := make([]int, 0, count)
+
+	for len(ids) < count {
+		select {
+		case msg, open := <-inbound:
+			if !open {
+				t.Fatalf("Inbound channel closed after %v", ids)
+			}
+
+			ids = append(ids, msg.(*stubMessage).id)
+
+		case <-time.After(time.Second):
+			t.Fatalf("Timed out after %v", ids)
+		}
+	}
+
+	return ids
+}
+
+func expectIDs(t *testing.T, got []int, want ...int) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("Unexpected messages %v, want %v", got, want)
+	}
+
+	for i := range got {
+		if got[i] != want[i] {
+			t.Fatalf("Unexpected messages %v, want %v", got, want)
+		}
+	}
+}
+
+// fillInbound pushes messages until the buffer holds size messages while one further message waits
+// for its delivery.
+func fillInbound(buf *inboundBuffer, ids ...int) {
+	for _, id := range ids {
+		buf.push(&stubMessage{id: id})
+
+		// Give the delivery goroutine the chance to pick up the first message.
+		if id == ids[0] {
+			for {
+				buf.mu.Lock()
+				count := buf.count
+				buf.mu.Unlock()
+
+				if count == 0 {
+					break
+				}
+
+				time.Sleep(time.Millisecond)
+			}
+		}
+	}
+}
+
+func TestInboundBuffer_Order(t *testing.T) {
+	buf := newInboundBuffer(4, OverflowBlock)
+	defer buf.abort()
+
+	go func() {
+		for i := 0; i < 100; i++ {
+			buf.push(&stubMessage{id: i})
+		}
+	}()
+
+	ids := receiveIDs(t, buf.channel(), 100)
+	for i, id := range ids {
+		if id != i {
+			t.Fatalf("Unexpected order %v", ids)
+		}
+	}
+
+	if dropped := buf.droppedCount(); dropped != 0 {
+		t.Errorf("Unexpected number of dropped messages: %d", dropped)
+	}
+}
+
+func TestInboundBuffer_DropOldest(t *testing.T) {
+	buf := newInboundBuffer(2, OverflowDropOldest)
+	defer buf.abort()
+
+	fillInbound(buf, 1, 2, 3, 4, 5)
+
+	expectIDs(t, receiveIDs(t, buf.channel(), 3), 1, 4, 5)
+
+	if dropped := buf.droppedCount(); dropped != 2 {
+		t.Errorf("Unexpected number of dropped messages: %d", dropped)
+	}
+}
+
+func TestInboundBuffer_DropNewest(t *testing.T) {
+	buf := newInboundBuffer(2, OverflowDropNewest)
+	defer buf.abort()
+
+	fillInbound(buf, 1, 2, 3, 4, 5)
+
+	expectIDs(t, receiveIDs(t, buf.channel(), 3), 1, 2, 3)
+
+	if dropped := buf.droppedCount(); dropped != 2 {
+		t.Errorf("Unexpected number of dropped messages: %d", dropped)
+	}
+}
+
+func TestInboundBuffer_Block(t *testing.T) {
+	buf := newInboundBuffer(1, OverflowBlock)
+	defer buf.abort()
+
+	fillInbound(buf, 1, 2)
+
+	pushed := make(chan struct{})
+	go func() {
+		buf.push(&stubMessage{id: 3})
+		close(pushed)
+	}()
+
+	select {
+	case <-pushed:
+		t.Fatal("Push should block while the buffer is full")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	expectIDs(t, receiveIDs(t, buf.channel(), 3), 1, 2, 3)
+	<-pushed
+}
+
+func TestInboundBuffer_Close(t *testing.T) {
+	buf := newInboundBuffer(4, OverflowBlock)
+
+	buf.push(&stubMessage{id: 1})
+	buf.push(&stubMessage{id: 2})
+	buf.close()
+
+	// Messages which arrive after closing are ignored.
+	buf.push(&stubMessage{id: 3})
+
+	expectIDs(t, receiveIDs(t, buf.channel(), 2), 1, 2)
+
+	if _, open := <-buf.channel(); open {
+		t.Error("Inbound channel should be closed")
+	}
+}
+
+func TestInboundBuffer_Abort(t *testing.T) {
+	buf := newInboundBuffer(1, OverflowBlock)
+
+	fillInbound(buf, 1, 2)
+
+	pushed := make(chan struct{})
+	go func() {
+		buf.push(&stubMessage{id: 3})
+		close(pushed)
+	}()
+
+	buf.abort()
+
+	select {
+	case <-pushed:
+	case <-time.After(time.Second):
+		t.Fatal("Aborting should release a blocked push")
+	}
+
+	select {
+	case _, open := <-buf.channel():
+		if open {
+			// The message which was already waiting for its delivery may still be received.
+			if _, open := <-buf.channel(); open {
+				t.Error("Inbound channel should be closed")
+			}
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Inbound channel should be closed")
+	}
+}
@@ -4,6 +4,7 @@ package knxnet
 
 import (
 	"errors"
+	"io"
 	"net"
 
 	"github.com/knx-go/knx-go/knx/cemi"
@@ -233,60 +234,97 @@ func (f *ServiceFamily) Unpack(data []byte) (n uint, err error) {
 type DescriptionBlock struct {
 	DeviceHardware    DeviceInformationBlock
 	SupportedServices SupportedServicesDIB
+	IPConfig          *IPConfigDIB
+	IPCurrentConfig   *IPCurrentConfigDIB
 	UnknownBlocks     []UnknownDescriptionBlock
 }
 
+// Size returns the packed size. Unknown blocks are not included.
+func (di DescriptionBlock) Size() uint {
+	size := di.DeviceHardware.Size() + di.SupportedServices.Size()
+
+	if di.IPConfig != nil {
+		size += di.IPConfig.Size()
+	}
+
+	if di.IPCurrentConfig != nil {
+		size += di.IPCurrentConfig.Size()
+	}
+
+	return size
+}
+
+// Pack assembles the description blocks in the given buffer. Unknown blocks are not included.
+func (di *DescriptionBlock) Pack(buffer []byte) {
+	util.PackSome(buffer, &di.DeviceHardware, &di.SupportedServices)
+
+	n := di.DeviceHardware.Size() + di.SupportedServices.Size()
+
+	if di.IPConfig != nil {
+		di.IPConfig.Pack(buffer[n:])
+		n += di.IPConfig.Size()
+	}
+
+	if di.IPCurrentConfig != nil {
+		di.IPCurrentConfig.Pack(buffer[n:])
+	}
+}
+
 // Unpack parses the given service payload in order to initialize the Description Block.
 // It can cope with not in sequence and unknown Device Information Blocks (DIB).
 func (di *DescriptionBlock) Unpack(data []byte) (n uint, err error) {
-	var length uint8
-	var ty DescriptionType
-
-	n = 0
 	for n < uint(len(data)) {
 		// DIBs should always have a length and a type.
-		_, err := util.UnpackSome(data[n:], &length, (*uint8)(&ty))
-		if err != nil {
-			return 0, err
+		if uint(len(data))-n < 2 {
+			return n, io.ErrUnexpectedEOF
+		}
+
+		length := uint(data[n])
+		ty := DescriptionType(data[n+1])
+
+		if length < 2 || n+length > uint(len(data)) {
+			return n, errors.New("description information block length is invalid")
 		}
 
+		block := data[n : n+length]
+
 		switch ty {
 		case DescriptionTypeDeviceInfo:
-			_, err = di.DeviceHardware.Unpack(data[n : n+uint(length)])
-			if err != nil {
-				return 0, err
-			}
-			n += uint(length)
+			_, err = di.DeviceHardware.Unpack(block)
 
 		case DescriptionTypeSupportedServiceFamilies:
-			_, err = di.SupportedServices.Unpack(data[n : n+uint(length)])
-			if err != nil {
-				return 0, err
-			}
-			n += uint(length)
+			_, err = di.SupportedServices.Unpack(block)
+
+		case DescriptionTypeIPConfig:
+			di.IPConfig = &IPConfigDIB{}
+			_, err = di.IPConfig.Unpack(block)
 
-		case DescriptionTypeIPConfig, DescriptionTypeIPCurrentConfig,
-			DescriptionTypeKNXAddresses, DescriptionTypeManufacturerData:
+		case DescriptionTypeIPCurrentConfig:
+			di.IPCurrentConfig = &IPCurrentConfigDIB{}
+			_, err = di.IPCurrentConfig.Unpack(block)
+
+		case DescriptionTypeKNXAddresses, DescriptionTypeManufacturerData:
 			u := UnknownDescriptionBlock{Type: ty}
 
 			// known DIBs without data will be silently ignored.
 			if length > 2 {
-				_, err = u.Unpack(data[n+2 : n+uint(length)-2])
-				if err != nil {
-					return 0, err
-				}
+				_, err = u.Unpack(block[2:])
 				di.UnknownBlocks = append(di.UnknownBlocks, u)
-				util.Log(di, "DIB not parsed: 0x%02x", ty)
+				util.DefaultLogger().Debug("DIB not parsed", "type", ty)
 			}
-			n += uint(length)
 
 		default:
-			util.Log(di, "Found unsupported DIB with code: 0x%02x", ty)
-			n += uint(length)
+			util.DefaultLogger().Debug("Found unsupported DIB", "type", ty)
 		}
+
+		if err != nil {
+			return n, err
+		}
+
+		n += length
 	}
 
-	return n, err
+	return n, nil
 }
 
 // UnknownDescriptionBlock is a placeholder for unknown DIBs.
@@ -0,0 +1,138 @@
+package knxnet
+
+import (
+	"testing"
+)
+
+// fuzzPackets are KNXnet/IP packets captured from real installations.
+var fuzzPackets = [][]byte{
+	// ROUTING_INDICATION with a GroupValueWrite
+	{
+		0x06, 0x10, 0x05, 0x30, 0x00, 0x13,
+		0x29, 0x00, 0xbc, 0xe0, 0x11, 0x01, 0x0a, 0x03, 0x03, 0x00, 0x80, 0x0c, 0x1a,
+	},
+	// ROUTING_LOST_MESSAGE
+	{0x06, 0x10, 0x05, 0x31, 0x00, 0x0a, 0x04, 0x00, 0x00, 0x05},
+	// ROUTING_BUSY
+	{0x06, 0x10, 0x05, 0x32, 0x00, 0x0c, 0x06, 0x00, 0x00, 0x64, 0x00, 0x00},
+	// CONNECT_REQUEST
+	{
+		0x06, 0x10, 0x02, 0x05, 0x00, 0x1a,
+		0x08, 0x01, 0xc0, 0xa8, 0x01, 0x52, 0x0e, 0x57,
+		0x08, 0x01, 0xc0, 0xa8, 0x01, 0x52, 0x0e, 0x57,
+		0x04, 0x04, 0x02, 0x00,
+	},
+	// CONNECT_RESPONSE
+	{
+		0x06, 0x10, 0x02, 0x06, 0x00, 0x14,
+		0x15, 0x00, 0x08, 0x01, 0xc0, 0xa8, 0x01, 0x0a, 0x0e, 0x57,
+		0x04, 0x04, 0x11, 0x0a,
+	},
+	// CONNECTIONSTATE_REQUEST
+	{
+		0x06, 0x10, 0x02, 0x07, 0x00, 0x10,
+		0x15, 0x00, 0x08, 0x01, 0xc0, 0xa8, 0x01, 0x52, 0x0e, 0x57,
+	},
+	// CONNECTIONSTATE_RESPONSE
+	{0x06, 0x10, 0x02, 0x08, 0x00, 0x08, 0x15, 0x00},
+	// DISCONNECT_REQUEST
+	{
+		0x06, 0x10, 0x02, 0x09, 0x00, 0x10,
+		0x15, 0x00, 0x08, 0x01, 0xc0, 0xa8, 0x01, 0x52, 0x0e, 0x57,
+	},
+	// TUNNELING_REQUEST
+	{
+		0x06, 0x10, 0x04, 0x20, 0x00, 0x15,
+		0x04, 0x15, 0x00, 0x00,
+		0x29, 0x00, 0xbc, 0xd0, 0x11, 0x02, 0x0a, 0x04, 0x01, 0x00, 0x81,
+	},
+	// TUNNELING_ACK
+	{0x06, 0x10, 0x04, 0x21, 0x00, 0x0a, 0x04, 0x15, 0x00, 0x00},
+	// SEARCH_RESPONSE
+	{
+		0x06, 0x10, 0x02, 0x02, 0x00, 0x4e,
+		0x08, 0x01, 0xc0, 0xa8, 0x01, 0x0a, 0x0e, 0x57,
+		0x36, 0x01, 0x02, 0x00, 0x11, 0x00, 0x00, 0x00, 0x00, 0xc5, 0x01, 0x02, 0x03, 0x04,
+		0xe0, 0x00, 0x17, 0x0c, 0x00, 0x24, 0x6d, 0x01, 0x02, 0x03,
+		'K', 'N', 'X', ' ', 'I', 'P', ' ', 'R', 'o', 'u', 't', 'e', 'r', 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x0a, 0x02, 0x02, 0x01, 0x03, 0x01, 0x04, 0x01, 0x05, 0x01,
+	},
+	// DESCRIPTION_RESPONSE with an IP configuration block
+	{
+		0x06, 0x10, 0x02, 0x04, 0x00, 0x54,
+		0x36, 0x01, 0x02, 0x00, 0x11, 0x00, 0x00, 0x00, 0x00, 0xc5, 0x01, 0x02, 0x03, 0x04,
+		0xe0, 0x00, 0x17, 0x0c, 0x00, 0x24, 0x6d, 0x01, 0x02, 0x03,
+		'K', 'N', 'X', ' ', 'I', 'P', 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x04, 0x02, 0x02, 0x01,
+		0x10, 0x03, 0xc0, 0xa8, 0x01, 0x0a, 0xff, 0xff, 0xff, 0x00, 0xc0, 0xa8, 0x01, 0x01,
+		0x02, 0x01,
+	},
+	// REMOTE_DIAGNOSTIC_REQUEST with a MAC selector
+	{
+		0x06, 0x10, 0x07, 0x40, 0x00, 0x16,
+		0x08, 0x01, 0xe0, 0x00, 0x17, 0x0c, 0x0e, 0x57,
+		0x08, 0x02, 0x00, 0x24, 0x6d, 0x01, 0x02, 0x03,
+	},
+}
+
+func FuzzUnpack(f *testing.F) {
+	for _, packet := range fuzzPackets {
+		f.Add(packet)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var srv Service
+
+		n, err := Unpack(data, &srv)
+		if err != nil {
+			return
+		}
+
+		if n > uint(len(data)) {
+			t.Fatalf("Unpack consumed %d bytes of %d", n, len(data))
+		}
+	})
+}
+
+func FuzzUnpackInto(f *testing.F) {
+	for _, packet := range fuzzPackets {
+		f.Add(packet)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var srv Service
+		Unpack(fuzzPackets[0], &srv)
+
+		n, err := UnpackInto(data, &srv)
+		if err != nil {
+			return
+		}
+
+		if n > uint(len(data)) {
+			t.Fatalf("UnpackInto consumed %d bytes of %d", n, len(data))
+		}
+	})
+}
+
+func FuzzDescriptionBlockUnpack(f *testing.F) {
+	for _, packet := range fuzzPackets {
+		if len(packet) > 6 {
+			f.Add(packet[6:])
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var block DescriptionBlock
+
+		n, err := block.Unpack(data)
+		if err != nil {
+			return
+		}
+
+		if n > uint(len(data)) {
+			t.Fatalf("Unpack consumed %d bytes of %d", n, len(data))
+		}
+	})
+}
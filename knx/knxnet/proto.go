@@ -6,8 +6,9 @@ package knxnet
 
 import (
 	"errors"
-
 	"fmt"
+	"io"
+	"sync"
 
 	"github.com/knx-go/knx-go/knx/util"
 )
@@ -37,6 +38,12 @@ const (
 	RoutingIndService   ServiceID = 0x0530
 	RoutingLostService  ServiceID = 0x0531
 	RoutingBusyService  ServiceID = 0x0532
+	RoutingSysBcService ServiceID = 0x0533
+
+	RemoteDiagReqService        ServiceID = 0x0740
+	RemoteDiagResService        ServiceID = 0x0741
+	RemoteBasicConfigReqService ServiceID = 0x0742
+	RemoteResetReqService       ServiceID = 0x0743
 )
 
 // Service describes a KNXnet/IP service.
@@ -84,13 +91,44 @@ func Size(service ServicePackable) uint {
 
 // Pack generates a KNXnet/IP packet. Utilize Size() to determine the required size of the buffer.
 func Pack(buffer []byte, srv ServicePackable) {
+	size := srv.Size() + 6
+
 	buffer[0] = 6
 	buffer[1] = 16
-	util.Pack(buffer[2:], uint16(srv.Service()))
-	util.Pack(buffer[4:], uint16(srv.Size()+6))
+	buffer[2] = byte(srv.Service() >> 8)
+	buffer[3] = byte(srv.Service())
+	buffer[4] = byte(size >> 8)
+	buffer[5] = byte(size)
 	srv.Pack(buffer[6:])
 }
 
+// bufferPool holds buffers which are used to pack outgoing packets.
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		buffer := make([]byte, 0, 256)
+		return &buffer
+	},
+}
+
+// getBuffer retrieves a buffer of the given size from the pool. It must be returned using
+// putBuffer once it is not needed anymore.
+func getBuffer(size uint) *[]byte {
+	buffer := bufferPool.Get().(*[]byte)
+
+	if uint(cap(*buffer)) < size {
+		*buffer = make([]byte, size)
+	} else {
+		*buffer = (*buffer)[:size]
+	}
+
+	return buffer
+}
+
+// putBuffer returns a buffer to the pool.
+func putBuffer(buffer *[]byte) {
+	bufferPool.Put(buffer)
+}
+
 // AllocAndPack allocates a buffer and packs the KNXnet/IP packet into it.
 func AllocAndPack(srv ServicePackable) []byte {
 	buffer := make([]byte, Size(srv))
@@ -111,22 +149,24 @@ type serviceUnpackable interface {
 
 // UnpackHeader extracts information from the KNXnet/IP packet header.
 func UnpackHeader(data []byte, serviceID *ServiceID, totalLen *uint16) (uint, error) {
-	var headerLen, version uint8
-
-	n, err := util.UnpackSome(data, &headerLen, &version, (*uint16)(serviceID), totalLen)
-	if err != nil {
-		return n, err
+	// The header is decoded by hand, because passing its fields to util.UnpackSome causes
+	// allocations.
+	if len(data) < 6 {
+		return 0, io.ErrUnexpectedEOF
 	}
 
-	if headerLen != 6 {
-		return n, ErrHeaderLength
+	*serviceID = ServiceID(data[2])<<8 | ServiceID(data[3])
+	*totalLen = uint16(data[4])<<8 | uint16(data[5])
+
+	if data[0] != 6 {
+		return 6, ErrHeaderLength
 	}
 
-	if version != 16 {
-		return n, ErrHeaderVersion
+	if data[1] != 16 {
+		return 6, ErrHeaderVersion
 	}
 
-	return n, nil
+	return 6, nil
 }
 
 // Unpack parses a KNXnet/IP packet and retrieves its service payload.
@@ -206,6 +246,21 @@ func Unpack(data []byte, srv *Service) (uint, error) {
 	case RoutingBusyService:
 		body = &RoutingBusy{}
 
+	case RoutingSysBcService:
+		body = &RoutingSystemBroadcast{}
+
+	case RemoteDiagReqService:
+		body = &RemoteDiagReq{}
+
+	case RemoteDiagResService:
+		body = &RemoteDiagRes{}
+
+	case RemoteBasicConfigReqService:
+		body = &RemoteBasicConfigReq{}
+
+	case RemoteResetReqService:
+		body = &RemoteResetReq{}
+
 	default:
 		body = &UnknownService{service: srvID}
 	}
@@ -218,3 +273,33 @@ func Unpack(data []byte, srv *Service) (uint, error) {
 
 	return n + m, err
 }
+
+// serviceReusable is implemented by services which can reuse their buffers during unpacking.
+type serviceReusable interface {
+	serviceUnpackable
+	unpackInto(data []byte) (uint, error)
+}
+
+// UnpackInto works like Unpack, but reuses the service which srv points to if it matches the
+// packet. This is supported for routing indications, routing system broadcasts and tunnel
+// requests, whose CEMI payloads are reused as well (see cemi.UnpackInto). Other services are
+// allocated like Unpack does. The previous contents of the service must not be referenced anymore.
+// Unlike Unpack, the service may be modified even if an error is returned.
+func UnpackInto(data []byte, srv *Service) (uint, error) {
+	var srvID ServiceID
+	var totalLen uint16
+
+	n, err := UnpackHeader(data, &srvID, &totalLen)
+	if err != nil {
+		return n, err
+	}
+
+	body, ok := (*srv).(serviceReusable)
+	if !ok || body.Service() != srvID {
+		return Unpack(data, srv)
+	}
+
+	m, err := body.unpackInto(data[n:])
+
+	return n + m, err
+}
@@ -35,3 +35,90 @@ func BenchmarkPack(b *testing.B) {
 		util.AllocAndPack(req)
 	}
 }
+
+var benchmarkRoutingInd = []byte{
+	0x06, 0x10, 0x05, 0x30, 0x00, 0x13,
+	0x29, 0x00, 0xbc, 0xe0, 0x11, 0x01, 0x0a, 0x03, 0x03, 0x00, 0x80, 0x0c, 0x1a,
+}
+
+func TestUnpackInto(t *testing.T) {
+	var srv Service
+
+	for i := 0; i < 2; i++ {
+		previous := srv
+
+		n, err := UnpackInto(benchmarkRoutingInd, &srv)
+		if err != nil {
+			t.Fatalf("UnpackInto() error = %v", err)
+		}
+
+		if n != uint(len(benchmarkRoutingInd)) {
+			t.Fatalf("UnpackInto() bytes = %d, want %d", n, len(benchmarkRoutingInd))
+		}
+
+		ind, ok := srv.(*RoutingInd)
+		if !ok {
+			t.Fatalf("UnpackInto() service = %T, want *RoutingInd", srv)
+		}
+
+		if previous != nil && previous != srv {
+			t.Error("UnpackInto() did not reuse the service")
+		}
+
+		ldata, ok := ind.Payload.(*cemi.LDataInd)
+		if !ok || ldata.Destination != 0x0a03 {
+			t.Errorf("UnpackInto() payload = %+v", ind.Payload)
+		}
+	}
+
+	// A different service replaces the current one.
+	data := []byte{0x06, 0x10, 0x05, 0x32, 0x00, 0x0c, 0x06, 0x00, 0x00, 0x64, 0x00, 0x00}
+
+	if _, err := UnpackInto(data, &srv); err != nil {
+		t.Fatalf("UnpackInto() error = %v", err)
+	}
+
+	if _, ok := srv.(*RoutingBusy); !ok {
+		t.Errorf("UnpackInto() service = %T, want *RoutingBusy", srv)
+	}
+}
+
+func BenchmarkUnpack(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var srv Service
+		if _, err := Unpack(benchmarkRoutingInd, &srv); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnpackInto(b *testing.B) {
+	b.ReportAllocs()
+
+	var srv Service
+
+	for i := 0; i < b.N; i++ {
+		if _, err := UnpackInto(benchmarkRoutingInd, &srv); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPackPooled(b *testing.B) {
+	b.ReportAllocs()
+
+	var srv Service
+	if _, err := Unpack(benchmarkRoutingInd, &srv); err != nil {
+		b.Fatal(err)
+	}
+
+	ind := srv.(*RoutingInd)
+
+	for i := 0; i < b.N; i++ {
+		buffer := getBuffer(Size(ind))
+		Pack(*buffer, ind)
+		putBuffer(buffer)
+	}
+}
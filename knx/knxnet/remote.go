@@ -0,0 +1,361 @@
+package knxnet
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/knx-go/knx-go/knx/util"
+)
+
+// SelectorType identifies the kind of a Selector.
+type SelectorType uint8
+
+const (
+	// SelectorTypeProgMode selects all devices which are in programming mode.
+	SelectorTypeProgMode SelectorType = 0x01
+
+	// SelectorTypeMAC selects the device with a specific MAC address.
+	SelectorTypeMAC SelectorType = 0x02
+)
+
+// A Selector determines which devices shall respond to a remote diagnosis or configuration request.
+type Selector struct {
+	Type         SelectorType
+	HardwareAddr net.HardwareAddr
+}
+
+// NewProgModeSelector creates a Selector that matches all devices in programming mode.
+func NewProgModeSelector() Selector {
+	return Selector{Type: SelectorTypeProgMode}
+}
+
+// NewMACSelector creates a Selector that matches the device with the given MAC address.
+func NewMACSelector(addr net.HardwareAddr) (Selector, error) {
+	if len(addr) != 6 {
+		return Selector{}, fmt.Errorf("MAC address %v must have a length of 6 bytes", addr)
+	}
+
+	return Selector{Type: SelectorTypeMAC, HardwareAddr: addr}, nil
+}
+
+// String generates a string representation of the selector.
+func (sel Selector) String() string {
+	switch sel.Type {
+	case SelectorTypeProgMode:
+		return "programming mode"
+
+	case SelectorTypeMAC:
+		return "MAC " + sel.HardwareAddr.String()
+
+	default:
+		return fmt.Sprintf("unknown selector %#02x", uint8(sel.Type))
+	}
+}
+
+// Size returns the packed size.
+func (sel Selector) Size() uint {
+	if sel.Type == SelectorTypeMAC {
+		return 8
+	}
+
+	return 2
+}
+
+// Pack assembles the selector structure in the given buffer.
+func (sel *Selector) Pack(buffer []byte) {
+	util.PackSome(buffer, uint8(sel.Size()), uint8(sel.Type))
+
+	if sel.Type == SelectorTypeMAC {
+		copy(buffer[2:8], sel.HardwareAddr)
+	}
+}
+
+// Unpack parses the given data in order to initialize the structure.
+func (sel *Selector) Unpack(data []byte) (n uint, err error) {
+	var length uint8
+
+	if n, err = util.UnpackSome(data, &length, (*uint8)(&sel.Type)); err != nil {
+		return
+	}
+
+	if length < 2 || uint(len(data)) < uint(length) {
+		return n, errors.New("selector structure length is invalid")
+	}
+
+	switch sel.Type {
+	case SelectorTypeProgMode:
+		sel.HardwareAddr = nil
+
+	case SelectorTypeMAC:
+		if length != 8 {
+			return n, errors.New("MAC selector structure length is invalid")
+		}
+
+		sel.HardwareAddr = make(net.HardwareAddr, 6)
+		copy(sel.HardwareAddr, data[2:8])
+
+	default:
+		return n, fmt.Errorf("unknown selector type %#02x", uint8(sel.Type))
+	}
+
+	return uint(length), nil
+}
+
+// IPCapabilities describes the IP address assignment methods a device supports.
+type IPCapabilities uint8
+
+// These are the IP capabilities.
+const (
+	IPCapabilityBootP  IPCapabilities = 0x01
+	IPCapabilityDHCP   IPCapabilities = 0x02
+	IPCapabilityAutoIP IPCapabilities = 0x04
+)
+
+// IPAssignmentMethod describes how a device obtains its IP address.
+type IPAssignmentMethod uint8
+
+// These are the IP assignment methods.
+const (
+	IPAssignmentManual IPAssignmentMethod = 0x01
+	IPAssignmentBootP  IPAssignmentMethod = 0x02
+	IPAssignmentDHCP   IPAssignmentMethod = 0x04
+	IPAssignmentAutoIP IPAssignmentMethod = 0x08
+)
+
+// String generates a string representation of the assignment methods.
+func (method IPAssignmentMethod) String() string {
+	names := []struct {
+		flag IPAssignmentMethod
+		name string
+	}{
+		{IPAssignmentManual, "manual"},
+		{IPAssignmentBootP, "BootP"},
+		{IPAssignmentDHCP, "DHCP"},
+		{IPAssignmentAutoIP, "AutoIP"},
+	}
+
+	str := ""
+	for _, n := range names {
+		if method&n.flag != 0 {
+			if str != "" {
+				str += "|"
+			}
+			str += n.name
+		}
+	}
+
+	if str == "" {
+		return fmt.Sprintf("%#02x", uint8(method))
+	}
+
+	return str
+}
+
+// IPConfigDIB contains the configured IP settings of a device.
+type IPConfigDIB struct {
+	Address          Address
+	SubnetMask       Address
+	DefaultGateway   Address
+	Capabilities     IPCapabilities
+	AssignmentMethod IPAssignmentMethod
+}
+
+// Size returns the packed size.
+func (IPConfigDIB) Size() uint {
+	return 16
+}
+
+// Pack assembles the IP configuration structure in the given buffer.
+func (dib *IPConfigDIB) Pack(buffer []byte) {
+	util.PackSome(
+		buffer,
+		uint8(dib.Size()), uint8(DescriptionTypeIPConfig),
+		dib.Address[:], dib.SubnetMask[:], dib.DefaultGateway[:],
+		uint8(dib.Capabilities), uint8(dib.AssignmentMethod),
+	)
+}
+
+// Unpack parses the given data in order to initialize the structure.
+func (dib *IPConfigDIB) Unpack(data []byte) (n uint, err error) {
+	var length, ty uint8
+
+	if n, err = util.UnpackSome(
+		data,
+		&length, &ty,
+		dib.Address[:], dib.SubnetMask[:], dib.DefaultGateway[:],
+		(*uint8)(&dib.Capabilities), (*uint8)(&dib.AssignmentMethod),
+	); err != nil {
+		return
+	}
+
+	if length != uint8(dib.Size()) || DescriptionType(ty) != DescriptionTypeIPConfig {
+		return n, errors.New("IP config structure is invalid")
+	}
+
+	return
+}
+
+// IPCurrentConfigDIB contains the IP settings which a device currently uses.
+type IPCurrentConfigDIB struct {
+	Address          Address
+	SubnetMask       Address
+	DefaultGateway   Address
+	DHCPServer       Address
+	AssignmentMethod IPAssignmentMethod
+}
+
+// Size returns the packed size.
+func (IPCurrentConfigDIB) Size() uint {
+	return 20
+}
+
+// Pack assembles the current IP configuration structure in the given buffer.
+func (dib *IPCurrentConfigDIB) Pack(buffer []byte) {
+	util.PackSome(
+		buffer,
+		uint8(dib.Size()), uint8(DescriptionTypeIPCurrentConfig),
+		dib.Address[:], dib.SubnetMask[:], dib.DefaultGateway[:], dib.DHCPServer[:],
+		uint8(dib.AssignmentMethod), uint8(0),
+	)
+}
+
+// Unpack parses the given data in order to initialize the structure.
+func (dib *IPCurrentConfigDIB) Unpack(data []byte) (n uint, err error) {
+	var length, ty, reserved uint8
+
+	if n, err = util.UnpackSome(
+		data,
+		&length, &ty,
+		dib.Address[:], dib.SubnetMask[:], dib.DefaultGateway[:], dib.DHCPServer[:],
+		(*uint8)(&dib.AssignmentMethod), &reserved,
+	); err != nil {
+		return
+	}
+
+	if length != uint8(dib.Size()) || DescriptionType(ty) != DescriptionTypeIPCurrentConfig {
+		return n, errors.New("current IP config structure is invalid")
+	}
+
+	return
+}
+
+// A RemoteDiagReq asks the selected devices to report their configuration. It is sent via
+// multicast, so that devices with a wrong IP configuration can be reached as well.
+type RemoteDiagReq struct {
+	HostInfo HostInfo
+	Selector Selector
+}
+
+// Service returns the service identifier for Remote Diagnostic Request.
+func (RemoteDiagReq) Service() ServiceID {
+	return RemoteDiagReqService
+}
+
+// Size returns the packed size.
+func (req RemoteDiagReq) Size() uint {
+	return req.HostInfo.Size() + req.Selector.Size()
+}
+
+// Pack assembles the Remote Diagnostic Request structure in the given buffer.
+func (req *RemoteDiagReq) Pack(buffer []byte) {
+	util.PackSome(buffer, &req.HostInfo, &req.Selector)
+}
+
+// Unpack parses the given service payload in order to initialize the structure.
+func (req *RemoteDiagReq) Unpack(data []byte) (n uint, err error) {
+	return util.UnpackSome(data, &req.HostInfo, &req.Selector)
+}
+
+// A RemoteDiagRes is the answer of a device to a Remote Diagnostic Request.
+type RemoteDiagRes struct {
+	Selector     Selector
+	DescriptionB DescriptionBlock
+}
+
+// Service returns the service identifier for Remote Diagnostic Response.
+func (RemoteDiagRes) Service() ServiceID {
+	return RemoteDiagResService
+}
+
+// Size returns the packed size.
+func (res RemoteDiagRes) Size() uint {
+	return res.Selector.Size() + res.DescriptionB.Size()
+}
+
+// Pack assembles the Remote Diagnostic Response structure in the given buffer.
+func (res *RemoteDiagRes) Pack(buffer []byte) {
+	n := res.Selector.Size()
+	res.Selector.Pack(buffer)
+	res.DescriptionB.Pack(buffer[n:])
+}
+
+// Unpack parses the given service payload in order to initialize the structure.
+func (res *RemoteDiagRes) Unpack(data []byte) (n uint, err error) {
+	return util.UnpackSome(data, &res.Selector, &res.DescriptionB)
+}
+
+// A RemoteBasicConfigReq pushes a basic IP configuration to the selected devices.
+type RemoteBasicConfigReq struct {
+	HostInfo HostInfo
+	Selector Selector
+	IPConfig IPConfigDIB
+}
+
+// Service returns the service identifier for Remote Basic Configuration Request.
+func (RemoteBasicConfigReq) Service() ServiceID {
+	return RemoteBasicConfigReqService
+}
+
+// Size returns the packed size.
+func (req RemoteBasicConfigReq) Size() uint {
+	return req.HostInfo.Size() + req.Selector.Size() + req.IPConfig.Size()
+}
+
+// Pack assembles the Remote Basic Configuration Request structure in the given buffer.
+func (req *RemoteBasicConfigReq) Pack(buffer []byte) {
+	util.PackSome(buffer, &req.HostInfo, &req.Selector, &req.IPConfig)
+}
+
+// Unpack parses the given service payload in order to initialize the structure.
+func (req *RemoteBasicConfigReq) Unpack(data []byte) (n uint, err error) {
+	return util.UnpackSome(data, &req.HostInfo, &req.Selector, &req.IPConfig)
+}
+
+// ResetMode determines how a device shall be reset.
+type ResetMode uint8
+
+const (
+	// ResetModeRestart restarts the device.
+	ResetModeRestart ResetMode = 0x01
+
+	// ResetModeMasterReset resets the device to its factory settings.
+	ResetModeMasterReset ResetMode = 0x02
+)
+
+// A RemoteResetReq resets the selected devices.
+type RemoteResetReq struct {
+	Selector Selector
+	Mode     ResetMode
+}
+
+// Service returns the service identifier for Remote Reset Request.
+func (RemoteResetReq) Service() ServiceID {
+	return RemoteResetReqService
+}
+
+// Size returns the packed size.
+func (req RemoteResetReq) Size() uint {
+	return req.Selector.Size() + 2
+}
+
+// Pack assembles the Remote Reset Request structure in the given buffer.
+func (req *RemoteResetReq) Pack(buffer []byte) {
+	util.PackSome(buffer, &req.Selector, uint8(req.Mode), uint8(0))
+}
+
+// Unpack parses the given service payload in order to initialize the structure.
+func (req *RemoteResetReq) Unpack(data []byte) (n uint, err error) {
+	var reserved uint8
+	return util.UnpackSome(data, &req.Selector, (*uint8)(&req.Mode), &reserved)
+}
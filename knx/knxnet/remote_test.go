@@ -0,0 +1,136 @@
+package knxnet
+
+import (
+	"bytes"
+	"net"
+	"reflect"
+	"testing"
+)
+
+func TestSelector(t *testing.T) {
+	mac := net.HardwareAddr{0x00, 0x24, 0x6d, 0x01, 0x02, 0x03}
+
+	sel, err := NewMACSelector(mac)
+	if err != nil {
+		t.Fatalf("NewMACSelector() error = %v", err)
+	}
+
+	if _, err := NewMACSelector(mac[:4]); err == nil {
+		t.Error("NewMACSelector() should reject short addresses")
+	}
+
+	data := make([]byte, sel.Size())
+	sel.Pack(data)
+
+	want := []byte{0x08, 0x02, 0x00, 0x24, 0x6d, 0x01, 0x02, 0x03}
+	if !bytes.Equal(data, want) {
+		t.Errorf("Pack() = % x, want % x", data, want)
+	}
+
+	progMode := NewProgModeSelector()
+	data = make([]byte, progMode.Size())
+	progMode.Pack(data)
+
+	if !bytes.Equal(data, []byte{0x02, 0x01}) {
+		t.Errorf("Pack() = % x, want 02 01", data)
+	}
+
+	var unpacked Selector
+	if _, err := unpacked.Unpack([]byte{0x08, 0x03, 0, 0, 0, 0, 0, 0}); err == nil {
+		t.Error("Unpack() should reject unknown selector types")
+	}
+
+	if _, err := unpacked.Unpack([]byte{0x08, 0x02, 0}); err == nil {
+		t.Error("Unpack() should reject truncated selectors")
+	}
+}
+
+func TestRemoteDiagReqPack(t *testing.T) {
+	req := &RemoteDiagReq{
+		HostInfo: HostInfo{Protocol: UDP4, Address: Address{224, 0, 23, 12}, Port: 3671},
+		Selector: NewProgModeSelector(),
+	}
+
+	want := []byte{
+		0x06, 0x10, 0x07, 0x40, 0x00, 0x10,
+		0x08, 0x01, 224, 0, 23, 12, 0x0e, 0x57,
+		0x02, 0x01,
+	}
+
+	if data := AllocAndPack(req); !bytes.Equal(data, want) {
+		t.Errorf("AllocAndPack() = % x, want % x", data, want)
+	}
+}
+
+func TestRemoteServices(t *testing.T) {
+	mac := net.HardwareAddr{0x00, 0x24, 0x6d, 0x01, 0x02, 0x03}
+	sel, _ := NewMACSelector(mac)
+	hostInfo := HostInfo{Protocol: UDP4, Address: Address{224, 0, 23, 12}, Port: 3671}
+
+	ipConfig := IPConfigDIB{
+		Address:          Address{192, 168, 1, 10},
+		SubnetMask:       Address{255, 255, 255, 0},
+		DefaultGateway:   Address{192, 168, 1, 1},
+		Capabilities:     IPCapabilityDHCP,
+		AssignmentMethod: IPAssignmentManual,
+	}
+
+	services := []ServicePackable{
+		&RemoteDiagReq{HostInfo: hostInfo, Selector: sel},
+		&RemoteDiagRes{
+			Selector: sel,
+			DescriptionB: DescriptionBlock{
+				DeviceHardware: DeviceInformationBlock{
+					Type:         DescriptionTypeDeviceInfo,
+					Medium:       KNXMediumTP1,
+					Source:       0x1101,
+					HardwareAddr: mac,
+					FriendlyName: "Gateway",
+				},
+				SupportedServices: SupportedServicesDIB{
+					Type: DescriptionTypeSupportedServiceFamilies,
+					Families: []ServiceFamily{
+						{Type: ServiceFamilyTypeIPRemoteConfigurationAndDiagnosis, Version: 1},
+					},
+				},
+				IPConfig: &ipConfig,
+				IPCurrentConfig: &IPCurrentConfigDIB{
+					Address:          Address{10, 0, 0, 5},
+					SubnetMask:       Address{255, 0, 0, 0},
+					AssignmentMethod: IPAssignmentDHCP,
+				},
+			},
+		},
+		&RemoteBasicConfigReq{HostInfo: hostInfo, Selector: sel, IPConfig: ipConfig},
+		&RemoteResetReq{Selector: NewProgModeSelector(), Mode: ResetModeRestart},
+	}
+
+	for _, srv := range services {
+		data := AllocAndPack(srv)
+
+		var unpacked Service
+		n, err := Unpack(data, &unpacked)
+		if err != nil {
+			t.Errorf("%T: Unpack() error = %v", srv, err)
+			continue
+		}
+
+		if n != uint(len(data)) {
+			t.Errorf("%T: Unpack() bytes = %d, want %d", srv, n, len(data))
+		}
+
+		if !reflect.DeepEqual(unpacked, srv) {
+			t.Errorf("%T: Unpack() = %+v, want %+v", srv, unpacked, srv)
+		}
+	}
+}
+
+func TestIPAssignmentMethodString(t *testing.T) {
+	if got := (IPAssignmentManual | IPAssignmentDHCP).String(); got != "manual|DHCP" {
+		t.Errorf("String() = %q, want %q", got, "manual|DHCP")
+	}
+
+	if got := IPAssignmentMethod(0).String(); got != "0x00" {
+		t.Errorf("String() = %q, want %q", got, "0x00")
+	}
+}
@@ -36,6 +36,42 @@ func (ind *RoutingInd) Unpack(data []byte) (uint, error) {
 	return cemi.Unpack(data, &ind.Payload)
 }
 
+// unpackInto works like Unpack, but reuses the payload.
+func (ind *RoutingInd) unpackInto(data []byte) (uint, error) {
+	return cemi.UnpackInto(data, &ind.Payload)
+}
+
+// A RoutingSystemBroadcast carries a system broadcast telegram, e.g. for domain address or KNX
+// Secure management. Unlike a RoutingInd, it is not filtered by the routers' domain address.
+type RoutingSystemBroadcast struct {
+	Payload cemi.Message
+}
+
+// Service returns the service identifiers for routing system broadcast.
+func (RoutingSystemBroadcast) Service() ServiceID {
+	return RoutingSysBcService
+}
+
+// Size returns the packed size.
+func (bc *RoutingSystemBroadcast) Size() uint {
+	return cemi.Size(bc.Payload)
+}
+
+// Pack assembles the service payload in the given buffer.
+func (bc *RoutingSystemBroadcast) Pack(buffer []byte) {
+	cemi.Pack(buffer, bc.Payload)
+}
+
+// Unpack parses the given service payload in order to initialize the structure.
+func (bc *RoutingSystemBroadcast) Unpack(data []byte) (uint, error) {
+	return cemi.Unpack(data, &bc.Payload)
+}
+
+// unpackInto works like Unpack, but reuses the payload.
+func (bc *RoutingSystemBroadcast) unpackInto(data []byte) (uint, error) {
+	return cemi.UnpackInto(data, &bc.Payload)
+}
+
 // DeviceState indicates the state of a device.
 type DeviceState uint8
 
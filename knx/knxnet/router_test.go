@@ -1,8 +1,11 @@
 package knxnet
 
 import (
+	"reflect"
 	"testing"
 	"time"
+
+	"github.com/knx-go/knx-go/knx/cemi"
 )
 
 func TestDeviceStateString(t *testing.T) {
@@ -79,3 +82,38 @@ func TestRoutingBusyUnpack(t *testing.T) {
 		t.Errorf("Control = %#x, want 0x1234", msg.Control)
 	}
 }
+
+func TestRoutingSystemBroadcast(t *testing.T) {
+	payload := &cemi.LDataInd{LData: cemi.LData{
+		Control1:    cemi.Control1StdFrame | cemi.Control1NoRepeat,
+		Control2:    cemi.Control2Hops(6),
+		Source:      0x1101,
+		Destination: 0,
+		Data:        &cemi.AppData{Command: cemi.DomainAddressRead, Data: []byte{}},
+	}}
+
+	data := AllocAndPack(&RoutingSystemBroadcast{Payload: payload})
+
+	if service := ServiceID(data[2])<<8 | ServiceID(data[3]); service != RoutingSysBcService {
+		t.Fatalf("Service = %v, want %v", service, RoutingSysBcService)
+	}
+
+	var srv Service
+	n, err := Unpack(data, &srv)
+	if err != nil {
+		t.Fatalf("Unpack() error = %v", err)
+	}
+
+	if n != uint(len(data)) {
+		t.Fatalf("Unpack() bytes = %d, want %d", n, len(data))
+	}
+
+	bc, ok := srv.(*RoutingSystemBroadcast)
+	if !ok {
+		t.Fatalf("Unpack() service = %T, want *RoutingSystemBroadcast", srv)
+	}
+
+	if !reflect.DeepEqual(bc.Payload, payload) {
+		t.Errorf("Payload = %+v, want %+v", bc.Payload, payload)
+	}
+}
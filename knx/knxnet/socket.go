@@ -5,9 +5,14 @@ package knxnet
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net"
+	"net/netip"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/knx-go/knx-go/knx/util"
@@ -22,10 +27,35 @@ type Socket interface {
 	LocalAddr() net.Addr
 }
 
+// socketLogger holds the logger of a socket. It can be replaced while the worker is running.
+type socketLogger struct {
+	logger atomic.Pointer[slog.Logger]
+}
+
+// get returns the logger, or the default logger if none has been set.
+func (sl *socketLogger) get() *slog.Logger {
+	if logger := sl.logger.Load(); logger != nil {
+		return logger
+	}
+
+	return util.DefaultLogger().With("component", "knxnet")
+}
+
+// readFailed logs the error which terminates a worker. Errors caused by closing the socket are
+// expected and therefore less important.
+func (sl *socketLogger) readFailed(msg string, err error) {
+	if errors.Is(err, net.ErrClosed) || errors.Is(err, io.EOF) {
+		sl.get().Debug(msg, "error", err)
+	} else {
+		sl.get().Warn(msg, "error", err)
+	}
+}
+
 // TunnelSocket is a UDP socket for KNXnet/IP packet exchange.
 type TunnelSocket struct {
 	conn    net.Conn
 	inbound <-chan Service
+	log     *socketLogger
 }
 
 // DialTunnelUDP creates a new Socket which can used to exchange KNXnet/IP packets with a single
@@ -47,10 +77,11 @@ func DialTunnelUDP(address string) (*TunnelSocket, error) {
 
 	conn.SetDeadline(time.Time{})
 
+	log := &socketLogger{}
 	inbound := make(chan Service)
-	go serveUDPSocket(conn, addr, inbound)
+	go serveUDPSocket(conn, addr, inbound, log)
 
-	return &TunnelSocket{conn, inbound}, nil
+	return &TunnelSocket{conn, inbound, log}, nil
 }
 
 // DialTunnelTCP creates a new Socket which can used to exchange KNXnet/IP packets with a single
@@ -72,23 +103,27 @@ func DialTunnelTCP(address string) (*TunnelSocket, error) {
 
 	conn.SetDeadline(time.Time{})
 
+	log := &socketLogger{}
 	inbound := make(chan Service)
-	go serveTCPSocket(conn, addr, inbound)
+	go serveTCPSocket(conn, addr, inbound, log)
 
-	return &TunnelSocket{conn, inbound}, nil
+	return &TunnelSocket{conn, inbound, log}, nil
 }
 
 // Send transmits a KNXnet/IP packet.
 func (sock *TunnelSocket) Send(payload ServicePackable) error {
-	buffer := make([]byte, Size(payload))
-	Pack(buffer, payload)
+	buffer := getBuffer(Size(payload))
+	defer putBuffer(buffer)
+
+	Pack(*buffer, payload)
 
 	// Transmission of the buffer contents.
-	_, err := sock.conn.Write(buffer)
+	_, err := sock.conn.Write(*buffer)
 	return err
 }
 
-// Inbound provides a channel from which you can retrieve incoming packets.
+// Inbound provides a channel from which you can retrieve incoming packets. Packets which have been
+// processed may be handed back using ReleaseService.
 func (sock *TunnelSocket) Inbound() <-chan Service {
 	return sock.inbound
 }
@@ -103,11 +138,17 @@ func (sock *TunnelSocket) LocalAddr() net.Addr {
 	return sock.conn.LocalAddr()
 }
 
+// SetLogger replaces the logger which is used by the socket's worker.
+func (sock *TunnelSocket) SetLogger(logger *slog.Logger) {
+	sock.log.logger.Store(logger)
+}
+
 // RouterSocket is a UDP socket for KNXnet/IP packet exchange.
 type RouterSocket struct {
 	conn    *net.UDPConn
 	addr    *net.UDPAddr
 	inbound <-chan Service
+	log     *socketLogger
 }
 
 // ListenRouter creates a new Socket which can be used to exchange KNXnet/IP packets with
@@ -135,23 +176,25 @@ func ListenRouterOnInterface(ifi *net.Interface, multicastAddress string, multic
 		return nil, err
 	}
 
+	log := &socketLogger{}
+
 	// Just for logging purposes.
 	if loopOn, err := pc.MulticastLoopback(); err == nil {
-		util.Log(conn, "MulticastLoopback status: %v", loopOn)
+		log.get().Debug("Multicast loopback status", "enabled", loopOn)
 	}
 	// Setup interface with Multicast Loopback enabled if desired.
 	if err := pc.SetMulticastLoopback(multicastLoopbackEnabled); err != nil {
-		util.Log(conn, "SetMulticastLoopback error: %v", err)
+		log.get().Warn("Cannot set multicast loopback", "error", err)
 	} else {
-		util.Log(conn, "MulticastLoopbackEnabled: %t", multicastLoopbackEnabled)
+		log.get().Debug("Multicast loopback configured", "enabled", multicastLoopbackEnabled)
 	}
 
 	conn.SetDeadline(time.Time{})
 
 	inbound := make(chan Service)
-	go serveUDPSocket(conn, nil, inbound)
+	go serveUDPSocket(conn, nil, inbound, log)
 
-	return &RouterSocket{conn, addr, inbound}, nil
+	return &RouterSocket{conn, addr, inbound, log}, nil
 }
 
 // Addr returns the multicast destination address.
@@ -161,15 +204,18 @@ func (sock *RouterSocket) Addr() *net.UDPAddr {
 
 // Send transmits a KNXnet/IP packet.
 func (sock *RouterSocket) Send(payload ServicePackable) error {
-	buffer := make([]byte, Size(payload))
-	Pack(buffer, payload)
+	buffer := getBuffer(Size(payload))
+	defer putBuffer(buffer)
+
+	Pack(*buffer, payload)
 
 	// Transmission of the buffer contents
-	_, err := sock.conn.WriteToUDP(buffer, sock.addr)
+	_, err := sock.conn.WriteToUDP(*buffer, sock.addr)
 	return err
 }
 
-// Inbound provides a channel from which you can retrieve incoming packets.
+// Inbound provides a channel from which you can retrieve incoming packets. Packets which have been
+// processed may be handed back using ReleaseService.
 func (sock *RouterSocket) Inbound() <-chan Service {
 	return sock.inbound
 }
@@ -184,39 +230,71 @@ func (sock *RouterSocket) LocalAddr() net.Addr {
 	return sock.conn.LocalAddr()
 }
 
+// SetLogger replaces the logger which is used by the socket's worker.
+func (sock *RouterSocket) SetLogger(logger *slog.Logger) {
+	sock.log.logger.Store(logger)
+}
+
+// servicePool holds the services which have been handed back by the readers of the sockets.
+var servicePool sync.Pool
+
+// ReleaseService hands a service which has been received through Inbound back to the socket
+// workers. They reuse it and its payload for a later packet of the same type instead of allocating
+// new ones, see UnpackInto. Neither the service nor anything it references, e.g. the data of its
+// CEMI frame, must be used after releasing it. Releasing is optional, services which are not
+// released are garbage collected as usual.
+func ReleaseService(srv Service) {
+	if _, ok := srv.(serviceReusable); ok {
+		servicePool.Put(srv)
+	}
+}
+
+// releasedService retrieves a service which has been handed back using ReleaseService, or nil.
+func releasedService() Service {
+	srv, _ := servicePool.Get().(Service)
+	return srv
+}
+
 // serveUDPSocket is the receiver worker for a UDP socket.
-func serveUDPSocket(conn *net.UDPConn, addr *net.UDPAddr, inbound chan<- Service) {
-	util.Log(conn, "Started worker")
-	defer util.Log(conn, "Worker exited")
+func serveUDPSocket(conn *net.UDPConn, addr *net.UDPAddr, inbound chan<- Service, log *socketLogger) {
+	log.get().Debug("Started socket worker", "local", conn.LocalAddr())
+	defer func() { log.get().Debug("Socket worker exited", "local", conn.LocalAddr()) }()
 
 	// A closed inbound channel indicates to its readers that the worker has terminated.
 	defer close(inbound)
 
 	buffer := [1024]byte{}
 
+	// Unlike ReadFromUDP, ReadFromUDPAddrPort does not allocate the sender address.
+	var origin netip.AddrPort
+	if addr != nil {
+		origin = addr.AddrPort()
+	}
+
 	for {
-		len, sender, err := conn.ReadFromUDP(buffer[:])
+		len, sender, err := conn.ReadFromUDPAddrPort(buffer[:])
 		if err != nil {
-			util.Log(conn, "Error during ReadFromUDP: %v", err)
+			log.readFailed("Error during ReadFromUDP", err)
 			return
 		}
 
 		// Discard empty frames
 		if len == 0 {
-			util.Log(conn, "Empty frame discarded")
+			log.get().Debug("Empty frame discarded", "sender", sender)
 			continue
 		}
 
 		// Validate sender origin if necessary.
-		if addr != nil && (!addr.IP.Equal(sender.IP) || addr.Port != sender.Port) {
-			util.Log(conn, "Origin validation failed: %v != %v", addr, sender)
+		if addr != nil && (origin.Addr().Unmap() != sender.Addr().Unmap() || origin.Port() != sender.Port()) {
+			log.get().Debug("Origin validation failed", "expected", addr, "sender", sender)
 			continue
 		}
 
-		var payload Service
-		_, err = Unpack(buffer[:len], &payload)
+		payload := releasedService()
+		_, err = UnpackInto(buffer[:len], &payload)
 		if err != nil {
-			util.Log(conn, "Error during Unpack: %v", err)
+			log.get().Warn("Error during Unpack", "sender", sender, "error", err)
+			ReleaseService(payload)
 			continue
 		}
 
@@ -225,19 +303,22 @@ func serveUDPSocket(conn *net.UDPConn, addr *net.UDPAddr, inbound chan<- Service
 }
 
 // serveTCPSocket is the receiver worker for a TCP socket.
-func serveTCPSocket(conn *net.TCPConn, addr *net.TCPAddr, inbound chan<- Service) {
-	util.Log(conn, "Started worker")
-	defer util.Log(conn, "Worker exited")
+func serveTCPSocket(conn *net.TCPConn, addr *net.TCPAddr, inbound chan<- Service, log *socketLogger) {
+	log.get().Debug("Started socket worker", "local", conn.LocalAddr())
+	defer func() { log.get().Debug("Socket worker exited", "local", conn.LocalAddr()) }()
 
 	// A closed inbound channel indicates to its readers that the worker has terminated.
 	defer close(inbound)
 
 	connBuffer := bufio.NewReader(conn)
 
+	// The buffer can be reused, because unpacking copies everything it keeps.
+	var buffer []byte
+
 	for {
 		header, err := connBuffer.Peek(6) // KNXnet/IP headers are 6 bytes long
 		if err != nil {
-			util.Log(conn, "Error during peeking header: %v", err)
+			log.readFailed("Error during peeking header", err)
 			return
 		}
 
@@ -246,27 +327,31 @@ func serveTCPSocket(conn *net.TCPConn, addr *net.TCPAddr, inbound chan<- Service
 
 		_, err = UnpackHeader(header, &serviceID, &totalLen)
 		if err != nil {
-			util.Log(conn, "Error during header inspection: %v", err)
+			log.get().Warn("Error during header inspection", "error", err)
 			return
 		}
 
-		buffer := make([]byte, totalLen)
-		len, err := io.ReadFull(connBuffer, buffer)
+		if cap(buffer) < int(totalLen) {
+			buffer = make([]byte, totalLen)
+		}
+
+		len, err := io.ReadFull(connBuffer, buffer[:totalLen])
 		if err != nil {
-			util.Log(conn, "Error during ReadFull: %v", err)
+			log.readFailed("Error during ReadFull", err)
 			return
 		}
 
 		// Discard empty frames
 		if len == 0 {
-			util.Log(conn, "Empty frame discarded")
+			log.get().Debug("Empty frame discarded")
 			continue
 		}
 
-		var payload Service
-		_, err = Unpack(buffer[:len], &payload)
+		payload := releasedService()
+		_, err = UnpackInto(buffer[:len], &payload)
 		if err != nil {
-			util.Log(conn, "Error during Unpack: %v", err)
+			log.get().Warn("Error during Unpack", "service", serviceID, "error", err)
+			ReleaseService(payload)
 			continue
 		}
 
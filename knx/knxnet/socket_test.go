@@ -0,0 +1,83 @@
+package knxnet
+
+import (
+	"net"
+	"testing"
+
+	"github.com/knx-go/knx-go/knx/cemi"
+)
+
+// serveLoopbackUDP starts a socket worker on the loopback interface and returns a connection which
+// sends to it.
+func serveLoopbackUDP(tb testing.TB) (*net.UDPConn, <-chan Service) {
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		tb.Skipf("Cannot listen on loopback: %v", err)
+	}
+	tb.Cleanup(func() { conn.Close() })
+
+	sender, err := net.DialUDP("udp4", nil, conn.LocalAddr().(*net.UDPAddr))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	tb.Cleanup(func() { sender.Close() })
+
+	inbound := make(chan Service)
+	go serveUDPSocket(conn, nil, inbound, &socketLogger{})
+
+	return sender, inbound
+}
+
+func TestServeUDPSocketReleaseService(t *testing.T) {
+	sender, inbound := serveLoopbackUDP(t)
+
+	for _, destination := range []byte{0x03, 0x04, 0x05} {
+		packet := append([]byte(nil), benchmarkRoutingInd...)
+		packet[13] = destination
+
+		if _, err := sender.Write(packet); err != nil {
+			t.Fatal(err)
+		}
+
+		srv := <-inbound
+
+		ind, ok := srv.(*RoutingInd)
+		if !ok {
+			t.Fatalf("Unexpected service %T", srv)
+		}
+
+		ldata, ok := ind.Payload.(*cemi.LDataInd)
+		if !ok || ldata.Destination != 0x0a00|uint16(destination) {
+			t.Errorf("Unexpected payload %+v", ind.Payload)
+		}
+
+		ReleaseService(srv)
+	}
+}
+
+func BenchmarkServeUDPSocket(b *testing.B) {
+	for _, release := range []bool{false, true} {
+		name := "Unpack"
+		if release {
+			name = "ReleaseService"
+		}
+
+		b.Run(name, func(b *testing.B) {
+			sender, inbound := serveLoopbackUDP(b)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				if _, err := sender.Write(benchmarkRoutingInd); err != nil {
+					b.Fatal(err)
+				}
+
+				srv := <-inbound
+				if release {
+					ReleaseService(srv)
+				}
+			}
+		})
+	}
+}
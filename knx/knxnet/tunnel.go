@@ -5,6 +5,7 @@ package knxnet
 
 import (
 	"errors"
+	"io"
 
 	"github.com/knx-go/knx-go/knx/cemi"
 	"github.com/knx-go/knx-go/knx/util"
@@ -43,19 +44,34 @@ func (req *TunnelReq) Pack(buffer []byte) {
 
 // Unpack parses the given service payload in order to initialize the structure.
 func (req *TunnelReq) Unpack(data []byte) (n uint, err error) {
-	var length, reserved uint8
+	return req.unpack(data, cemi.Unpack)
+}
 
-	if n, err = util.UnpackSome(
-		data, &length, &req.Channel, &req.SeqNumber, &reserved,
-	); err != nil {
-		return
+// unpackInto works like Unpack, but reuses the payload.
+func (req *TunnelReq) unpackInto(data []byte) (n uint, err error) {
+	return req.unpack(data, cemi.UnpackInto)
+}
+
+// unpack parses the connection header and uses unpackPayload to parse the payload.
+func (req *TunnelReq) unpack(
+	data []byte,
+	unpackPayload func([]byte, *cemi.Message) (uint, error),
+) (n uint, err error) {
+	// The header is decoded by hand, because passing its fields to util.UnpackSome causes
+	// allocations.
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
 	}
 
-	if length != 4 {
+	req.Channel = data[1]
+	req.SeqNumber = data[2]
+	n = 4
+
+	if data[0] != 4 {
 		return n, errors.New("header length is not 4")
 	}
 
-	m, err := cemi.Unpack(data[n:], &req.Payload)
+	m, err := unpackPayload(data[n:], &req.Payload)
 	n += m
 
 	return
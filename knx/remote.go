@@ -0,0 +1,126 @@
+package knx
+
+import (
+	"errors"
+	"net"
+	"time"
+
+	"github.com/knx-go/knx-go/knx/knxnet"
+)
+
+// sendRemote multicasts the request which is generated by build. The function build receives the
+// endpoint which the devices shall respond to. The returned socket must be closed by the caller.
+func sendRemote(
+	ifi *net.Interface,
+	multicastAddress string,
+	build func(knxnet.HostInfo) knxnet.ServicePackable,
+) (*knxnet.RouterSocket, error) {
+	socket, err := knxnet.ListenRouterOnInterface(ifi, multicastAddress, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Devices with a wrong IP configuration cannot reach us via unicast, therefore they shall
+	// respond to the multicast address.
+	hostInfo, err := knxnet.HostInfoFromAddress(socket.Addr())
+	if err != nil {
+		socket.Close()
+		return nil, err
+	}
+
+	if err := socket.Send(build(hostInfo)); err != nil {
+		socket.Close()
+		return nil, err
+	}
+
+	return socket, nil
+}
+
+// RemoteDiagnose asks all KNXnet/IP servers which match the selector to report their
+// configuration. This works even if their IP configuration does not fit the local network.
+func RemoteDiagnose(multicastAddress string, selector knxnet.Selector, timeout time.Duration) ([]*knxnet.RemoteDiagRes, error) {
+	return RemoteDiagnoseOnInterface(nil, multicastAddress, selector, timeout)
+}
+
+// RemoteDiagnoseOnInterface works like RemoteDiagnose on a specific interface. If the interface is
+// nil, the system-assigned multicast interface is used.
+func RemoteDiagnoseOnInterface(
+	ifi *net.Interface,
+	multicastAddress string,
+	selector knxnet.Selector,
+	timeout time.Duration,
+) ([]*knxnet.RemoteDiagRes, error) {
+	socket, err := sendRemote(ifi, multicastAddress, func(hostInfo knxnet.HostInfo) knxnet.ServicePackable {
+		return &knxnet.RemoteDiagReq{HostInfo: hostInfo, Selector: selector}
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer socket.Close()
+
+	results := []*knxnet.RemoteDiagRes{}
+	deadline := time.After(timeout)
+
+	for {
+		select {
+		case msg, open := <-socket.Inbound():
+			if !open {
+				return results, errors.New("socket closed before the timeout")
+			}
+
+			if res, ok := msg.(*knxnet.RemoteDiagRes); ok {
+				results = append(results, res)
+			}
+
+		case <-deadline:
+			return results, nil
+		}
+	}
+}
+
+// RemoteConfigure pushes a basic IP configuration to all KNXnet/IP servers which match the selector.
+// The servers do not respond. Use RemoteDiagnose to verify the result.
+func RemoteConfigure(multicastAddress string, selector knxnet.Selector, config knxnet.IPConfigDIB) error {
+	return RemoteConfigureOnInterface(nil, multicastAddress, selector, config)
+}
+
+// RemoteConfigureOnInterface works like RemoteConfigure on a specific interface. If the interface
+// is nil, the system-assigned multicast interface is used.
+func RemoteConfigureOnInterface(
+	ifi *net.Interface,
+	multicastAddress string,
+	selector knxnet.Selector,
+	config knxnet.IPConfigDIB,
+) error {
+	socket, err := sendRemote(ifi, multicastAddress, func(hostInfo knxnet.HostInfo) knxnet.ServicePackable {
+		return &knxnet.RemoteBasicConfigReq{HostInfo: hostInfo, Selector: selector, IPConfig: config}
+	})
+	if err != nil {
+		return err
+	}
+
+	return socket.Close()
+}
+
+// RemoteReset resets all KNXnet/IP servers which match the selector.
+func RemoteReset(multicastAddress string, selector knxnet.Selector, mode knxnet.ResetMode) error {
+	return RemoteResetOnInterface(nil, multicastAddress, selector, mode)
+}
+
+// RemoteResetOnInterface works like RemoteReset on a specific interface. If the interface is nil,
+// the system-assigned multicast interface is used.
+func RemoteResetOnInterface(
+	ifi *net.Interface,
+	multicastAddress string,
+	selector knxnet.Selector,
+	mode knxnet.ResetMode,
+) error {
+	socket, err := sendRemote(ifi, multicastAddress, func(knxnet.HostInfo) knxnet.ServicePackable {
+		return &knxnet.RemoteResetReq{Selector: selector, Mode: mode}
+	})
+	if err != nil {
+		return err
+	}
+
+	return socket.Close()
+}
@@ -6,7 +6,7 @@ package knx
 import (
 	"container/list"
 	"errors"
-	"math/rand"
+	"log/slog"
 	"net"
 	"sync"
 	"time"
@@ -26,10 +26,22 @@ type RouterConfig struct {
 	Interface *net.Interface
 	// Specifies if Multicast Loopback should be enabled.
 	MulticastLoopbackEnabled bool
-	// Pause duration after sending. 0 means disabled.
-	// According to the specification, we may choose to always pause for 20 ms // after transmitting,
-	// bu we should always pause for at least 5 ms on a multicast address.
+	// Minimum pause between two transmissions. 0 means disabled.
+	// According to the specification, a device must not send more than 50 telegrams per second,
+	// which is what the default pause of 20 ms achieves. We should always pause for at least 5 ms
+	// on a multicast address.
 	PostSendPauseDuration time.Duration
+	// Specifies how many messages may wait for their transmission. Send fails with ErrQueueFull if
+	// the queue is exhausted.
+	QueueSize uint
+	// Specifies how many incoming messages are kept until they are received through the inbound
+	// channel.
+	InboundBufferSize uint
+	// Specifies what happens to incoming messages when the inbound buffer is full.
+	InboundOverflow OverflowPolicy
+	// Logger receives the log records of the router and its socket. If it is nil, the records are
+	// forwarded to util.Logger.
+	Logger *slog.Logger
 }
 
 // DefaultRouterConfig is a good default configuration for a Router client.
@@ -37,6 +49,9 @@ var DefaultRouterConfig = RouterConfig{
 	RetainCount:              32,
 	MulticastLoopbackEnabled: false,
 	PostSendPauseDuration:    20 * time.Millisecond,
+	QueueSize:                64,
+	InboundBufferSize:        64,
+	InboundOverflow:          OverflowDropOldest,
 }
 
 // checkRouterConfig validates the given RouterConfig.
@@ -45,174 +60,328 @@ func checkRouterConfig(config RouterConfig) RouterConfig {
 		config.RetainCount = DefaultRouterConfig.RetainCount
 	}
 
+	if config.QueueSize == 0 {
+		config.QueueSize = DefaultRouterConfig.QueueSize
+	}
+
+	if config.InboundBufferSize == 0 {
+		config.InboundBufferSize = DefaultRouterConfig.InboundBufferSize
+	}
+
+	if config.Logger == nil {
+		config.Logger = util.DefaultLogger()
+	}
+
 	return config
 }
 
+var (
+	// ErrQueueFull is returned when a message cannot be sent because too many messages are waiting
+	// for their transmission.
+	ErrQueueFull = errors.New("send queue is full")
+
+	errRouterClosed = errors.New("router has been closed")
+)
+
+// systemBroadcastBuffer is the number of system broadcasts that are kept until the user receives
+// them.
+const systemBroadcastBuffer = 16
+
+// routerRequest is a packet that waits for its transmission.
+type routerRequest struct {
+	packet knxnet.ServicePackable
+	result chan error
+}
+
 // A Router provides the means to communicate with KNXnet/IP routers in a IP multicast group.
 // It supports sending and receiving CEMI-encoded frames, aswell as basic flow control.
 type Router struct {
-	sock          knxnet.Socket
-	config        RouterConfig
-	inbound       chan cemi.Message
-	sendMu        sync.Mutex
-	retainer      *list.List
-	postSendPause time.Duration
+	sock    knxnet.Socket
+	config  RouterConfig
+	clock   clock
+	log     *slog.Logger
+	inbound *inboundBuffer
+	sysBc   chan cemi.Message
+
+	// Flow control
+	flow    *flowControl
+	queue   chan routerRequest
+	flowMod chan struct{}
+
+	// Messages for potential resending
+	retainMu sync.Mutex
+	retainer *list.List
+
+	// Goroutine controller
+	done chan struct{}
+	once sync.Once
 }
 
-// sendMultiple sends each message from the slice. Doesn't matter if one fails, all will be tried.
-func (router *Router) sendMultiple(messages []cemi.Message) {
-	for _, message := range messages {
-		router.Send(message)
+// enqueue adds the request to the send queue without blocking.
+func (router *Router) enqueue(req routerRequest) error {
+	select {
+	case router.queue <- req:
+		return nil
+
+	default:
+		return ErrQueueFull
 	}
 }
 
 // resendLost resends the last count messages.
 func (router *Router) resendLost(count uint16) {
-	router.sendMu.Lock()
-	defer router.sendMu.Unlock()
+	router.retainMu.Lock()
 
 	// Make sure not to overflow our retainer list.
 	if int(count) > router.retainer.Len() {
 		count = uint16(router.retainer.Len())
 	}
 
-	messages := make([]cemi.Message, count)
+	packets := make([]knxnet.ServicePackable, count)
 
 	// Retrieve the messages in reverse. This enables us to resend them in the order in which the
 	// have been sent initially.
-	for i := len(messages) - 1; i >= 0; i-- {
-		messages[i] = router.retainer.Remove(router.retainer.Back()).(cemi.Message)
+	for i := len(packets) - 1; i >= 0; i-- {
+		packets[i] = router.retainer.Remove(router.retainer.Back()).(knxnet.ServicePackable)
 	}
 
-	go router.sendMultiple(messages)
+	router.retainMu.Unlock()
+
+	for _, packet := range packets {
+		if err := router.enqueue(routerRequest{packet: packet}); err != nil {
+			router.log.Warn("Cannot resend lost message", "service", packet.Service(), "error", err)
+		}
+	}
 }
 
-// pushInbound sends the message through the inbound channel. If the sending blocks, it will launch
-// a goroutine which will do the sending.
-func (router *Router) pushInbound(msg cemi.Message) {
+// retain stores the packet for potential resending.
+func (router *Router) retain(packet knxnet.ServicePackable) {
+	router.retainMu.Lock()
+	defer router.retainMu.Unlock()
+
+	// TODO: Ensure that the retained value is independent from the parameter, i.e. not modified
+	//       when the user changes a member of data.
+	router.retainer.PushBack(packet)
+
+	// We don't want to keep more messages than necessary. The overhead needs to be removed.
+	for uint(router.retainer.Len()) > router.config.RetainCount {
+		router.retainer.Remove(router.retainer.Front())
+	}
+}
+
+// pushSystemBroadcast sends the message through the system broadcast channel. The message is
+// discarded if the channel is full.
+func (router *Router) pushSystemBroadcast(msg cemi.Message) {
 	select {
-	case router.inbound <- msg:
+	case router.sysBc <- msg:
 
 	default:
-		go func() {
-			// Since this goroutine decouples from the server goroutine, it might try to send when
-			// the server closed the inbound channel. Sending to a closed channel will panic. But we
-			// don't care, because cool guys don't look at explosions.
-			defer func() { recover() }()
-			router.inbound <- msg
-		}()
+		router.log.Warn("Discarded system broadcast, because the channel is full")
+	}
+}
+
+// awaitTransmission blocks until the flow control permits the next transmission. It returns false
+// if the router has been closed in the meantime.
+func (router *Router) awaitTransmission() bool {
+	for {
+		delay := router.flow.delay()
+		if delay <= 0 {
+			return true
+		}
+
+		timer := router.clock.NewTimer(delay)
+
+		select {
+		case <-router.done:
+			timer.Stop()
+			return false
+
+		// The flow control has changed, hence the delay needs to be recomputed.
+		case <-router.flowMod:
+			timer.Stop()
+
+		case <-timer.C():
+		}
 	}
 }
 
-const maxWaitTime = 50 * time.Millisecond
+// schedule transmits the queued messages as permitted by the flow control.
+func (router *Router) schedule() {
+	router.log.Debug("Started scheduler")
+	defer router.log.Debug("Scheduler exited")
+
+	for {
+		var req routerRequest
+
+		select {
+		case <-router.done:
+			return
+
+		case req = <-router.queue:
+		}
+
+		if !router.awaitTransmission() {
+			if req.result != nil {
+				req.result <- errRouterClosed
+			}
+
+			return
+		}
+
+		err := router.sock.Send(req.packet)
+		router.flow.sent()
+
+		if err == nil {
+			router.retain(req.packet)
+		}
+
+		if req.result != nil {
+			req.result <- err
+		}
+	}
+}
 
 // serve listens for incoming routing-related packets.
 func (router *Router) serve() {
-	util.Log(router, "Started worker")
-	defer util.Log(router, "Worker exited")
+	router.log.Debug("Started worker")
+	defer router.log.Debug("Worker exited")
 
-	defer close(router.inbound)
+	defer router.inbound.close()
+	defer close(router.sysBc)
 
 	for msg := range router.sock.Inbound() {
 		switch msg := msg.(type) {
 		case *knxnet.RoutingInd:
 			// Try to push it to the client without blocking this goroutine too long.
-			router.pushInbound(msg.Payload)
+			router.inbound.push(msg.Payload)
+
+		case *knxnet.RoutingSystemBroadcast:
+			router.pushSystemBroadcast(msg.Payload)
 
 		case *knxnet.RoutingBusy:
-			var trandom time.Duration
-			// If Control is 0, we should add a specified random amount of time
-			// to the WaitTime. Otherwise, it is not specified, we just wait WaitTime.
-			if msg.Control == 0 {
-				trandom = time.Duration(rand.Float64()*50) * time.Millisecond
-			}
+			router.log.Info("Router is busy", "wait", msg.WaitTime, "control", msg.Control)
 
-			// Inhibit sending for the given time.
-			router.sendMu.Lock()
+			// Inhibit sending for the requested time.
+			router.flow.handleBusy(msg.WaitTime, msg.Control)
 
-			waitTime := msg.WaitTime + trandom
-			if waitTime > maxWaitTime {
-				waitTime = maxWaitTime
+			select {
+			case router.flowMod <- struct{}{}:
+			default:
 			}
 
-			time.AfterFunc(waitTime, router.sendMu.Unlock)
-
 		case *knxnet.RoutingLost:
+			router.log.Info("Router lost messages", "count", msg.Count)
+
 			// Resend the last msg.Count messages.
 			router.resendLost(msg.Count)
 		}
 	}
 }
 
+// newRouter creates a Router on top of the given socket.
+func newRouter(sock knxnet.Socket, config RouterConfig, clock clock) *Router {
+	config = checkRouterConfig(config)
+
+	r := &Router{
+		sock:     sock,
+		config:   config,
+		clock:    clock,
+		log:      config.Logger.With("component", "router"),
+		inbound:  newInboundBuffer(config.InboundBufferSize, config.InboundOverflow),
+		sysBc:    make(chan cemi.Message, systemBroadcastBuffer),
+		flow:     newFlowControl(clock, config.PostSendPauseDuration),
+		queue:    make(chan routerRequest, config.QueueSize),
+		flowMod:  make(chan struct{}, 1),
+		retainer: list.New(),
+		done:     make(chan struct{}),
+	}
+
+	go r.serve()
+	go r.schedule()
+
+	return r
+}
+
 // NewRouter creates a new Router that joins the given multicast group. You may pass a
 // zero-initialized value as parameter config, the default values will be set up.
 func NewRouter(multicastAddress string, config RouterConfig) (*Router, error) {
 	config = checkRouterConfig(config)
+	config.Logger = config.Logger.With("multicast", multicastAddress)
 
 	sock, err := knxnet.ListenRouterOnInterface(config.Interface, multicastAddress, config.MulticastLoopbackEnabled)
 	if err != nil {
 		return nil, err
 	}
 
-	r := &Router{
-		sock:          sock,
-		config:        config,
-		inbound:       make(chan cemi.Message),
-		retainer:      list.New(),
-		postSendPause: config.PostSendPauseDuration,
+	sock.SetLogger(config.Logger.With("component", "knxnet"))
+
+	return newRouter(sock, config, realClock{}), nil
+}
+
+// send queues the packet and waits for its transmission.
+func (router *Router) send(packet knxnet.ServicePackable) error {
+	req := routerRequest{packet: packet, result: make(chan error, 1)}
+
+	if err := router.enqueue(req); err != nil {
+		return err
 	}
 
-	go r.serve()
+	select {
+	case err := <-req.result:
+		return err
 
-	return r, nil
+	case <-router.done:
+		return errRouterClosed
+	}
 }
 
-// Send transmits a packet.
-func (router *Router) Send(data cemi.Message) (err error) {
+// Send transmits a packet. The packet is queued until the flow control permits its transmission.
+// Send blocks until the packet has been transmitted.
+func (router *Router) Send(data cemi.Message) error {
 	if data == nil {
 		return errors.New("nil-pointers are not sendable")
 	}
 
-	// We lock this before doing any sending so the server goroutine can adjust the flow control.
-	router.sendMu.Lock()
-
-	defer func() {
-		// This is called as a goroutine in order to not block the return of Send.
-		go func() {
-			if err == nil && router.postSendPause > 0 {
-				time.Sleep(router.postSendPause)
-			}
-
-			router.sendMu.Unlock()
-		}()
-	}()
-
-	err = router.sock.Send(&knxnet.RoutingInd{Payload: data})
+	return router.send(&knxnet.RoutingInd{Payload: data})
+}
 
-	if err == nil {
-		// Store this for potential resending.
-		// TODO: Ensure that the retained value is independent from the parameter, i.e. not modified
-		//       when the user changes a member of data.
-		router.retainer.PushBack(data)
-
-		// We don't want to keep more messages than necessary. The overhead needs to be removed.
-		for uint(router.retainer.Len()) > router.config.RetainCount {
-			router.retainer.Remove(router.retainer.Front())
-		}
+// SendSystemBroadcast transmits a system broadcast telegram using a ROUTING_SYSTEM_BROADCAST. It is
+// subject to the same flow control as Send.
+func (router *Router) SendSystemBroadcast(data cemi.Message) error {
+	if data == nil {
+		return errors.New("nil-pointers are not sendable")
 	}
 
-	return err
+	return router.send(&knxnet.RoutingSystemBroadcast{Payload: data})
+}
+
+// SystemBroadcasts returns the channel which transmits incoming system broadcast telegrams. These
+// are not delivered through Inbound. Only a limited number of telegrams is kept if the channel
+// is not drained, the others are discarded. The channel is closed together with the inbound
+// channel.
+func (router *Router) SystemBroadcasts() <-chan cemi.Message {
+	return router.sysBc
 }
 
 // Inbound returns the channel which transmits incoming data. The channel will be closed when the
 // underlying Socket closes its inbound channel (which happens on read errors or upon closing it).
 func (router *Router) Inbound() <-chan cemi.Message {
-	return router.inbound
+	return router.inbound.channel()
+}
+
+// InboundDropped returns the number of incoming messages which have been discarded, because the
+// inbound buffer was full.
+func (router *Router) InboundDropped() uint64 {
+	return router.inbound.droppedCount()
 }
 
 // Close closes the underlying socket and terminates the Router thereby.
 func (router *Router) Close() {
-	router.sock.Close()
+	router.once.Do(func() {
+		close(router.done)
+		router.inbound.abort()
+		router.sock.Close()
+	})
 }
 
 // GroupRouter is a Router that provides only a group communication interface.
@@ -227,7 +396,7 @@ func NewGroupRouter(multicastAddress string, config RouterConfig) (gr GroupRoute
 
 	if err == nil {
 		gr.inbound = make(chan GroupEvent)
-		go serveGroupInbound(gr.Router.Inbound(), gr.inbound)
+		go serveGroupInbound(gr.Router.Inbound(), gr.inbound, gr.Router.log)
 	}
 
 	return
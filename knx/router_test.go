@@ -1,10 +1,14 @@
 package knx
 
 import (
+	"log/slog"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/knx-go/knx-go/knx/cemi"
+	"github.com/knx-go/knx-go/knx/knxnet"
 )
 
 type stubMessage struct {
@@ -24,6 +28,12 @@ func TestCheckRouterConfigAppliesDefaults(t *testing.T) {
 	if got.RetainCount != DefaultRouterConfig.RetainCount {
 		t.Fatalf("expected RetainCount %d, got %d", DefaultRouterConfig.RetainCount, got.RetainCount)
 	}
+	if got.QueueSize != DefaultRouterConfig.QueueSize {
+		t.Fatalf("expected QueueSize %d, got %d", DefaultRouterConfig.QueueSize, got.QueueSize)
+	}
+	if got.InboundBufferSize != DefaultRouterConfig.InboundBufferSize {
+		t.Fatalf("expected InboundBufferSize %d, got %d", DefaultRouterConfig.InboundBufferSize, got.InboundBufferSize)
+	}
 
 	custom := RouterConfig{RetainCount: 5, PostSendPauseDuration: time.Second}
 	got = checkRouterConfig(custom)
@@ -35,48 +45,212 @@ func TestCheckRouterConfigAppliesDefaults(t *testing.T) {
 	}
 }
 
-func TestRouterPushInboundBuffered(t *testing.T) {
+func TestRouterSendQueueFull(t *testing.T) {
 	t.Parallel()
 
-	router := &Router{inbound: make(chan cemi.Message, 1)}
-	msg := &stubMessage{id: 1}
+	router := &Router{queue: make(chan routerRequest, 1)}
+
+	if err := router.enqueue(routerRequest{packet: &knxnet.RoutingInd{Payload: &stubMessage{id: 1}}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
 
-	router.pushInbound(msg)
+	if err := router.Send(&stubMessage{id: 2}); err != ErrQueueFull {
+		t.Fatalf("expected %v, got %v", ErrQueueFull, err)
+	}
+}
+
+// expectRouted waits for the gateway to receive a routing indication with the given message.
+func expectRouted(t *testing.T, gateway *dummySocket, msg cemi.Message) {
+	t.Helper()
 
 	select {
-	case got := <-router.inbound:
-		if got != msg {
-			t.Fatalf("expected %p, got %p", msg, got)
+	case svc := <-gateway.Inbound():
+		ind, ok := svc.(*knxnet.RoutingInd)
+		if !ok || ind.Payload != msg {
+			t.Fatalf("unexpected service %v", svc)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for routing indication")
+	}
+}
+
+// expectNotRouted makes sure that the gateway does not receive anything for a moment.
+func expectNotRouted(t *testing.T, gateway *dummySocket) {
+	t.Helper()
+
+	select {
+	case svc := <-gateway.Inbound():
+		t.Fatalf("unexpected service %v", svc)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestRouterSendRateLimit(t *testing.T) {
+	t.Parallel()
+
+	client, gateway := newDummySockets()
+	defer gateway.Close()
+
+	clock := newFakeClock()
+	router := newRouter(client, RouterConfig{PostSendPauseDuration: 20 * time.Millisecond}, clock)
+	defer router.Close()
+
+	first, second := &stubMessage{id: 1}, &stubMessage{id: 2}
+
+	if err := router.Send(first); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectRouted(t, gateway, first)
+
+	result := make(chan error, 1)
+	go func() { result <- router.Send(second) }()
+
+	if delay := clock.waitTimer(); delay != 20*time.Millisecond {
+		t.Fatalf("unexpected delay %v", delay)
+	}
+	expectNotRouted(t, gateway)
+
+	clock.Advance(20 * time.Millisecond)
+	expectRouted(t, gateway, second)
+
+	if err := <-result; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestRouterSendBusy(t *testing.T) {
+	t.Parallel()
+
+	client, gateway := newDummySockets()
+	defer gateway.Close()
+
+	clock := newFakeClock()
+	router := newRouter(client, RouterConfig{}, clock)
+	defer router.Close()
+
+	if err := gateway.sendAny(&knxnet.RoutingBusy{WaitTime: 100 * time.Millisecond, Control: 1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Wait until the router has processed the busy frame.
+	deadline := time.Now().Add(time.Second)
+	for router.flow.delay() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("busy frame has not been processed")
 		}
-	default:
-		t.Fatal("expected message to be delivered without blocking")
+		time.Sleep(time.Millisecond)
+	}
+
+	msg := &stubMessage{id: 1}
+	result := make(chan error, 1)
+	go func() { result <- router.Send(msg) }()
+
+	if delay := clock.waitTimer(); delay != 100*time.Millisecond {
+		t.Fatalf("unexpected delay %v", delay)
+	}
+	expectNotRouted(t, gateway)
+
+	clock.Advance(100 * time.Millisecond)
+	expectRouted(t, gateway, msg)
+
+	if err := <-result; err != nil {
+		t.Fatalf("unexpected error: %v", err)
 	}
 }
 
-func TestRouterPushInboundSpawnsWorker(t *testing.T) {
+func TestRouterSystemBroadcast(t *testing.T) {
 	t.Parallel()
 
-	router := &Router{inbound: make(chan cemi.Message)}
-	msg := &stubMessage{id: 2}
+	client, gateway := newDummySockets()
+	defer gateway.Close()
 
-	done := make(chan struct{})
-	go func() {
-		router.pushInbound(msg)
-		close(done)
-	}()
+	router := newRouter(client, RouterConfig{}, newFakeClock())
+	defer router.Close()
+
+	outgoing := &stubMessage{id: 1}
+	if err := router.SendSystemBroadcast(outgoing); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
 
 	select {
-	case <-done:
+	case svc := <-gateway.Inbound():
+		bc, ok := svc.(*knxnet.RoutingSystemBroadcast)
+		if !ok || bc.Payload != outgoing {
+			t.Fatalf("unexpected service %v", svc)
+		}
 	case <-time.After(time.Second):
-		t.Fatal("pushInbound should not block when channel is full")
+		t.Fatal("timed out waiting for system broadcast")
+	}
+
+	incoming := &stubMessage{id: 2}
+	if err := gateway.sendAny(&knxnet.RoutingSystemBroadcast{Payload: incoming}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
 	}
 
 	select {
-	case got := <-router.inbound:
-		if got != msg {
-			t.Fatalf("expected %p, got %p", msg, got)
+	case got := <-router.SystemBroadcasts():
+		if got != incoming {
+			t.Fatalf("expected %p, got %p", incoming, got)
 		}
+	case got := <-router.Inbound():
+		t.Fatalf("system broadcast delivered as regular message %v", got)
 	case <-time.After(time.Second):
-		t.Fatal("expected goroutine to forward message")
+		t.Fatal("timed out waiting for system broadcast")
+	}
+}
+
+// recordingWriter collects the output of a log handler.
+type recordingWriter struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (w *recordingWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.lines = append(w.lines, string(p))
+	return len(p), nil
+}
+
+func (w *recordingWriter) contains(substr string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, line := range w.lines {
+		if strings.Contains(line, substr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestRouterLogger(t *testing.T) {
+	t.Parallel()
+
+	client, gateway := newDummySockets()
+	defer gateway.Close()
+
+	output := &recordingWriter{}
+	logger := slog.New(slog.NewTextHandler(output, &slog.HandlerOptions{Level: slog.LevelInfo}))
+
+	router := newRouter(client, RouterConfig{Logger: logger}, newFakeClock())
+	defer router.Close()
+
+	if err := gateway.sendAny(&knxnet.RoutingLost{Count: 3}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for !output.contains(`msg="Router lost messages" component=router count=3`) {
+		if time.Now().After(deadline) {
+			t.Fatalf("unexpected log output %q", output.lines)
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	if output.contains("Started worker") {
+		t.Error("debug records should have been discarded")
 	}
 }
@@ -0,0 +1,450 @@
+package knx
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/knx-go/knx-go/knx/cemi"
+	"github.com/knx-go/knx-go/knx/ft12"
+	"github.com/knx-go/knx-go/knx/util"
+)
+
+// EMI selects the External Message Interface that is spoken with a serial interface.
+type EMI uint8
+
+const (
+	// EMICommon is the Common External Message Interface (cEMI).
+	EMICommon EMI = iota
+
+	// EMI2 is the External Message Interface 2.
+	EMI2
+)
+
+// String generates a string representation of the EMI.
+func (emi EMI) String() string {
+	switch emi {
+	case EMICommon:
+		return "cEMI"
+
+	case EMI2:
+		return "EMI2"
+	}
+
+	return "Unknown"
+}
+
+// SerialConfig allows you to configure the serial client's behavior.
+type SerialConfig struct {
+	// EMI determines the message format which is exchanged with the interface.
+	EMI EMI
+
+	// ResendInterval is the interval with which frames will be resent if no acknowledgement is
+	// received.
+	ResendInterval time.Duration
+
+	// ResponseTimeout specifies how long to wait for an acknowledgement.
+	ResponseTimeout time.Duration
+
+	// InboundBufferSize is the number of incoming messages which are kept until they are received
+	// through the inbound channel.
+	InboundBufferSize uint
+
+	// InboundOverflow determines what happens to incoming messages when the inbound buffer is full.
+	InboundOverflow OverflowPolicy
+
+	// Logger receives the log records of the client. If it is nil, the records are forwarded to
+	// util.Logger.
+	Logger *slog.Logger
+}
+
+// DefaultSerialConfig is a good default configuration for a Serial client.
+var DefaultSerialConfig = SerialConfig{
+	EMI:               EMICommon,
+	ResendInterval:    500 * time.Millisecond,
+	ResponseTimeout:   2 * time.Second,
+	InboundBufferSize: 64,
+	InboundOverflow:   OverflowDropOldest,
+}
+
+// checkSerialConfig makes sure that the configuration is actually usable.
+func checkSerialConfig(config SerialConfig) SerialConfig {
+	if config.ResendInterval <= 0 {
+		config.ResendInterval = DefaultSerialConfig.ResendInterval
+	}
+
+	if config.ResponseTimeout <= 0 {
+		config.ResponseTimeout = DefaultSerialConfig.ResponseTimeout
+	}
+
+	if config.InboundBufferSize == 0 {
+		config.InboundBufferSize = DefaultSerialConfig.InboundBufferSize
+	}
+
+	if config.Logger == nil {
+		config.Logger = util.DefaultLogger()
+	}
+
+	return config
+}
+
+var (
+	// emi2LinkLayer is a PEI_Switch.req which switches an EMI2 interface to link layer mode.
+	emi2LinkLayer = []byte{0xA9, 0x00, 0x18, 0x34, 0x56, 0x78, 0x0A}
+
+	// cemiLinkLayer is a M_PropWrite.req which sets the communication mode of the cEMI server to
+	// data link layer.
+	cemiLinkLayer = []byte{0xF6, 0x00, 0x08, 0x01, 0x34, 0x10, 0x01, 0x00}
+)
+
+// A Serial provides methods to communicate with a KNX interface that is attached through a serial
+// line using FT1.2 framing, e.g. a USB-serial adapter.
+type Serial struct {
+	rwc    io.ReadWriteCloser
+	config SerialConfig
+	log    *slog.Logger
+
+	// For outgoing frames
+	writeMu sync.Mutex
+	sendMu  sync.Mutex
+	fcb     bool
+	ack     chan struct{}
+
+	// Incoming frames
+	inbound *inboundBuffer
+
+	// Goroutine controller
+	done chan struct{}
+	once sync.Once
+	wait sync.WaitGroup
+}
+
+// write transmits the frame.
+func (serial *Serial) write(frame ft12.Frame) error {
+	serial.writeMu.Lock()
+	defer serial.writeMu.Unlock()
+
+	_, err := serial.rwc.Write(util.AllocAndPack(&frame))
+	return err
+}
+
+// request transmits the frame and waits for its acknowledgement. The frame is repeated if it is
+// not acknowledged in time. The caller must hold sendMu.
+func (serial *Serial) request(frame ft12.Frame) error {
+	// Discard acknowledgements that did not belong to any request.
+	select {
+	case <-serial.ack:
+	default:
+	}
+
+	if err := serial.write(frame); err != nil {
+		return err
+	}
+
+	// Start the resend timer.
+	ticker := time.NewTicker(serial.config.ResendInterval)
+	defer ticker.Stop()
+
+	// Setup timeout.
+	timeout := time.After(serial.config.ResponseTimeout)
+
+	for {
+		select {
+		// Termination has been requested.
+		case <-serial.done:
+			return errors.New("serial connection has been closed")
+
+		// Timeout reached.
+		case <-timeout:
+			return errResponseTimeout
+
+		// Resend timer fired.
+		case <-ticker.C:
+			if err := serial.write(frame); err != nil {
+				return err
+			}
+
+		// Received the acknowledgement.
+		case <-serial.ack:
+			return nil
+		}
+	}
+}
+
+// requestReset resets the link to the interface.
+func (serial *Serial) requestReset() error {
+	serial.sendMu.Lock()
+	defer serial.sendMu.Unlock()
+
+	if err := serial.request(ft12.NewFixed(ft12.ControlReset)); err != nil {
+		return err
+	}
+
+	// The first user data frame after a reset carries a set frame count bit.
+	serial.fcb = true
+
+	return nil
+}
+
+// requestData transmits the user data and waits for its acknowledgement.
+func (serial *Serial) requestData(data []byte) error {
+	serial.sendMu.Lock()
+	defer serial.sendMu.Unlock()
+
+	control := ft12.ControlData
+	if serial.fcb {
+		control |= ft12.ControlFCB
+	}
+
+	if err := serial.request(ft12.NewVariable(control, data)); err != nil {
+		return err
+	}
+
+	serial.fcb = !serial.fcb
+
+	return nil
+}
+
+// packMessage converts the message into the format that is spoken with the interface.
+func (serial *Serial) packMessage(msg cemi.Message) ([]byte, error) {
+	if serial.config.EMI == EMICommon {
+		buffer := make([]byte, cemi.Size(msg))
+		cemi.Pack(buffer, msg)
+
+		return buffer, nil
+	}
+
+	req, ok := msg.(*cemi.LDataReq)
+	if !ok {
+		return nil, fmt.Errorf("message %v is not supported by %v", msg.MessageCode(), serial.config.EMI)
+	}
+
+	buffer := make([]byte, 1+req.TPSize())
+	buffer[0] = byte(cemi.LDataReqCode)
+	req.PackTP(buffer[1:])
+
+	return buffer, nil
+}
+
+// unpackMessage converts the data received from the interface into a message.
+func (serial *Serial) unpackMessage(data []byte) (cemi.Message, error) {
+	var msg cemi.Message
+
+	if serial.config.EMI == EMICommon {
+		_, err := cemi.Unpack(data, &msg)
+		return msg, err
+	}
+
+	if len(data) < 1 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	switch code := cemi.MessageCode(data[0]); code {
+	case cemi.LDataIndCode:
+		ind := &cemi.LDataInd{}
+		if _, err := ind.UnpackTP(data[1:]); err != nil {
+			return nil, err
+		}
+
+		msg = ind
+
+	case cemi.LDataConCode:
+		con := &cemi.LDataCon{}
+		if _, err := con.UnpackTP(data[1:]); err != nil {
+			return nil, err
+		}
+
+		// The lowest bit of the control field carries the confirmation.
+		con.Control1 |= cemi.ControlField1(data[1]) & cemi.Control1HasError
+		msg = con
+
+	case cemi.LBusmonIndCode:
+		ind := cemi.LBusmonInd(append([]byte(nil), data[1:]...))
+		msg = &ind
+
+	default:
+		msg = &cemi.UnsupportedMessage{Code: code, Data: append([]byte(nil), data[1:]...)}
+	}
+
+	return msg, nil
+}
+
+// serve reads incoming frames until the underlying stream fails or is closed.
+func (serial *Serial) serve() {
+	serial.log.Debug("Started worker")
+	defer serial.log.Debug("Worker exited")
+
+	defer serial.inbound.close()
+	defer serial.wait.Done()
+
+	reader := ft12.NewReader(serial.rwc)
+
+	// The frame count bit of the previous user data frame, used to detect repetitions.
+	var lastFCB ft12.Control
+	hasLastFCB := false
+
+	for {
+		frame, err := reader.ReadFrame()
+		if err == ft12.ErrChecksum || err == ft12.ErrMalformed {
+			serial.log.Warn("Discarded frame", "error", err)
+			continue
+		} else if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, os.ErrClosed) {
+				serial.log.Debug("Error while reading", "error", err)
+			} else {
+				serial.log.Error("Error while reading", "error", err)
+			}
+
+			return
+		}
+
+		switch frame.Kind {
+		case ft12.KindAck:
+			// Relay the acknowledgement to a waiting request.
+			select {
+			case serial.ack <- struct{}{}:
+			default:
+			}
+
+		case ft12.KindFixed:
+			// A reset of the interface resets the frame count bit.
+			if frame.Control.Function() == 0 {
+				hasLastFCB = false
+			}
+
+			if err := serial.write(ft12.NewAck()); err != nil {
+				serial.log.Warn("Error while acknowledging", "control", frame.Control, "error", err)
+			}
+
+		case ft12.KindVariable:
+			if err := serial.write(ft12.NewAck()); err != nil {
+				serial.log.Warn("Error while acknowledging", "control", frame.Control, "error", err)
+			}
+
+			// Frames which are repeated because our acknowledgement got lost, are dropped.
+			if frame.Control&ft12.ControlFCV != 0 {
+				fcb := frame.Control & ft12.ControlFCB
+				if hasLastFCB && fcb == lastFCB {
+					serial.log.Debug("Discarded repeated frame", "control", frame.Control)
+					continue
+				}
+
+				lastFCB, hasLastFCB = fcb, true
+			}
+
+			msg, err := serial.unpackMessage(frame.Data)
+			if err != nil {
+				serial.log.Warn("Error while unpacking message", "error", err)
+				continue
+			}
+
+			serial.inbound.push(msg)
+		}
+	}
+}
+
+// NewSerial initializes the interface which is reachable through the given stream, e.g. a serial
+// port. You can pass a zero initialized SerialConfig; the function will take care of filling in the
+// default values. The stream is closed when the Serial is closed.
+func NewSerial(rwc io.ReadWriteCloser, config SerialConfig) (*Serial, error) {
+	config = checkSerialConfig(config)
+
+	serial := &Serial{
+		rwc:    rwc,
+		config: config,
+		log:    config.Logger.With("component", "serial", "emi", config.EMI),
+		ack:    make(chan struct{}, 1),
+		done:   make(chan struct{}),
+	}
+
+	serial.inbound = newInboundBuffer(serial.config.InboundBufferSize, serial.config.InboundOverflow)
+
+	serial.wait.Add(1)
+	go serial.serve()
+
+	err := serial.requestReset()
+	if err == nil {
+		// Switch the interface to link layer mode.
+		if serial.config.EMI == EMICommon {
+			err = serial.requestData(cemiLinkLayer)
+		} else {
+			err = serial.requestData(emi2LinkLayer)
+		}
+	}
+
+	if err != nil {
+		serial.Close()
+		return nil, err
+	}
+
+	return serial, nil
+}
+
+// Close terminates the connection and closes the underlying stream.
+func (serial *Serial) Close() {
+	serial.once.Do(func() {
+		close(serial.done)
+		serial.inbound.abort()
+
+		serial.rwc.Close()
+		serial.wait.Wait()
+	})
+}
+
+// Inbound retrieves the channel which transmits incoming data. The channel is closed when the
+// underlying stream fails or when the connection is closed.
+func (serial *Serial) Inbound() <-chan cemi.Message {
+	return serial.inbound.channel()
+}
+
+// InboundDropped returns the number of incoming messages which have been discarded, because the
+// inbound buffer was full.
+func (serial *Serial) InboundDropped() uint64 {
+	return serial.inbound.droppedCount()
+}
+
+// Send transmits the message to the interface and waits for its acknowledgement.
+func (serial *Serial) Send(data cemi.Message) error {
+	if data == nil {
+		return errors.New("nil-pointers are not sendable")
+	}
+
+	buffer, err := serial.packMessage(data)
+	if err != nil {
+		return err
+	}
+
+	return serial.requestData(buffer)
+}
+
+// GroupSerial is a Serial that provides only a group communication interface.
+type GroupSerial struct {
+	*Serial
+	inbound chan GroupEvent
+}
+
+// NewGroupSerial creates a new Serial for group communication.
+func NewGroupSerial(rwc io.ReadWriteCloser, config SerialConfig) (gs GroupSerial, err error) {
+	gs.Serial, err = NewSerial(rwc, config)
+
+	if err == nil {
+		gs.inbound = make(chan GroupEvent)
+		go serveGroupInbound(gs.Serial.Inbound(), gs.inbound, gs.Serial.log)
+	}
+
+	return
+}
+
+// Send a group communication.
+func (gs *GroupSerial) Send(event GroupEvent) error {
+	return gs.Serial.Send(&cemi.LDataReq{LData: buildGroupOutbound(event)})
+}
+
+// Inbound returns the channel on which group communication can be received.
+func (gs *GroupSerial) Inbound() <-chan GroupEvent {
+	return gs.inbound
+}
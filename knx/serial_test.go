@@ -0,0 +1,284 @@
+package knx
+
+import (
+	"bytes"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/knx-go/knx-go/knx/cemi"
+	"github.com/knx-go/knx-go/knx/ft12"
+	"github.com/knx-go/knx-go/knx/util"
+)
+
+// dummyInterface is the interface side of a serial line. It acknowledges every frame if desired and
+// records all frames it receives.
+type dummyInterface struct {
+	conn     net.Conn
+	writeMu  sync.Mutex
+	frames   chan ft12.Frame
+	noAck    bool
+	fcbState bool
+}
+
+func newDummyInterface(noAck bool) (*dummyInterface, net.Conn) {
+	host, iface := net.Pipe()
+
+	dummy := &dummyInterface{
+		conn:   iface,
+		frames: make(chan ft12.Frame, 100),
+		noAck:  noAck,
+	}
+
+	go dummy.serve()
+
+	return dummy, host
+}
+
+func (iface *dummyInterface) serve() {
+	defer close(iface.frames)
+
+	reader := ft12.NewReader(iface.conn)
+
+	for {
+		frame, err := reader.ReadFrame()
+		if err != nil {
+			return
+		}
+
+		if frame.Kind != ft12.KindAck && !iface.noAck {
+			iface.write(ft12.NewAck())
+		}
+
+		iface.frames <- frame
+	}
+}
+
+func (iface *dummyInterface) write(frame ft12.Frame) {
+	iface.writeMu.Lock()
+	defer iface.writeMu.Unlock()
+
+	iface.conn.Write(util.AllocAndPack(&frame))
+}
+
+// sendData transmits user data to the host. If repeat is true, the frame count bit is not toggled.
+func (iface *dummyInterface) sendData(data []byte, repeat bool) {
+	if !repeat {
+		iface.fcbState = !iface.fcbState
+	}
+
+	control := ft12.ControlDir | ft12.ControlData
+	if iface.fcbState {
+		control |= ft12.ControlFCB
+	}
+
+	iface.write(ft12.NewVariable(control, data))
+}
+
+// next retrieves the next frame that the interface received.
+func (iface *dummyInterface) next(t *testing.T) ft12.Frame {
+	t.Helper()
+
+	select {
+	case frame := <-iface.frames:
+		return frame
+
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for frame")
+		return ft12.Frame{}
+	}
+}
+
+func packMessage(msg cemi.Message) []byte {
+	buffer := make([]byte, cemi.Size(msg))
+	cemi.Pack(buffer, msg)
+
+	return buffer
+}
+
+func makeSerialConn(t *testing.T, emi EMI) (*Serial, *dummyInterface) {
+	t.Helper()
+
+	iface, host := newDummyInterface(false)
+
+	serial, err := NewSerial(host, SerialConfig{EMI: emi})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if frame := iface.next(t); frame.Kind != ft12.KindFixed || frame.Control != ft12.ControlReset {
+		t.Fatalf("Expected reset request, got %+v", frame)
+	}
+
+	expected := cemiLinkLayer
+	if emi == EMI2 {
+		expected = emi2LinkLayer
+	}
+
+	if frame := iface.next(t); !bytes.Equal(frame.Data, expected) || frame.Control&ft12.ControlFCB == 0 {
+		t.Fatalf("Expected link layer request, got %+v", frame)
+	}
+
+	return serial, iface
+}
+
+func TestSerial_Send(t *testing.T) {
+	serial, iface := makeSerialConn(t, EMICommon)
+	defer serial.Close()
+
+	msg := &cemi.LDataReq{LData: buildGroupOutbound(GroupEvent{
+		Command:     GroupWrite,
+		Destination: cemi.NewGroupAddr3(1, 2, 3),
+		Data:        []byte{1},
+	})}
+
+	for i := 0; i < 2; i++ {
+		if err := serial.Send(msg); err != nil {
+			t.Fatal(err)
+		}
+
+		frame := iface.next(t)
+		if frame.Kind != ft12.KindVariable {
+			t.Fatalf("Unexpected frame: %+v", frame)
+		}
+
+		// The link layer request consumed the set frame count bit following the reset.
+		if (frame.Control&ft12.ControlFCB != 0) != (i%2 == 1) {
+			t.Errorf("Unexpected frame count bit in frame %d: %v", i, frame.Control)
+		}
+
+		if !bytes.Equal(frame.Data, packMessage(msg)) {
+			t.Errorf("Unexpected payload: % X", frame.Data)
+		}
+	}
+}
+
+func TestSerial_Inbound(t *testing.T) {
+	serial, iface := makeSerialConn(t, EMICommon)
+	defer serial.Close()
+
+	ind := &cemi.LDataInd{LData: buildGroupOutbound(GroupEvent{
+		Command:     GroupWrite,
+		Destination: cemi.NewGroupAddr3(1, 2, 3),
+		Data:        []byte{1},
+	})}
+	data := packMessage(ind)
+
+	iface.sendData(data, false)
+	iface.sendData(data, true)
+	iface.sendData(data, false)
+
+	for i := 0; i < 3; i++ {
+		if frame := iface.next(t); frame.Kind != ft12.KindAck {
+			t.Fatalf("Expected acknowledgement, got %+v", frame)
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		select {
+		case msg := <-serial.Inbound():
+			if _, ok := msg.(*cemi.LDataInd); !ok {
+				t.Fatalf("Unexpected message: %T", msg)
+			}
+
+		case <-time.After(time.Second):
+			t.Fatal("Timed out waiting for message")
+		}
+	}
+
+	// The repeated frame must not have been delivered.
+	select {
+	case msg := <-serial.Inbound():
+		t.Fatalf("Unexpected message: %v", msg)
+
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestSerial_EMI2(t *testing.T) {
+	serial, iface := makeSerialConn(t, EMI2)
+	defer serial.Close()
+
+	event := GroupEvent{
+		Command:     GroupWrite,
+		Source:      0x1101,
+		Destination: cemi.NewGroupAddr3(1, 2, 3),
+		Data:        []byte{1},
+	}
+
+	if err := serial.Send(&cemi.LDataReq{LData: buildGroupOutbound(event)}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []byte{0x11, 0xBC, 0x11, 0x01, 0x0A, 0x03, 0xE1, 0x00, 0x81}
+	if frame := iface.next(t); !bytes.Equal(frame.Data, expected) {
+		t.Errorf("Unexpected payload: % X", frame.Data)
+	}
+
+	if err := serial.Send(&cemi.LDataInd{}); err == nil {
+		t.Error("Sending an unsupported message should fail")
+	}
+
+	iface.sendData([]byte{0x29, 0xBC, 0x11, 0x01, 0x0A, 0x03, 0xE1, 0x00, 0x80}, false)
+
+	select {
+	case msg := <-serial.Inbound():
+		ind, ok := msg.(*cemi.LDataInd)
+		if !ok {
+			t.Fatalf("Unexpected message: %T", msg)
+		}
+
+		if ind.Source != 0x1101 || ind.Destination != uint16(cemi.NewGroupAddr3(1, 2, 3)) {
+			t.Errorf("Unexpected addresses: %v %v", ind.Source, ind.Destination)
+		}
+
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for message")
+	}
+}
+
+func TestSerial_Timeout(t *testing.T) {
+	iface, host := newDummyInterface(true)
+	defer iface.conn.Close()
+
+	_, err := NewSerial(host, SerialConfig{
+		ResendInterval:  time.Millisecond,
+		ResponseTimeout: 20 * time.Millisecond,
+	})
+	if err != errResponseTimeout {
+		t.Fatalf("Expected error %v, got %v", errResponseTimeout, err)
+	}
+}
+
+func TestGroupSerial(t *testing.T) {
+	iface, host := newDummyInterface(false)
+
+	client, err := NewGroupSerial(host, DefaultSerialConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var _ GroupClient = &client
+
+	iface.next(t)
+	iface.next(t)
+
+	iface.sendData([]byte{0x29, 0x00, 0xBC, 0xE0, 0x11, 0x01, 0x0A, 0x03, 0x01, 0x00, 0x81}, false)
+
+	select {
+	case event := <-client.Inbound():
+		if event.Command != GroupWrite || !bytes.Equal(event.Data, []byte{1}) {
+			t.Errorf("Unexpected event: %+v", event)
+		}
+
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for event")
+	}
+
+	client.Close()
+
+	if _, open := <-client.Inbound(); open {
+		t.Error("Inbound channel should be closed")
+	}
+}
@@ -0,0 +1,390 @@
+package knx
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/knx-go/knx-go/knx/cemi"
+	"github.com/knx-go/knx-go/knx/tpuart"
+	"github.com/knx-go/knx-go/knx/util"
+)
+
+// TPUARTConfig allows you to configure the TP-UART client's behavior.
+type TPUARTConfig struct {
+	// Address is the individual address of the host. It is used as source address for outgoing
+	// frames which do not specify one and determines which incoming frames are acknowledged.
+	Address cemi.PhysicalAddr
+
+	// AckGroupFrames specifies if frames targeting group addresses should be acknowledged.
+	AckGroupFrames bool
+
+	// Busmon activates the bus monitor mode. Incoming frames will be delivered as L_Busmon.ind
+	// messages, nothing will be acknowledged and sending is not possible.
+	Busmon bool
+
+	// ResponseTimeout specifies how long to wait for a response of the TP-UART.
+	ResponseTimeout time.Duration
+
+	// FrameTimeout specifies the maximum gap between two bytes of a frame. A partially received
+	// frame is discarded when it is exceeded.
+	FrameTimeout time.Duration
+
+	// InboundBufferSize is the number of incoming messages which are kept until they are received
+	// through the inbound channel.
+	InboundBufferSize uint
+
+	// InboundOverflow determines what happens to incoming messages when the inbound buffer is full.
+	InboundOverflow OverflowPolicy
+
+	// Logger receives the log records of the client. If it is nil, the records are forwarded to
+	// util.Logger.
+	Logger *slog.Logger
+}
+
+// DefaultTPUARTConfig is a good default configuration for a TPUART client.
+var DefaultTPUARTConfig = TPUARTConfig{
+	ResponseTimeout:   time.Second,
+	FrameTimeout:      50 * time.Millisecond,
+	InboundBufferSize: 64,
+	InboundOverflow:   OverflowDropOldest,
+}
+
+// checkTPUARTConfig makes sure that the configuration is actually usable.
+func checkTPUARTConfig(config TPUARTConfig) TPUARTConfig {
+	if config.ResponseTimeout <= 0 {
+		config.ResponseTimeout = DefaultTPUARTConfig.ResponseTimeout
+	}
+
+	if config.FrameTimeout <= 0 {
+		config.FrameTimeout = DefaultTPUARTConfig.FrameTimeout
+	}
+
+	if config.InboundBufferSize == 0 {
+		config.InboundBufferSize = DefaultTPUARTConfig.InboundBufferSize
+	}
+
+	if config.Logger == nil {
+		config.Logger = util.DefaultLogger()
+	}
+
+	return config
+}
+
+// A TPUART provides methods to communicate with KNX TP1 media through a TP-UART transceiver. It acts
+// as the data link layer, therefore it accepts L_Data.req messages and produces L_Data.ind
+// messages.
+type TPUART struct {
+	rwc    io.ReadWriteCloser
+	config TPUARTConfig
+	log    *slog.Logger
+
+	// For outgoing requests
+	writeMu sync.Mutex
+	sendMu  sync.Mutex
+	events  chan tpuart.Event
+
+	// Incoming frames
+	inbound *inboundBuffer
+
+	// Goroutine controller
+	done chan struct{}
+	once sync.Once
+	wait sync.WaitGroup
+}
+
+// write transmits the given bytes to the TP-UART.
+func (tp *TPUART) write(data ...byte) error {
+	tp.writeMu.Lock()
+	defer tp.writeMu.Unlock()
+
+	_, err := tp.rwc.Write(data)
+	return err
+}
+
+// request transmits the data and waits for an event of the given kind. The caller must hold sendMu.
+func (tp *TPUART) request(data []byte, kind tpuart.EventKind) (tpuart.Event, error) {
+	// Discard events that did not belong to any request.
+	for len(tp.events) > 0 {
+		<-tp.events
+	}
+
+	if err := tp.write(data...); err != nil {
+		return tpuart.Event{}, err
+	}
+
+	timeout := time.After(tp.config.ResponseTimeout)
+
+	for {
+		select {
+		// Termination has been requested.
+		case <-tp.done:
+			return tpuart.Event{}, errors.New("TP-UART connection has been closed")
+
+		// Timeout reached.
+		case <-timeout:
+			return tpuart.Event{}, errResponseTimeout
+
+		// Received an event.
+		case event := <-tp.events:
+			if event.Kind == kind {
+				return event, nil
+			}
+		}
+	}
+}
+
+// initialize resets the TP-UART and queries its state.
+func (tp *TPUART) initialize() error {
+	tp.sendMu.Lock()
+	defer tp.sendMu.Unlock()
+
+	if _, err := tp.request([]byte{tpuart.ResetReq}, tpuart.EventResetInd); err != nil {
+		return err
+	}
+
+	event, err := tp.request([]byte{tpuart.StateReq}, tpuart.EventStateInd)
+	if err != nil {
+		return err
+	}
+
+	if event.State.HasError() {
+		tp.log.Warn("TP-UART reports an error state", "state", event.State)
+	}
+
+	if tp.config.Busmon {
+		// There is no response to this request.
+		return tp.write(tpuart.ActivateBusmonReq)
+	}
+
+	return nil
+}
+
+// pushEvent relays the event to a waiting request. It is dropped if nobody is waiting.
+func (tp *TPUART) pushEvent(event tpuart.Event) {
+	select {
+	case tp.events <- event:
+	default:
+	}
+}
+
+// handleFrameHeader acknowledges the frame that is being received if it is addressed to us.
+func (tp *TPUART) handleFrameHeader(event tpuart.Event) {
+	if tp.config.Busmon {
+		return
+	}
+
+	var addressed bool
+	if event.IsGroupAddr() {
+		addressed = tp.config.AckGroupFrames
+	} else {
+		addressed = event.Destination() == uint16(tp.config.Address)
+	}
+
+	if addressed {
+		if err := tp.write(tpuart.AckAddressed.Service()); err != nil {
+			tp.log.Warn("Error while acknowledging", "error", err)
+		}
+	}
+}
+
+// handleFrame converts the frame to a message and pushes it to the client.
+func (tp *TPUART) handleFrame(frame []byte) {
+	if tp.config.Busmon {
+		// The frame is preceded by an empty additional info segment.
+		ind := cemi.LBusmonInd(append([]byte{0}, frame...))
+		tp.inbound.push(&ind)
+
+		return
+	}
+
+	ind := &cemi.LDataInd{}
+	if _, err := ind.UnpackTP(frame[:len(frame)-1]); err != nil {
+		tp.log.Warn("Error while unpacking frame", "error", err)
+		return
+	}
+
+	tp.inbound.push(ind)
+}
+
+// serve reads incoming data until the underlying stream fails or is closed.
+func (tp *TPUART) serve() {
+	tp.log.Debug("Started worker")
+	defer tp.log.Debug("Worker exited")
+
+	defer tp.inbound.close()
+	defer tp.wait.Done()
+
+	var decoder tpuart.Decoder
+	var lastRead time.Time
+
+	buffer := make([]byte, tpuart.MaxFrameLength)
+
+	for {
+		n, err := tp.rwc.Read(buffer)
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, os.ErrClosed) {
+				tp.log.Debug("Error while reading", "error", err)
+			} else {
+				tp.log.Error("Error while reading", "error", err)
+			}
+
+			return
+		}
+
+		// Bytes which arrive too late cannot belong to the frame that is currently received.
+		now := time.Now()
+		if decoder.InFrame() && now.Sub(lastRead) > tp.config.FrameTimeout {
+			tp.log.Debug("Discarded incomplete frame")
+			decoder.Reset()
+		}
+
+		lastRead = now
+
+		for _, b := range buffer[:n] {
+			event, ok := decoder.Feed(b)
+			if !ok {
+				continue
+			}
+
+			switch event.Kind {
+			case tpuart.EventFrameHeader:
+				tp.handleFrameHeader(event)
+
+			case tpuart.EventFrame:
+				tp.handleFrame(event.Frame)
+
+			case tpuart.EventCorruptFrame:
+				tp.log.Warn("Discarded frame with invalid checksum")
+
+			case tpuart.EventUnknown:
+				tp.log.Debug("Discarded unknown service", "service", fmt.Sprintf("%#02x", event.Frame[0]))
+
+			default:
+				tp.pushEvent(event)
+			}
+		}
+	}
+}
+
+// NewTPUART initializes the TP-UART which is reachable through the given stream, e.g. a serial
+// port. You can pass a zero initialized TPUARTConfig; the function will take care of filling in the
+// default values. The stream is closed when the TPUART is closed.
+func NewTPUART(rwc io.ReadWriteCloser, config TPUARTConfig) (*TPUART, error) {
+	config = checkTPUARTConfig(config)
+
+	tp := &TPUART{
+		rwc:    rwc,
+		config: config,
+		log:    config.Logger.With("component", "tpuart", "address", config.Address),
+		events: make(chan tpuart.Event, 1),
+		done:   make(chan struct{}),
+	}
+
+	tp.inbound = newInboundBuffer(tp.config.InboundBufferSize, tp.config.InboundOverflow)
+
+	tp.wait.Add(1)
+	go tp.serve()
+
+	if err := tp.initialize(); err != nil {
+		tp.Close()
+		return nil, err
+	}
+
+	return tp, nil
+}
+
+// Close terminates the connection and closes the underlying stream.
+func (tp *TPUART) Close() {
+	tp.once.Do(func() {
+		close(tp.done)
+		tp.inbound.abort()
+
+		tp.rwc.Close()
+		tp.wait.Wait()
+	})
+}
+
+// Inbound retrieves the channel which transmits incoming data. The channel is closed when the
+// underlying stream fails or when the connection is closed.
+func (tp *TPUART) Inbound() <-chan cemi.Message {
+	return tp.inbound.channel()
+}
+
+// InboundDropped returns the number of incoming messages which have been discarded, because the
+// inbound buffer was full.
+func (tp *TPUART) InboundDropped() uint64 {
+	return tp.inbound.droppedCount()
+}
+
+// Send transmits a L_Data.req message on the bus and waits for its confirmation. An error is
+// returned if the TP-UART could not transmit the frame.
+func (tp *TPUART) Send(data cemi.Message) error {
+	req, ok := data.(*cemi.LDataReq)
+	if !ok {
+		return errors.New("only L_Data.req messages can be sent through a TP-UART")
+	}
+
+	if tp.config.Busmon {
+		return errors.New("cannot send in bus monitor mode")
+	}
+
+	ldata := req.LData
+	if ldata.Source == 0 {
+		ldata.Source = tp.config.Address
+	}
+
+	frame := make([]byte, ldata.TPSize())
+	ldata.PackTP(frame)
+
+	encoded, err := tpuart.EncodeFrame(frame)
+	if err != nil {
+		return err
+	}
+
+	tp.sendMu.Lock()
+	defer tp.sendMu.Unlock()
+
+	event, err := tp.request(encoded, tpuart.EventDataCon)
+	if err != nil {
+		return err
+	}
+
+	if !event.Positive {
+		return fmt.Errorf("transmission of frame to %#04x has not been confirmed", ldata.Destination)
+	}
+
+	return nil
+}
+
+// GroupTPUART is a TPUART that provides only a group communication interface.
+type GroupTPUART struct {
+	*TPUART
+	inbound chan GroupEvent
+}
+
+// NewGroupTPUART creates a new TPUART for group communication.
+func NewGroupTPUART(rwc io.ReadWriteCloser, config TPUARTConfig) (gt GroupTPUART, err error) {
+	gt.TPUART, err = NewTPUART(rwc, config)
+
+	if err == nil {
+		gt.inbound = make(chan GroupEvent)
+		go serveGroupInbound(gt.TPUART.Inbound(), gt.inbound, gt.TPUART.log)
+	}
+
+	return
+}
+
+// Send a group communication.
+func (gt *GroupTPUART) Send(event GroupEvent) error {
+	return gt.TPUART.Send(&cemi.LDataReq{LData: buildGroupOutbound(event)})
+}
+
+// Inbound returns the channel on which group communication can be received.
+func (gt *GroupTPUART) Inbound() <-chan GroupEvent {
+	return gt.inbound
+}
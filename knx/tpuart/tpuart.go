@@ -0,0 +1,270 @@
+// Package tpuart provides the means to communicate with a TP-UART transceiver, which connects a
+// host to KNX TP1 media through a UART.
+package tpuart
+
+import "fmt"
+
+// These are services that the host sends to the TP-UART.
+const (
+	ResetReq          byte = 0x01
+	StateReq          byte = 0x02
+	ActivateBusmonReq byte = 0x05
+	ProductIDReq      byte = 0x20
+
+	// dataStartReq is U_L_DataStart, which transmits the first byte of a frame.
+	dataStartReq byte = 0x80
+
+	// dataContinueReq is U_L_DataContinue, which transmits the byte at the index encoded in the
+	// lower 6 bits.
+	dataContinueReq byte = 0x80
+
+	// dataEndReq is U_L_DataEnd, which transmits the checksum at the index encoded in the lower 6
+	// bits.
+	dataEndReq byte = 0x40
+
+	// ackInfoReq is U_AckInformation, the lower 3 bits contain the flags.
+	ackInfoReq byte = 0x10
+)
+
+// These are services that the TP-UART sends to the host.
+const (
+	ResetInd byte = 0x03
+
+	// stateIndMask identifies U_State.ind, the upper 5 bits contain the state.
+	stateIndMask byte = 0x07
+
+	// dataConMask identifies L_Data.con, the highest bit indicates success.
+	dataConMask byte = 0x7F
+	dataCon     byte = 0x0B
+)
+
+// MaxFrameLength is the maximum length of a frame including its checksum that can be transmitted
+// through the TP-UART.
+const MaxFrameLength = 64
+
+// AckInfo contains the flags of an U_AckInformation service.
+type AckInfo uint8
+
+const (
+	// AckAddressed acknowledges the frame that is currently being received.
+	AckAddressed AckInfo = 1 << 0
+
+	// AckBusy rejects the frame that is currently being received, because the host is busy.
+	AckBusy AckInfo = 1 << 1
+
+	// AckNack rejects the frame that is currently being received.
+	AckNack AckInfo = 1 << 2
+)
+
+// Service generates the U_AckInformation service byte.
+func (ack AckInfo) Service() byte {
+	return ackInfoReq | byte(ack)&7
+}
+
+// State is the state reported through U_State.ind.
+type State uint8
+
+const (
+	// StateTemperatureWarning indicates that the TP-UART is overheating.
+	StateTemperatureWarning State = 1 << 3
+
+	// StateProtocolError indicates that an illegal control sequence has been received.
+	StateProtocolError State = 1 << 4
+
+	// StateTransmitterError indicates that sending a frame failed.
+	StateTransmitterError State = 1 << 5
+
+	// StateReceiveError indicates a checksum, parity or bit timing error on the UART.
+	StateReceiveError State = 1 << 6
+
+	// StateSlaveCollision indicates a collision on the bus when sending the poll data.
+	StateSlaveCollision State = 1 << 7
+)
+
+// HasError determines if the state indicates an error.
+func (state State) HasError() bool {
+	return state&(StateProtocolError|StateTransmitterError|StateReceiveError|StateSlaveCollision) != 0
+}
+
+// String generates a string representation of the state.
+func (state State) String() string {
+	return fmt.Sprintf("%#02x", uint8(state))
+}
+
+// Checksum computes the checksum of a TP1 frame, which is the inverted exclusive-or over all bytes.
+func Checksum(frame []byte) byte {
+	var sum byte = 0xFF
+
+	for _, b := range frame {
+		sum ^= b
+	}
+
+	return sum
+}
+
+// EncodeFrame generates the sequence of U_L_DataStart, U_L_DataContinue and U_L_DataEnd services
+// which transmits the given frame. The frame must not contain the checksum, it is appended
+// automatically.
+func EncodeFrame(frame []byte) ([]byte, error) {
+	if len(frame)+1 > MaxFrameLength {
+		return nil, fmt.Errorf("frame length %d exceeds the maximum of %d", len(frame)+1, MaxFrameLength)
+	}
+
+	if len(frame) < 1 {
+		return nil, fmt.Errorf("frame is empty")
+	}
+
+	buffer := make([]byte, 0, 2*len(frame)+2)
+	buffer = append(buffer, dataStartReq, frame[0])
+
+	for i := 1; i < len(frame); i++ {
+		buffer = append(buffer, dataContinueReq|byte(i), frame[i])
+	}
+
+	return append(buffer, dataEndReq|byte(len(frame)), Checksum(frame)), nil
+}
+
+// EventKind identifies the kind of an Event.
+type EventKind uint8
+
+const (
+	// EventResetInd is an U_Reset.ind.
+	EventResetInd EventKind = iota
+
+	// EventStateInd is an U_State.ind.
+	EventStateInd
+
+	// EventDataCon is a L_Data.con.
+	EventDataCon
+
+	// EventFrameHeader indicates that the addressing information of a frame has been received.
+	// This is the moment to send an U_AckInformation service.
+	EventFrameHeader
+
+	// EventFrame is a completely received frame with a valid checksum.
+	EventFrame
+
+	// EventCorruptFrame is a completely received frame with an invalid checksum.
+	EventCorruptFrame
+
+	// EventUnknown is a byte which could not be identified.
+	EventUnknown
+)
+
+// An Event is something that the TP-UART reported to the host.
+type Event struct {
+	Kind EventKind
+
+	// State is set for EventStateInd.
+	State State
+
+	// Positive is set for EventDataCon.
+	Positive bool
+
+	// Frame contains the frame including its checksum for EventFrame and EventCorruptFrame, the
+	// received part for EventFrameHeader, and the raw byte for EventUnknown.
+	Frame []byte
+}
+
+// IsGroupAddr determines if the frame of a EventFrameHeader targets a group address.
+func (event *Event) IsGroupAddr() bool {
+	if isStdFrame(event.Frame[0]) {
+		return event.Frame[5]&0x80 != 0
+	}
+
+	return event.Frame[1]&0x80 != 0
+}
+
+// Destination retrieves the destination address of the frame of a EventFrameHeader.
+func (event *Event) Destination() uint16 {
+	if isStdFrame(event.Frame[0]) {
+		return uint16(event.Frame[3])<<8 | uint16(event.Frame[4])
+	}
+
+	return uint16(event.Frame[4])<<8 | uint16(event.Frame[5])
+}
+
+// isStdFrame determines if the control field belongs to a standard frame.
+func isStdFrame(control byte) bool {
+	return control&0xD3 == 0x90
+}
+
+// isExtFrame determines if the control field belongs to an extended frame.
+func isExtFrame(control byte) bool {
+	return control&0xD3 == 0x10
+}
+
+// A Decoder assembles the bytes received from the TP-UART into events.
+type Decoder struct {
+	frame    []byte
+	expected int
+}
+
+// InFrame determines if the decoder is in the middle of a frame.
+func (dec *Decoder) InFrame() bool {
+	return len(dec.frame) > 0
+}
+
+// Reset discards a partially received frame.
+func (dec *Decoder) Reset() {
+	dec.frame = nil
+	dec.expected = 0
+}
+
+// headerLength determines how many bytes are needed to know the destination of the frame.
+func (dec *Decoder) headerLength() int {
+	if isStdFrame(dec.frame[0]) {
+		return 6
+	}
+
+	return 7
+}
+
+// Feed processes the next byte. It returns true if an event has been completed.
+func (dec *Decoder) Feed(b byte) (Event, bool) {
+	if !dec.InFrame() {
+		switch {
+		case b == ResetInd:
+			return Event{Kind: EventResetInd}, true
+
+		case b&stateIndMask == stateIndMask:
+			return Event{Kind: EventStateInd, State: State(b &^ stateIndMask)}, true
+
+		case b&dataConMask == dataCon:
+			return Event{Kind: EventDataCon, Positive: b&0x80 != 0}, true
+
+		case isStdFrame(b), isExtFrame(b):
+			dec.frame = append(make([]byte, 0, MaxFrameLength), b)
+			return Event{}, false
+
+		default:
+			return Event{Kind: EventUnknown, Frame: []byte{b}}, true
+		}
+	}
+
+	dec.frame = append(dec.frame, b)
+
+	if len(dec.frame) == dec.headerLength() {
+		// The header contains the length of the frame.
+		if isStdFrame(dec.frame[0]) {
+			dec.expected = 6 + 1 + int(dec.frame[5]&0x0F) + 1
+		} else {
+			dec.expected = 7 + 1 + int(dec.frame[6]) + 1
+		}
+
+		return Event{Kind: EventFrameHeader, Frame: dec.frame}, true
+	}
+
+	if len(dec.frame) < dec.expected || dec.expected == 0 {
+		return Event{}, false
+	}
+
+	frame := dec.frame
+	dec.Reset()
+
+	if Checksum(frame[:len(frame)-1]) != frame[len(frame)-1] {
+		return Event{Kind: EventCorruptFrame, Frame: frame}, true
+	}
+
+	return Event{Kind: EventFrame, Frame: frame}, true
+}
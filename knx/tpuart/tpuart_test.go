@@ -0,0 +1,157 @@
+package tpuart
+
+import (
+	"bytes"
+	"testing"
+)
+
+var groupWriteFrame = []byte{0xBC, 0x11, 0x01, 0x0A, 0x03, 0xE1, 0x00, 0x81}
+
+func TestChecksum(t *testing.T) {
+	if sum := Checksum(groupWriteFrame); sum != 0x3A {
+		t.Errorf("Unexpected checksum: %#02x", sum)
+	}
+}
+
+func TestEncodeFrame(t *testing.T) {
+	encoded, err := EncodeFrame(groupWriteFrame)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []byte{
+		0x80, 0xBC, 0x81, 0x11, 0x82, 0x01, 0x83, 0x0A,
+		0x84, 0x03, 0x85, 0xE1, 0x86, 0x00, 0x87, 0x81,
+		0x48, 0x3A,
+	}
+
+	if !bytes.Equal(encoded, expected) {
+		t.Errorf("Unexpected encoding: % X", encoded)
+	}
+
+	if _, err := EncodeFrame(make([]byte, MaxFrameLength)); err == nil {
+		t.Error("Encoding an oversized frame should fail")
+	}
+
+	if _, err := EncodeFrame(nil); err == nil {
+		t.Error("Encoding an empty frame should fail")
+	}
+}
+
+func TestAckInfo_Service(t *testing.T) {
+	if s := AckAddressed.Service(); s != 0x11 {
+		t.Errorf("Unexpected service: %#02x", s)
+	}
+
+	if s := (AckNack | AckBusy).Service(); s != 0x16 {
+		t.Errorf("Unexpected service: %#02x", s)
+	}
+}
+
+func feedAll(dec *Decoder, data []byte) (events []Event) {
+	for _, b := range data {
+		if event, ok := dec.Feed(b); ok {
+			events = append(events, event)
+		}
+	}
+
+	return
+}
+
+func TestDecoder_Services(t *testing.T) {
+	var dec Decoder
+
+	events := feedAll(&dec, []byte{0x03, 0x07, 0x27, 0x8B, 0x0B, 0xCC})
+
+	if len(events) != 6 {
+		t.Fatalf("Unexpected events: %+v", events)
+	}
+
+	if events[0].Kind != EventResetInd {
+		t.Errorf("Expected reset indication, got %+v", events[0])
+	}
+
+	if events[1].Kind != EventStateInd || events[1].State.HasError() {
+		t.Errorf("Expected healthy state, got %+v", events[1])
+	}
+
+	if events[2].Kind != EventStateInd || events[2].State != StateTransmitterError {
+		t.Errorf("Expected transmitter error, got %+v", events[2])
+	}
+
+	if events[3].Kind != EventDataCon || !events[3].Positive {
+		t.Errorf("Expected positive confirmation, got %+v", events[3])
+	}
+
+	if events[4].Kind != EventDataCon || events[4].Positive {
+		t.Errorf("Expected negative confirmation, got %+v", events[4])
+	}
+
+	if events[5].Kind != EventUnknown {
+		t.Errorf("Expected unknown service, got %+v", events[5])
+	}
+}
+
+func TestDecoder_Frame(t *testing.T) {
+	var dec Decoder
+
+	frame := append(append([]byte(nil), groupWriteFrame...), Checksum(groupWriteFrame))
+	events := feedAll(&dec, frame)
+
+	if len(events) != 2 {
+		t.Fatalf("Unexpected events: %+v", events)
+	}
+
+	header := events[0]
+	if header.Kind != EventFrameHeader || !header.IsGroupAddr() || header.Destination() != 0x0A03 {
+		t.Errorf("Unexpected header event: %+v", header)
+	}
+
+	if events[1].Kind != EventFrame || !bytes.Equal(events[1].Frame, frame) {
+		t.Errorf("Unexpected frame event: %+v", events[1])
+	}
+
+	if dec.InFrame() {
+		t.Error("Decoder should have completed the frame")
+	}
+
+	frame[len(frame)-1] ^= 0xFF
+	events = feedAll(&dec, frame)
+
+	if len(events) != 2 || events[1].Kind != EventCorruptFrame {
+		t.Errorf("Expected corrupt frame, got %+v", events)
+	}
+}
+
+func TestDecoder_ExtendedFrame(t *testing.T) {
+	var dec Decoder
+
+	frame := []byte{0x3C, 0x60, 0x11, 0x01, 0x11, 0x02, 0x02, 0x00, 0x80, 0x01}
+	frame = append(frame, Checksum(frame))
+
+	events := feedAll(&dec, frame)
+
+	if len(events) != 2 || events[0].IsGroupAddr() || events[0].Destination() != 0x1102 {
+		t.Fatalf("Unexpected events: %+v", events)
+	}
+
+	if events[1].Kind != EventFrame || !bytes.Equal(events[1].Frame, frame) {
+		t.Errorf("Unexpected frame event: %+v", events[1])
+	}
+}
+
+func TestDecoder_Reset(t *testing.T) {
+	var dec Decoder
+
+	feedAll(&dec, groupWriteFrame[:4])
+
+	if !dec.InFrame() {
+		t.Fatal("Decoder should be in the middle of a frame")
+	}
+
+	dec.Reset()
+
+	if events := feedAll(&dec, []byte{0x03}); len(events) != 1 || events[0].Kind != EventResetInd {
+		t.Errorf("Unexpected events after reset: %+v", events)
+	}
+}
@@ -0,0 +1,300 @@
+package knx
+
+import (
+	"bytes"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/knx-go/knx-go/knx/cemi"
+	"github.com/knx-go/knx-go/knx/tpuart"
+)
+
+// dummyUART is a scripted TP-UART. It answers control services, confirms transmitted frames and
+// records everything the host sends.
+type dummyUART struct {
+	conn     net.Conn
+	out      chan []byte
+	frames   chan []byte
+	services chan byte
+	confirm  bool
+}
+
+func newDummyUART(confirm bool) (*dummyUART, net.Conn) {
+	host, uart := net.Pipe()
+
+	dummy := &dummyUART{
+		conn:     uart,
+		out:      make(chan []byte, 100),
+		frames:   make(chan []byte, 100),
+		services: make(chan byte, 100),
+		confirm:  confirm,
+	}
+
+	go dummy.serveOut()
+	go dummy.serveIn()
+
+	return dummy, host
+}
+
+// serveOut writes to the host from a separate goroutine, so the host can write at the same time.
+func (uart *dummyUART) serveOut() {
+	for data := range uart.out {
+		if _, err := uart.conn.Write(data); err != nil {
+			return
+		}
+	}
+}
+
+func (uart *dummyUART) serveIn() {
+	defer close(uart.out)
+
+	var frame []byte
+	buffer := make([]byte, 1)
+
+	readByte := func() (byte, bool) {
+		_, err := uart.conn.Read(buffer)
+		return buffer[0], err == nil
+	}
+
+	for {
+		service, ok := readByte()
+		if !ok {
+			return
+		}
+
+		switch {
+		case service&0x80 != 0:
+			b, ok := readByte()
+			if !ok {
+				return
+			}
+
+			frame = append(frame, b)
+
+		case service&0xC0 == 0x40:
+			if _, ok := readByte(); !ok {
+				return
+			}
+
+			uart.frames <- frame
+			frame = nil
+
+			if uart.confirm {
+				uart.out <- []byte{0x8B}
+			} else {
+				uart.out <- []byte{0x0B}
+			}
+
+		case service == tpuart.ResetReq:
+			uart.out <- []byte{tpuart.ResetInd}
+
+		case service == tpuart.StateReq:
+			uart.out <- []byte{0x07}
+
+		default:
+			uart.services <- service
+		}
+	}
+}
+
+func makeTPUART(t *testing.T, config TPUARTConfig, confirm bool) (*TPUART, *dummyUART) {
+	t.Helper()
+
+	uart, host := newDummyUART(confirm)
+
+	tp, err := NewTPUART(host, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return tp, uart
+}
+
+func TestTPUART_Send(t *testing.T) {
+	tp, uart := makeTPUART(t, TPUARTConfig{Address: 0x1101}, true)
+	defer tp.Close()
+
+	req := &cemi.LDataReq{LData: buildGroupOutbound(GroupEvent{
+		Command:     GroupWrite,
+		Destination: cemi.NewGroupAddr3(1, 2, 3),
+		Data:        []byte{1},
+	})}
+
+	if err := tp.Send(req); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case frame := <-uart.frames:
+		expected := []byte{0xBC, 0x11, 0x01, 0x0A, 0x03, 0xE1, 0x00, 0x81}
+		if !bytes.Equal(frame, expected) {
+			t.Errorf("Unexpected frame: % X", frame)
+		}
+
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for frame")
+	}
+
+	if err := tp.Send(&cemi.LDataInd{}); err == nil {
+		t.Error("Sending an unsupported message should fail")
+	}
+}
+
+func TestTPUART_SendNotConfirmed(t *testing.T) {
+	tp, _ := makeTPUART(t, TPUARTConfig{}, false)
+	defer tp.Close()
+
+	req := &cemi.LDataReq{LData: buildGroupOutbound(GroupEvent{
+		Command:     GroupRead,
+		Destination: cemi.NewGroupAddr3(1, 2, 3),
+	})}
+
+	if err := tp.Send(req); err == nil {
+		t.Fatal("Should not succeed")
+	}
+}
+
+func TestTPUART_Inbound(t *testing.T) {
+	tp, uart := makeTPUART(t, TPUARTConfig{
+		Address:      0x1101,
+		FrameTimeout: 20 * time.Millisecond,
+	}, true)
+	defer tp.Close()
+
+	individual := []byte{0xB0, 0x11, 0x02, 0x11, 0x01, 0x60, 0x80}
+	individual = append(individual, tpuart.Checksum(individual))
+
+	group := []byte{0xBC, 0x11, 0x02, 0x0A, 0x03, 0xE1, 0x00, 0x81}
+	group = append(group, tpuart.Checksum(group))
+
+	// This frame is never completed and must be discarded once the frame timeout elapsed.
+	uart.out <- group[:4]
+	time.Sleep(50 * time.Millisecond)
+
+	uart.out <- individual
+	uart.out <- group
+
+	select {
+	case service := <-uart.services:
+		if service != tpuart.AckAddressed.Service() {
+			t.Errorf("Unexpected service: %#02x", service)
+		}
+
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for acknowledgement")
+	}
+
+	for _, dest := range []uint16{0x1101, 0x0A03} {
+		select {
+		case msg := <-tp.Inbound():
+			ind, ok := msg.(*cemi.LDataInd)
+			if !ok {
+				t.Fatalf("Unexpected message: %T", msg)
+			}
+
+			if ind.Source != 0x1102 || ind.Destination != dest {
+				t.Errorf("Unexpected addresses: %v %#04x", ind.Source, ind.Destination)
+			}
+
+		case <-time.After(time.Second):
+			t.Fatal("Timed out waiting for message")
+		}
+	}
+
+	// Group frames are not acknowledged by default.
+	select {
+	case service := <-uart.services:
+		t.Errorf("Unexpected service: %#02x", service)
+
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestTPUART_Busmon(t *testing.T) {
+	tp, uart := makeTPUART(t, TPUARTConfig{Busmon: true}, true)
+	defer tp.Close()
+
+	select {
+	case service := <-uart.services:
+		if service != tpuart.ActivateBusmonReq {
+			t.Errorf("Unexpected service: %#02x", service)
+		}
+
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for bus monitor activation")
+	}
+
+	frame := []byte{0xBC, 0x11, 0x02, 0x0A, 0x03, 0xE1, 0x00, 0x81}
+	frame = append(frame, tpuart.Checksum(frame))
+	uart.out <- frame
+
+	select {
+	case msg := <-tp.Inbound():
+		ind, ok := msg.(*cemi.LBusmonInd)
+		if !ok || !bytes.Equal([]byte(*ind), append([]byte{0}, frame...)) {
+			t.Errorf("Unexpected message: %v", msg)
+		}
+
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for message")
+	}
+
+	if err := tp.Send(&cemi.LDataReq{}); err == nil {
+		t.Error("Sending in bus monitor mode should fail")
+	}
+}
+
+func TestTPUART_Timeout(t *testing.T) {
+	host, uart := net.Pipe()
+	defer uart.Close()
+
+	// Swallow everything without answering.
+	go func() {
+		buffer := make([]byte, 16)
+		for {
+			if _, err := uart.Read(buffer); err != nil {
+				return
+			}
+		}
+	}()
+
+	_, err := NewTPUART(host, TPUARTConfig{ResponseTimeout: 20 * time.Millisecond})
+	if err != errResponseTimeout {
+		t.Fatalf("Expected error %v, got %v", errResponseTimeout, err)
+	}
+}
+
+func TestGroupTPUART(t *testing.T) {
+	uart, host := newDummyUART(true)
+
+	client, err := NewGroupTPUART(host, TPUARTConfig{AckGroupFrames: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var _ GroupClient = &client
+
+	frame := []byte{0xBC, 0x11, 0x02, 0x0A, 0x03, 0xE1, 0x00, 0x81}
+	uart.out <- append(frame, tpuart.Checksum(frame))
+
+	select {
+	case event := <-client.Inbound():
+		if event.Command != GroupWrite || event.Destination != cemi.NewGroupAddr3(1, 2, 3) {
+			t.Errorf("Unexpected event: %+v", event)
+		}
+
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for event")
+	}
+
+	if service := <-uart.services; service != tpuart.AckAddressed.Service() {
+		t.Errorf("Unexpected service: %#02x", service)
+	}
+
+	client.Close()
+
+	if _, open := <-client.Inbound(); open {
+		t.Error("Inbound channel should be closed")
+	}
+}
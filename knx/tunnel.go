@@ -6,6 +6,7 @@ package knx
 import (
 	"errors"
 	"fmt"
+	"log/slog"
 	"sync"
 	"time"
 
@@ -30,6 +31,17 @@ type TunnelConfig struct {
 
 	// UseTCP configures whether to connect to the gateway using TCP.
 	UseTCP bool
+
+	// InboundBufferSize is the number of incoming messages which are kept until they are received
+	// through the inbound channel.
+	InboundBufferSize uint
+
+	// InboundOverflow determines what happens to incoming messages when the inbound buffer is full.
+	InboundOverflow OverflowPolicy
+
+	// Logger receives the log records of the tunnel and its socket. If it is nil, the records are
+	// forwarded to util.Logger.
+	Logger *slog.Logger
 }
 
 // DefaultTunnelConfig is a good default configuration for a Tunnel client.
@@ -39,6 +51,8 @@ var DefaultTunnelConfig = TunnelConfig{
 	ResponseTimeout:   10 * time.Second,
 	SendLocalAddress:  false,
 	UseTCP:            false,
+	InboundBufferSize: 64,
+	InboundOverflow:   OverflowDropOldest,
 }
 
 // checkTunnelConfig makes sure that the configuration is actually usable.
@@ -55,6 +69,14 @@ func checkTunnelConfig(config TunnelConfig) TunnelConfig {
 		config.ResponseTimeout = DefaultTunnelConfig.ResponseTimeout
 	}
 
+	if config.InboundBufferSize == 0 {
+		config.InboundBufferSize = DefaultTunnelConfig.InboundBufferSize
+	}
+
+	if config.Logger == nil {
+		config.Logger = util.DefaultLogger()
+	}
+
 	return config
 }
 
@@ -67,6 +89,7 @@ type Tunnel struct {
 	// Communication methods
 	sock   knxnet.Socket
 	config TunnelConfig
+	log    *slog.Logger
 
 	// Connection information
 	layer   knxnet.TunnelLayer
@@ -79,7 +102,7 @@ type Tunnel struct {
 	ack       chan *knxnet.TunnelRes
 
 	// Incoming requests
-	inbound chan cemi.Message
+	inbound *inboundBuffer
 
 	// Goroutine controller
 	done chan struct{}
@@ -87,6 +110,16 @@ type Tunnel struct {
 	wait sync.WaitGroup
 }
 
+// logger returns the logger which adds the attributes of the current connection.
+func (conn *Tunnel) logger() *slog.Logger {
+	log := conn.log
+	if log == nil {
+		log = util.DefaultLogger()
+	}
+
+	return log.With("channel", conn.channel)
+}
+
 func (conn *Tunnel) hostInfo() (knxnet.HostInfo, error) {
 	addr := conn.sock.LocalAddr()
 	if conn.config.SendLocalAddress && !conn.config.UseTCP {
@@ -169,6 +202,7 @@ func (conn *Tunnel) requestConn() (err error) {
 
 				// The gateway is busy, but we don't stop yet.
 				case knxnet.ErrNoMoreConnections, knxnet.ErrNoMoreUniqueConnections:
+					conn.logger().Info("Gateway has no free connections", "status", res.Status)
 					continue
 
 				// Connection request has been denied.
@@ -279,6 +313,8 @@ func (conn *Tunnel) requestTunnel(data cemi.Message) error {
 
 		// Resend timer fired.
 		case <-ticker.C:
+			conn.logger().Debug("Resending tunnel request", "seq", seqNumber)
+
 			err := conn.sock.Send(req)
 			if err != nil {
 				return err
@@ -317,9 +353,9 @@ func (conn *Tunnel) performHeartbeat(
 	state, err := conn.requestConnState(heartbeat)
 	if err != nil || state != knxnet.NoError {
 		if err != nil {
-			util.Log(conn, "Error while requesting connection state: %v", err)
+			conn.logger().Warn("Error while requesting connection state", "error", err)
 		} else {
-			util.Log(conn, "Bad connection state: %v", state)
+			conn.logger().Warn("Bad connection state", "status", state)
 		}
 
 		// Write to timeout as an indication that the heartbeat has failed.
@@ -353,23 +389,6 @@ func (conn *Tunnel) handleDiscRes(res *knxnet.DiscRes) error {
 	return nil
 }
 
-// pushInbound sends the message through the inbound channel. If the sending blocks, it will launch
-// a goroutine which will do the sending.
-func (conn *Tunnel) pushInbound(msg cemi.Message) {
-	select {
-	case conn.inbound <- msg:
-
-	default:
-		go func() {
-			// Since this goroutine decouples from the server goroutine, it might try to send when
-			// the server closed the inbound channel. Sending to a closed channel will panic. But we
-			// don't care, because cool guys don't look at explosions.
-			defer func() { recover() }()
-			conn.inbound <- msg
-		}()
-	}
-}
-
 // handleTunnelReq validates the request, pushes the data to the client and acknowledges the
 // request for the gateway.
 func (conn *Tunnel) handleTunnelReq(req *knxnet.TunnelReq, seqNumber *uint8) error {
@@ -382,7 +401,7 @@ func (conn *Tunnel) handleTunnelReq(req *knxnet.TunnelReq, seqNumber *uint8) err
 	// tunnelling request.
 	if conn.config.UseTCP {
 		// Send tunnel data to the client without blocking this goroutine to long.
-		conn.pushInbound(req.Payload)
+		conn.inbound.push(req.Payload)
 
 		return nil
 	}
@@ -394,7 +413,7 @@ func (conn *Tunnel) handleTunnelReq(req *knxnet.TunnelReq, seqNumber *uint8) err
 		*seqNumber++
 
 		// Send tunnel data to the client without blocking this goroutine to long.
-		conn.pushInbound(req.Payload)
+		conn.inbound.push(req.Payload)
 	} else if req.SeqNumber != expected-1 {
 		// The sequence number is out of the range which we would have to acknowledge.
 		return errors.New("out of sequence tunnel acknowledgement")
@@ -505,7 +524,8 @@ func (conn *Tunnel) process() error {
 					return errDisconnected
 				}
 
-				util.Log(conn, "Error while handling disconnect request %v: %v", msg, err)
+				conn.logger().Warn("Error while handling disconnect request",
+					"service", msg.Service(), "received_channel", msg.Channel, "error", err)
 
 			case *knxnet.DiscRes:
 				err := conn.handleDiscRes(msg)
@@ -513,27 +533,28 @@ func (conn *Tunnel) process() error {
 					return nil
 				}
 
-				util.Log(conn, "Error while handling disconnect response %v: %v", msg, err)
+				conn.logger().Warn("Error while handling disconnect response",
+					"service", msg.Service(), "received_channel", msg.Channel, "error", err)
 
 			case *knxnet.TunnelReq:
 				err := conn.handleTunnelReq(msg, &seqNumber)
 				if err != nil {
-					util.Log(conn, "Error while handling tunnel request %v: %v", msg, err)
+					conn.logger().Warn("Error while handling tunnel request",
+						"service", msg.Service(), "received_channel", msg.Channel, "seq", msg.SeqNumber, "error", err)
 				}
 
 			case *knxnet.TunnelRes:
 				err := conn.handleTunnelRes(msg)
 				if err != nil {
-					util.Log(conn, "Error while handling tunnel response %v: %v", msg, err)
+					conn.logger().Warn("Error while handling tunnel response",
+						"service", msg.Service(), "received_channel", msg.Channel, "seq", msg.SeqNumber, "error", err)
 				}
 
 			case *knxnet.ConnStateRes:
 				err := conn.handleConnStateRes(msg, heartbeat)
 				if err != nil {
-					util.Log(
-						conn,
-						"Error while handling connection state response: %v", err,
-					)
+					conn.logger().Warn("Error while handling connection state response",
+						"service", msg.Service(), "received_channel", msg.Channel, "error", err)
 				}
 			}
 		}
@@ -543,32 +564,32 @@ func (conn *Tunnel) process() error {
 // serve serves the tunnel connection. It can sustain certain failures. This method will try to
 // reconnect in case of a heartbeat failure or disconnect.
 func (conn *Tunnel) serve() {
-	util.Log(conn, "Started worker")
-	defer util.Log(conn, "Worker exited")
+	conn.logger().Debug("Started worker")
+	defer func() { conn.logger().Debug("Worker exited") }()
 
 	defer close(conn.ack)
-	defer close(conn.inbound)
+	defer conn.inbound.close()
 	defer conn.wait.Done()
 
 	for {
 		err := conn.process()
 
 		if err != nil {
-			util.Log(conn, "Server terminated with error: %v", err)
+			conn.logger().Warn("Server terminated with error", "error", err)
 		}
 
 		// Check if we can try again.
 		if err == errDisconnected || err == errHeartbeatFailed {
-			util.Log(conn, "Attempting reconnect")
+			conn.logger().Info("Attempting reconnect")
 
 			reconnErr := conn.requestConn()
 
 			if reconnErr == nil {
-				util.Log(conn, "Reconnect succeeded")
+				conn.logger().Info("Reconnect succeeded")
 				continue
 			}
 
-			util.Log(conn, "Reconnect failed: %v", reconnErr)
+			conn.logger().Error("Reconnect failed", "error", reconnErr)
 		}
 
 		return
@@ -582,7 +603,10 @@ func NewTunnel(
 	layer knxnet.TunnelLayer,
 	config TunnelConfig,
 ) (tunnel *Tunnel, err error) {
-	var sock knxnet.Socket
+	config = checkTunnelConfig(config)
+	log := config.Logger.With("gateway", gatewayAddr)
+
+	var sock *knxnet.TunnelSocket
 
 	// Create socket which will be used for communication.
 	if config.UseTCP {
@@ -595,19 +619,24 @@ func NewTunnel(
 		return nil, err
 	}
 
+	sock.SetLogger(log.With("component", "knxnet"))
+
 	// Initialize the Client structure.
 	client := &Tunnel{
-		sock:    sock,
-		config:  checkTunnelConfig(config),
-		layer:   layer,
-		ack:     make(chan *knxnet.TunnelRes),
-		inbound: make(chan cemi.Message),
-		done:    make(chan struct{}),
+		sock:   sock,
+		config: config,
+		log:    log.With("component", "tunnel"),
+		layer:  layer,
+		ack:    make(chan *knxnet.TunnelRes),
+		done:   make(chan struct{}),
 	}
 
+	client.inbound = newInboundBuffer(client.config.InboundBufferSize, client.config.InboundOverflow)
+
 	// Connect to the gateway.
 	err = client.requestConn()
 	if err != nil {
+		client.inbound.abort()
 		sock.Close()
 		return nil, err
 	}
@@ -625,6 +654,7 @@ func (conn *Tunnel) Close() {
 		conn.requestDisc()
 
 		close(conn.done)
+		conn.inbound.abort()
 		conn.wait.Wait()
 
 		conn.sock.Close()
@@ -634,7 +664,13 @@ func (conn *Tunnel) Close() {
 // Inbound retrieves the channel which transmits incoming data. The channel is closed when the
 // underlying Socket closes its inbound channel or when the connection is terminated.
 func (conn *Tunnel) Inbound() <-chan cemi.Message {
-	return conn.inbound
+	return conn.inbound.channel()
+}
+
+// InboundDropped returns the number of incoming messages which have been discarded, because the
+// inbound buffer was full.
+func (conn *Tunnel) InboundDropped() uint64 {
+	return conn.inbound.droppedCount()
 }
 
 // Send relays a tunnel request to the gateway with the given contents.
@@ -654,7 +690,7 @@ func NewGroupTunnel(gatewayAddr string, config TunnelConfig) (gt GroupTunnel, er
 
 	if err == nil {
 		gt.inbound = make(chan GroupEvent)
-		go serveGroupInbound(gt.Tunnel.Inbound(), gt.inbound)
+		go serveGroupInbound(gt.Tunnel.Inbound(), gt.inbound, gt.Tunnel.log)
 	}
 
 	return
@@ -21,7 +21,7 @@ func makeTunnelConn(
 		config:  config,
 		channel: channel,
 		ack:     make(chan *knxnet.TunnelRes),
-		inbound: make(chan cemi.Message, 100),
+		inbound: newInboundBuffer(100, OverflowBlock),
 	}
 }
 
@@ -4,8 +4,13 @@
 package util
 
 import (
+	"context"
 	"fmt"
+	"log/slog"
 	"reflect"
+	"strconv"
+	"strings"
+	"sync"
 )
 
 // A LogTarget is used to log certain messages.
@@ -14,11 +19,16 @@ type LogTarget interface {
 }
 
 // Logger is the log target for asynchronous and non-critical errors.
+//
+// Deprecated: Configure a *slog.Logger for each client instead. Clients without a logger of their
+// own still forward their messages to Logger, see DefaultLogger.
 var Logger LogTarget
 
 var longestLogger = 10
 
 // Log sends a message to the Logger.
+//
+// Deprecated: Use a *slog.Logger instead.
 func Log(value interface{}, format string, args ...interface{}) {
 	if Logger == nil {
 		return
@@ -35,3 +45,133 @@ func Log(value interface{}, format string, args ...interface{}) {
 		reflect.TypeOf(value).String(), value, fmt.Sprintf(format, args...),
 	)
 }
+
+// DefaultLogger returns the logger which is used when no logger has been configured. It forwards
+// all records to Logger, as long as it is set, and discards them otherwise.
+func DefaultLogger() *slog.Logger {
+	return defaultLogger
+}
+
+var defaultLogger = slog.New(&logTargetHandler{level: slog.LevelDebug})
+
+// NewLogTargetHandler creates a slog.Handler which formats records as text and writes them to the
+// given LogTarget, e.g. a *log.Logger. Records below the given level are discarded.
+func NewLogTargetHandler(target LogTarget, level slog.Leveler) slog.Handler {
+	if level == nil {
+		level = slog.LevelInfo
+	}
+
+	return &logTargetHandler{target: target, level: level}
+}
+
+// logTargetMu serializes the writes to log targets, which are not necessarily safe for concurrent
+// use.
+var logTargetMu sync.Mutex
+
+// logTargetHandler is a slog.Handler which writes to a LogTarget. If target is nil, the global
+// Logger is used.
+type logTargetHandler struct {
+	target LogTarget
+	level  slog.Leveler
+
+	// Attributes which have been added using WithAttrs, already formatted.
+	attrs string
+
+	// Prefix for the keys of subsequent attributes, derived from WithGroup.
+	group string
+}
+
+func (h *logTargetHandler) logTarget() LogTarget {
+	if h.target != nil {
+		return h.target
+	}
+
+	return Logger
+}
+
+// Enabled reports whether the handler handles records at the given level.
+func (h *logTargetHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return h.logTarget() != nil && level >= h.level.Level()
+}
+
+// Handle formats the record and writes it to the log target.
+func (h *logTargetHandler) Handle(_ context.Context, record slog.Record) error {
+	target := h.logTarget()
+	if target == nil {
+		return nil
+	}
+
+	var b strings.Builder
+	b.WriteString(record.Level.String())
+	b.WriteByte(' ')
+	b.WriteString(record.Message)
+	b.WriteString(h.attrs)
+
+	record.Attrs(func(attr slog.Attr) bool {
+		appendAttr(&b, h.group, attr)
+		return true
+	})
+
+	logTargetMu.Lock()
+	defer logTargetMu.Unlock()
+
+	target.Printf("%s\n", b.String())
+
+	return nil
+}
+
+// WithAttrs returns a handler which adds the given attributes to every record.
+func (h *logTargetHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	var b strings.Builder
+	for _, attr := range attrs {
+		appendAttr(&b, h.group, attr)
+	}
+
+	derived := *h
+	derived.attrs += b.String()
+
+	return &derived
+}
+
+// WithGroup returns a handler which qualifies the keys of subsequent attributes with name.
+func (h *logTargetHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	derived := *h
+	derived.group += name + "."
+
+	return &derived
+}
+
+// appendAttr writes the attribute in the form " key=value".
+func appendAttr(b *strings.Builder, group string, attr slog.Attr) {
+	attr.Value = attr.Value.Resolve()
+	if attr.Equal(slog.Attr{}) {
+		return
+	}
+
+	if attr.Value.Kind() == slog.KindGroup {
+		if attr.Key != "" {
+			group += attr.Key + "."
+		}
+
+		for _, member := range attr.Value.Group() {
+			appendAttr(b, group, member)
+		}
+
+		return
+	}
+
+	value := attr.Value.String()
+	if value == "" || strings.ContainsAny(value, " \t\n\"=") {
+		value = strconv.Quote(value)
+	}
+
+	b.WriteByte(' ')
+	b.WriteString(group)
+	b.WriteString(attr.Key)
+	b.WriteByte('=')
+	b.WriteString(value)
+}
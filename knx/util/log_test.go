@@ -2,6 +2,7 @@ package util
 
 import (
 	"fmt"
+	"log/slog"
 	"strings"
 	"testing"
 )
@@ -44,3 +45,38 @@ func TestLogWithoutLogger(t *testing.T) {
 	longestLogger = 10
 	Log(&sampleComponent{}, "noop")
 }
+
+func TestLogTargetHandler(t *testing.T) {
+	logger := &recordingLogger{}
+	log := slog.New(NewLogTargetHandler(logger, slog.LevelInfo))
+
+	log.Debug("hidden")
+	log.With("component", "tunnel").WithGroup("req").Warn("Rejected", "channel", 3, "reason", "out of sequence")
+
+	if len(logger.messages) != 1 {
+		t.Fatalf("expected 1 log message, got %d", len(logger.messages))
+	}
+
+	expected := "WARN Rejected component=tunnel req.channel=3 req.reason=\"out of sequence\"\n"
+	if logger.messages[0] != expected {
+		t.Fatalf("log message %q, want %q", logger.messages[0], expected)
+	}
+}
+
+func TestDefaultLoggerForwardsToLogger(t *testing.T) {
+	Logger = nil
+	DefaultLogger().Info("discarded")
+
+	logger := &recordingLogger{}
+	Logger = logger
+	t.Cleanup(func() { Logger = nil })
+
+	DefaultLogger().Debug("Started worker", "component", "router")
+	if len(logger.messages) != 1 {
+		t.Fatalf("expected 1 log message, got %d", len(logger.messages))
+	}
+
+	if !strings.Contains(logger.messages[0], "Started worker component=router") {
+		t.Fatalf("log message %q missing content", logger.messages[0])
+	}
+}
@@ -199,3 +199,11 @@ func TestUnpackString(t *testing.T) {
 	}
 
 }
+
+func TestUnpackStringShort(t *testing.T) {
+	data := make([]byte, 30)
+
+	var output string
+	_, err := UnpackString(data[:10], 30, &output)
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/knx-go/knx-go/knx/dpt"
 	"gorm.io/gorm"
 )
 
@@ -18,6 +19,7 @@ type Event struct {
 	Group       string `gorm:"index"`
 	DPT         string
 	Decoded     string
+	Value       *dpt.Description `gorm:"serializer:json;type:jsonb"`
 }
 
 func (e *Event) BeforeCreate(tx *gorm.DB) (err error) {